@@ -2414,6 +2414,9 @@ type ResponseCheckTx struct {
 	// mempool_error is set by CometBFT.
 	// ABCI applictions creating a ResponseCheckTX should not set mempool_error.
 	MempoolError string `protobuf:"bytes,11,opt,name=mempool_error,json=mempoolError,proto3" json:"mempool_error,omitempty"`
+	// nonce is the sequence number of the tx for its sender. If the mempool
+	// orders txs by sender nonce, the txs of a sender are reaped in nonce order.
+	Nonce uint64 `protobuf:"varint,12,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
 func (m *ResponseCheckTx) Reset()         { *m = ResponseCheckTx{} }
@@ -2526,6 +2529,13 @@ func (m *ResponseCheckTx) GetMempoolError() string {
 	return ""
 }
 
+func (m *ResponseCheckTx) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 type ResponseDeliverTx struct {
 	Code      uint32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Data      []byte  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -2698,6 +2708,9 @@ type ResponseCommit struct {
 	// reserve 1
 	Data         []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	RetainHeight int64  `protobuf:"varint,3,opt,name=retain_height,json=retainHeight,proto3" json:"retain_height,omitempty"`
+	// If set, only the txs in the mempool of the senders in the filter, and the
+	// txs without a sender, are rechecked. Otherwise, all the txs are rechecked.
+	RecheckFilter *RecheckFilter `protobuf:"bytes,4,opt,name=recheck_filter,json=recheckFilter,proto3" json:"recheck_filter,omitempty"`
 }
 
 func (m *ResponseCommit) Reset()         { *m = ResponseCommit{} }
@@ -2747,6 +2760,13 @@ func (m *ResponseCommit) GetRetainHeight() int64 {
 	return 0
 }
 
+func (m *ResponseCommit) GetRecheckFilter() *RecheckFilter {
+	if m != nil {
+		return m.RecheckFilter
+	}
+	return nil
+}
+
 type ResponseListSnapshots struct {
 	Snapshots []*Snapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
 }
@@ -3441,6 +3461,53 @@ func (m *TxResult) GetResult() ResponseDeliverTx {
 	return ResponseDeliverTx{}
 }
 
+// RecheckFilter selects the txs rechecked in the mempool once a block is
+// committed.
+type RecheckFilter struct {
+	// Senders whose txs are rechecked, as returned in ResponseCheckTx.sender.
+	Senders []string `protobuf:"bytes,1,rep,name=senders,proto3" json:"senders,omitempty"`
+}
+
+func (m *RecheckFilter) Reset()         { *m = RecheckFilter{} }
+func (m *RecheckFilter) String() string { return proto.CompactTextString(m) }
+func (*RecheckFilter) ProtoMessage()    {}
+func (*RecheckFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_252557cfdd89a31a, []int{46}
+}
+func (m *RecheckFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecheckFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecheckFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecheckFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecheckFilter.Merge(m, src)
+}
+func (m *RecheckFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecheckFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecheckFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecheckFilter proto.InternalMessageInfo
+
+func (m *RecheckFilter) GetSenders() []string {
+	if m != nil {
+		return m.Senders
+	}
+	return nil
+}
+
 // Validator
 type Validator struct {
 	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -3452,7 +3519,7 @@ func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{46}
+	return fileDescriptor_252557cfdd89a31a, []int{47}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3507,7 +3574,7 @@ func (m *ValidatorUpdate) Reset()         { *m = ValidatorUpdate{} }
 func (m *ValidatorUpdate) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdate) ProtoMessage()    {}
 func (*ValidatorUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{47}
+	return fileDescriptor_252557cfdd89a31a, []int{48}
 }
 func (m *ValidatorUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3574,7 +3641,7 @@ func (m *VoteInfo) Reset()         { *m = VoteInfo{} }
 func (m *VoteInfo) String() string { return proto.CompactTextString(m) }
 func (*VoteInfo) ProtoMessage()    {}
 func (*VoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{48}
+	return fileDescriptor_252557cfdd89a31a, []int{49}
 }
 func (m *VoteInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3627,7 +3694,7 @@ func (m *ExtendedVoteInfo) Reset()         { *m = ExtendedVoteInfo{} }
 func (m *ExtendedVoteInfo) String() string { return proto.CompactTextString(m) }
 func (*ExtendedVoteInfo) ProtoMessage()    {}
 func (*ExtendedVoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{49}
+	return fileDescriptor_252557cfdd89a31a, []int{50}
 }
 func (m *ExtendedVoteInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3695,7 +3762,7 @@ func (m *Misbehavior) Reset()         { *m = Misbehavior{} }
 func (m *Misbehavior) String() string { return proto.CompactTextString(m) }
 func (*Misbehavior) ProtoMessage()    {}
 func (*Misbehavior) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{50}
+	return fileDescriptor_252557cfdd89a31a, []int{51}
 }
 func (m *Misbehavior) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3771,7 +3838,7 @@ func (m *Snapshot) Reset()         { *m = Snapshot{} }
 func (m *Snapshot) String() string { return proto.CompactTextString(m) }
 func (*Snapshot) ProtoMessage()    {}
 func (*Snapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{51}
+	return fileDescriptor_252557cfdd89a31a, []int{52}
 }
 func (m *Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3887,6 +3954,7 @@ func init() {
 	proto.RegisterType((*Event)(nil), "tendermint.abci.Event")
 	proto.RegisterType((*EventAttribute)(nil), "tendermint.abci.EventAttribute")
 	proto.RegisterType((*TxResult)(nil), "tendermint.abci.TxResult")
+	proto.RegisterType((*RecheckFilter)(nil), "tendermint.abci.RecheckFilter")
 	proto.RegisterType((*Validator)(nil), "tendermint.abci.Validator")
 	proto.RegisterType((*ValidatorUpdate)(nil), "tendermint.abci.ValidatorUpdate")
 	proto.RegisterType((*VoteInfo)(nil), "tendermint.abci.VoteInfo")
@@ -3898,216 +3966,219 @@ func init() {
 func init() { proto.RegisterFile("tendermint/abci/types.proto", fileDescriptor_252557cfdd89a31a) }
 
 var fileDescriptor_252557cfdd89a31a = []byte{
-	// 3341 bytes of a gzipped FileDescriptorProto
+	// 3384 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcb, 0x73, 0x23, 0xd5,
-	0xd5, 0xd7, 0xfb, 0x71, 0xf4, 0xf4, 0xb5, 0x99, 0xd1, 0x88, 0x19, 0x7b, 0xa6, 0xe7, 0x83, 0x79,
-	0x00, 0x1e, 0x3e, 0xcf, 0x37, 0x3c, 0x0a, 0xf8, 0xc0, 0xd6, 0x68, 0x22, 0x63, 0x63, 0x9b, 0xb6,
-	0x3c, 0xd4, 0xe4, 0x41, 0xd3, 0x92, 0xae, 0xad, 0x66, 0x24, 0x75, 0xd3, 0xdd, 0x32, 0x32, 0x55,
-	0xd9, 0x84, 0xa2, 0x2a, 0xc5, 0x8a, 0x25, 0x1b, 0x16, 0xa9, 0x54, 0xb2, 0xc9, 0x3f, 0x90, 0x55,
-	0x56, 0x59, 0xb0, 0xc8, 0x82, 0xaa, 0x2c, 0x92, 0x45, 0x8a, 0xa4, 0x60, 0x97, 0x7f, 0x20, 0xdb,
-	0xd4, 0x7d, 0x75, 0xdf, 0x96, 0xd4, 0x92, 0x4c, 0xa8, 0x54, 0xa5, 0xb2, 0xeb, 0x7b, 0xfa, 0x9c,
-	0xd3, 0xf7, 0x79, 0xce, 0xef, 0x77, 0xfa, 0xc2, 0x93, 0x2e, 0x1e, 0x74, 0xb0, 0xdd, 0x37, 0x06,
-	0xee, 0x1d, 0xbd, 0xd5, 0x36, 0xee, 0xb8, 0x67, 0x16, 0x76, 0xd6, 0x2d, 0xdb, 0x74, 0x4d, 0x54,
-	0xf2, 0x5f, 0xae, 0x93, 0x97, 0xd5, 0x2b, 0x92, 0x76, 0xdb, 0x3e, 0xb3, 0x5c, 0xf3, 0x8e, 0x65,
-	0x9b, 0xe6, 0x31, 0xd3, 0xaf, 0x5e, 0x96, 0x5e, 0x53, 0x3f, 0xb2, 0xb7, 0xc0, 0x5b, 0x6e, 0xfc,
-	0x18, 0x9f, 0x89, 0xb7, 0x57, 0x26, 0x6c, 0x2d, 0xdd, 0xd6, 0xfb, 0xe2, 0xf5, 0xda, 0x89, 0x69,
-	0x9e, 0xf4, 0xf0, 0x1d, 0xda, 0x6a, 0x0d, 0x8f, 0xef, 0xb8, 0x46, 0x1f, 0x3b, 0xae, 0xde, 0xb7,
-	0xb8, 0xc2, 0xca, 0x89, 0x79, 0x62, 0xd2, 0xc7, 0x3b, 0xe4, 0x89, 0x49, 0x95, 0x5f, 0xe6, 0x20,
-	0xad, 0xe2, 0x0f, 0x86, 0xd8, 0x71, 0xd1, 0x06, 0x24, 0x70, 0xbb, 0x6b, 0x56, 0xa2, 0x57, 0xa3,
-	0x37, 0x73, 0x1b, 0x97, 0xd7, 0xc7, 0x06, 0xb7, 0xce, 0xf5, 0xea, 0xed, 0xae, 0xd9, 0x88, 0xa8,
-	0x54, 0x17, 0xdd, 0x83, 0xe4, 0x71, 0x6f, 0xe8, 0x74, 0x2b, 0x31, 0x6a, 0x74, 0x25, 0xcc, 0xe8,
-	0x01, 0x51, 0x6a, 0x44, 0x54, 0xa6, 0x4d, 0x3e, 0x65, 0x0c, 0x8e, 0xcd, 0x4a, 0x7c, 0xf6, 0xa7,
-	0xb6, 0x07, 0xc7, 0xf4, 0x53, 0x44, 0x17, 0x6d, 0x01, 0x18, 0x03, 0xc3, 0xd5, 0xda, 0x5d, 0xdd,
-	0x18, 0x54, 0x92, 0xd4, 0xf2, 0x5a, 0xb8, 0xa5, 0xe1, 0xd6, 0x88, 0x62, 0x23, 0xa2, 0x66, 0x0d,
-	0xd1, 0x20, 0xdd, 0xfd, 0x60, 0x88, 0xed, 0xb3, 0x4a, 0x6a, 0x76, 0x77, 0xdf, 0x26, 0x4a, 0xa4,
-	0xbb, 0x54, 0x1b, 0xd5, 0x21, 0xd7, 0xc2, 0x27, 0xc6, 0x40, 0x6b, 0xf5, 0xcc, 0xf6, 0xe3, 0x4a,
-	0x9a, 0x1a, 0x2b, 0x61, 0xc6, 0x5b, 0x44, 0x75, 0x8b, 0x68, 0x36, 0x22, 0x2a, 0xb4, 0xbc, 0x16,
-	0x7a, 0x15, 0x32, 0xed, 0x2e, 0x6e, 0x3f, 0xd6, 0xdc, 0x51, 0x25, 0x43, 0x7d, 0xac, 0x85, 0xf9,
-	0xa8, 0x11, 0xbd, 0xe6, 0xa8, 0x11, 0x51, 0xd3, 0x6d, 0xf6, 0x48, 0xc6, 0xdf, 0xc1, 0x3d, 0xe3,
-	0x14, 0xdb, 0xc4, 0x3e, 0x3b, 0x7b, 0xfc, 0xf7, 0x99, 0x26, 0xf5, 0x90, 0xed, 0x88, 0x06, 0x7a,
-	0x1d, 0xb2, 0x78, 0xd0, 0xe1, 0xc3, 0x00, 0xea, 0xe2, 0x6a, 0xe8, 0x3a, 0x0f, 0x3a, 0x62, 0x10,
-	0x19, 0xcc, 0x9f, 0xd1, 0x4b, 0x90, 0x6a, 0x9b, 0xfd, 0xbe, 0xe1, 0x56, 0x72, 0xd4, 0x7a, 0x35,
-	0x74, 0x00, 0x54, 0xab, 0x11, 0x51, 0xb9, 0x3e, 0xda, 0x83, 0x62, 0xcf, 0x70, 0x5c, 0xcd, 0x19,
-	0xe8, 0x96, 0xd3, 0x35, 0x5d, 0xa7, 0x92, 0xa7, 0x1e, 0x9e, 0x0a, 0xf3, 0xb0, 0x6b, 0x38, 0xee,
-	0xa1, 0x50, 0x6e, 0x44, 0xd4, 0x42, 0x4f, 0x16, 0x10, 0x7f, 0xe6, 0xf1, 0x31, 0xb6, 0x3d, 0x87,
-	0x95, 0xc2, 0x6c, 0x7f, 0xfb, 0x44, 0x5b, 0xd8, 0x13, 0x7f, 0xa6, 0x2c, 0x40, 0x3f, 0x82, 0xe5,
-	0x9e, 0xa9, 0x77, 0x3c, 0x77, 0x5a, 0xbb, 0x3b, 0x1c, 0x3c, 0xae, 0x14, 0xa9, 0xd3, 0x5b, 0xa1,
-	0x9d, 0x34, 0xf5, 0x8e, 0x70, 0x51, 0x23, 0x06, 0x8d, 0x88, 0xba, 0xd4, 0x1b, 0x17, 0xa2, 0x77,
-	0x61, 0x45, 0xb7, 0xac, 0xde, 0xd9, 0xb8, 0xf7, 0x12, 0xf5, 0x7e, 0x3b, 0xcc, 0xfb, 0x26, 0xb1,
-	0x19, 0x77, 0x8f, 0xf4, 0x09, 0x29, 0x6a, 0x42, 0xd9, 0xb2, 0xb1, 0xa5, 0xdb, 0x58, 0xb3, 0x6c,
-	0xd3, 0x32, 0x1d, 0xbd, 0x57, 0x29, 0x53, 0xdf, 0x37, 0xc2, 0x7c, 0x1f, 0x30, 0xfd, 0x03, 0xae,
-	0xde, 0x88, 0xa8, 0x25, 0x2b, 0x28, 0x62, 0x5e, 0xcd, 0x36, 0x76, 0x1c, 0xdf, 0xeb, 0xd2, 0x3c,
-	0xaf, 0x54, 0x3f, 0xe8, 0x35, 0x20, 0xa2, 0x7b, 0xd0, 0xed, 0x6a, 0xec, 0x1c, 0xa2, 0x39, 0x7b,
-	0xd0, 0xed, 0x8a, 0xa3, 0x98, 0xc1, 0xfc, 0x19, 0xed, 0x03, 0xe9, 0xa9, 0x26, 0x9f, 0xc8, 0xe5,
-	0xd9, 0x4b, 0x7f, 0x60, 0xe3, 0xc0, 0xa1, 0x2c, 0x58, 0xb2, 0x00, 0xed, 0x42, 0x91, 0x38, 0x94,
-	0x4e, 0xd7, 0x0a, 0xf5, 0xf7, 0x3f, 0x33, 0xfc, 0xc9, 0x07, 0x2c, 0x6f, 0x49, 0x6d, 0x72, 0x4e,
-	0x89, 0x37, 0x7e, 0x4c, 0x9e, 0x98, 0x7d, 0x4e, 0x0f, 0x6c, 0xec, 0x9d, 0x94, 0xac, 0x25, 0x1a,
-	0x5b, 0x69, 0x48, 0x9e, 0xea, 0xbd, 0x21, 0x7e, 0x33, 0x91, 0x49, 0x94, 0x93, 0xca, 0x0d, 0xc8,
-	0x49, 0xc1, 0x17, 0x55, 0x20, 0xdd, 0xc7, 0x8e, 0xa3, 0x9f, 0x60, 0x1a, 0xab, 0xb3, 0xaa, 0x68,
-	0x2a, 0x45, 0xc8, 0xcb, 0x01, 0x57, 0xf9, 0x2c, 0xea, 0x59, 0x92, 0x58, 0x4a, 0x2c, 0x4f, 0xb1,
-	0xed, 0x18, 0xe6, 0x40, 0x58, 0xf2, 0x26, 0xba, 0x0e, 0x05, 0x3a, 0x95, 0x9a, 0x78, 0x4f, 0x02,
-	0x7a, 0x42, 0xcd, 0x53, 0xe1, 0x43, 0xae, 0xb4, 0x06, 0x39, 0x6b, 0xc3, 0xf2, 0x54, 0xe2, 0x54,
-	0x05, 0xac, 0x0d, 0x4b, 0x28, 0x5c, 0x83, 0x3c, 0x19, 0x9d, 0xa7, 0x91, 0xa0, 0x1f, 0xc9, 0x11,
-	0x19, 0x57, 0x51, 0xfe, 0x10, 0x83, 0xf2, 0x78, 0x90, 0x46, 0x2f, 0x41, 0x82, 0xe4, 0x2b, 0x9e,
-	0x7a, 0xaa, 0xeb, 0x2c, 0x99, 0xad, 0x8b, 0x64, 0xb6, 0xde, 0x14, 0xc9, 0x6c, 0x2b, 0xf3, 0xe5,
-	0xd7, 0x6b, 0x91, 0xcf, 0xfe, 0xba, 0x16, 0x55, 0xa9, 0x05, 0xba, 0x44, 0x62, 0xaa, 0x6e, 0x0c,
-	0x34, 0xa3, 0x43, 0xbb, 0x9c, 0x25, 0x01, 0x53, 0x37, 0x06, 0xdb, 0x1d, 0xb4, 0x0b, 0xe5, 0xb6,
-	0x39, 0x70, 0xf0, 0xc0, 0x19, 0x3a, 0x1a, 0x4b, 0x96, 0x3c, 0xe1, 0x04, 0x96, 0x83, 0xa5, 0xe0,
-	0x9a, 0xd0, 0x3c, 0xa0, 0x8a, 0x6a, 0xa9, 0x1d, 0x14, 0xa0, 0x07, 0x00, 0xa7, 0x7a, 0xcf, 0xe8,
-	0xe8, 0xae, 0x69, 0x3b, 0x95, 0xc4, 0xd5, 0xf8, 0xd4, 0x7d, 0xfb, 0x50, 0xa8, 0x1c, 0x59, 0x1d,
-	0xdd, 0xc5, 0x5b, 0x09, 0xd2, 0x5d, 0x55, 0xb2, 0x44, 0x4f, 0x43, 0x49, 0xb7, 0x2c, 0xcd, 0x71,
-	0x75, 0x17, 0x6b, 0xad, 0x33, 0x17, 0x3b, 0x34, 0x97, 0xe5, 0xd5, 0x82, 0x6e, 0x59, 0x87, 0x44,
-	0xba, 0x45, 0x84, 0xe8, 0x29, 0x28, 0x92, 0xbc, 0x65, 0xe8, 0x3d, 0xad, 0x8b, 0x8d, 0x93, 0xae,
-	0x4b, 0x73, 0x56, 0x5c, 0x2d, 0x70, 0x69, 0x83, 0x0a, 0x95, 0x8e, 0xb7, 0xe2, 0xec, 0x70, 0x20,
-	0x48, 0x74, 0x74, 0x57, 0xa7, 0x33, 0x99, 0x57, 0xe9, 0x33, 0x91, 0x59, 0xba, 0xdb, 0xe5, 0xf3,
-	0x43, 0x9f, 0xd1, 0x05, 0x48, 0x71, 0xb7, 0x71, 0xea, 0x96, 0xb7, 0xd0, 0x0a, 0x24, 0x2d, 0xdb,
-	0x3c, 0xc5, 0x74, 0xe9, 0x32, 0x2a, 0x6b, 0x28, 0x1f, 0xc7, 0x60, 0x69, 0x22, 0xbb, 0x11, 0xbf,
-	0x5d, 0xdd, 0xe9, 0x8a, 0x6f, 0x91, 0x67, 0xf4, 0x02, 0xf1, 0xab, 0x77, 0xb0, 0xcd, 0x11, 0x41,
-	0x65, 0x72, 0xaa, 0x1b, 0xf4, 0x3d, 0x9f, 0x1a, 0xae, 0x8d, 0x76, 0xa0, 0xdc, 0xd3, 0x1d, 0x97,
-	0x1f, 0x1b, 0x4d, 0x42, 0x07, 0x4f, 0x4e, 0x4c, 0x32, 0x3b, 0x24, 0x64, 0x43, 0x73, 0x27, 0x45,
-	0x62, 0xea, 0x4b, 0xd1, 0x11, 0xac, 0xb4, 0xce, 0x3e, 0xd2, 0x07, 0xae, 0x31, 0xc0, 0xda, 0xc4,
-	0xaa, 0x4d, 0xc2, 0x8d, 0xb7, 0x0c, 0xa7, 0x85, 0xbb, 0xfa, 0xa9, 0x61, 0x8a, 0x6e, 0x2d, 0x7b,
-	0xf6, 0xde, 0x8a, 0x3a, 0x8a, 0x0a, 0xc5, 0x60, 0x7a, 0x46, 0x45, 0x88, 0xb9, 0x23, 0x3e, 0xfe,
-	0x98, 0x3b, 0x42, 0xcf, 0x43, 0x82, 0x8c, 0x91, 0x8e, 0xbd, 0x38, 0xe5, 0x43, 0xdc, 0xae, 0x79,
-	0x66, 0x61, 0x95, 0x6a, 0x2a, 0x8a, 0x77, 0x1a, 0xfc, 0x08, 0x32, 0xe6, 0x55, 0xb9, 0x05, 0xa5,
-	0xb1, 0x9c, 0x2c, 0x2d, 0x5f, 0x54, 0x5e, 0x3e, 0xa5, 0x04, 0x85, 0x40, 0x02, 0x56, 0x2e, 0xc0,
-	0xca, 0xb4, 0x7c, 0xaa, 0x74, 0x3d, 0x79, 0x20, 0x2f, 0xa2, 0x7b, 0x90, 0xf1, 0x12, 0x2a, 0x3b,
-	0x8d, 0x97, 0x26, 0x46, 0x21, 0x94, 0x55, 0x4f, 0x95, 0x1c, 0x43, 0xb2, 0xab, 0xe9, 0x76, 0x88,
-	0xd1, 0x8e, 0xa7, 0x75, 0xcb, 0x6a, 0xe8, 0x4e, 0x57, 0x79, 0x0f, 0x2a, 0x61, 0xc9, 0x72, 0x6c,
-	0x18, 0x09, 0x6f, 0x17, 0x5e, 0x80, 0xd4, 0xb1, 0x69, 0xf7, 0x75, 0x97, 0x3a, 0x2b, 0xa8, 0xbc,
-	0x45, 0x76, 0x27, 0x4b, 0x9c, 0x71, 0x2a, 0x66, 0x0d, 0x45, 0x83, 0x4b, 0xa1, 0x09, 0x93, 0x98,
-	0x18, 0x83, 0x0e, 0x66, 0xf3, 0x59, 0x50, 0x59, 0xc3, 0x77, 0xc4, 0x3a, 0xcb, 0x1a, 0xe4, 0xb3,
-	0x0e, 0x1d, 0x2b, 0xf5, 0x9f, 0x55, 0x79, 0x4b, 0xf9, 0x3c, 0x0e, 0x17, 0xa6, 0xa7, 0x4d, 0x74,
-	0x15, 0xf2, 0x7d, 0x7d, 0xa4, 0xb9, 0x23, 0x7e, 0x96, 0xd9, 0x72, 0x40, 0x5f, 0x1f, 0x35, 0x47,
-	0xec, 0x20, 0x97, 0x21, 0xee, 0x8e, 0x9c, 0x4a, 0xec, 0x6a, 0xfc, 0x66, 0x5e, 0x25, 0x8f, 0xe8,
-	0x08, 0x96, 0x7a, 0x66, 0x5b, 0xef, 0x69, 0xd2, 0x8e, 0xe7, 0x9b, 0xfd, 0xfa, 0xc4, 0x64, 0xd7,
-	0x47, 0x54, 0xd2, 0x99, 0xd8, 0xf4, 0x25, 0xea, 0x63, 0xd7, 0xdb, 0xf9, 0xe8, 0x3e, 0xe4, 0xfa,
-	0xfe, 0x46, 0x3e, 0xc7, 0x66, 0x97, 0xcd, 0xa4, 0x25, 0x49, 0x06, 0x02, 0x83, 0x08, 0xd1, 0xa9,
-	0x73, 0x87, 0xe8, 0xe7, 0x61, 0x65, 0x80, 0x47, 0xae, 0x74, 0x10, 0xd9, 0x3e, 0x49, 0xd3, 0xa9,
-	0x47, 0xe4, 0x9d, 0x7f, 0xc8, 0xc8, 0x96, 0x41, 0xb7, 0x28, 0xf0, 0xb0, 0x4c, 0x07, 0xdb, 0x9a,
-	0xde, 0xe9, 0xd8, 0xd8, 0x71, 0x28, 0x60, 0xce, 0x53, 0x34, 0x41, 0xe5, 0x9b, 0x4c, 0xac, 0xfc,
-	0x5c, 0x5e, 0x9a, 0x20, 0xd0, 0xe0, 0x13, 0x1f, 0xf5, 0x27, 0xfe, 0x10, 0x56, 0xb8, 0x7d, 0x27,
-	0x30, 0xf7, 0xb1, 0x45, 0x03, 0x0d, 0x12, 0xe6, 0xe1, 0xd3, 0x1e, 0xff, 0x6e, 0xd3, 0x2e, 0x62,
-	0x69, 0x42, 0x8a, 0xa5, 0xff, 0x61, 0x4b, 0xf1, 0x8c, 0x1f, 0xa6, 0x04, 0x54, 0xab, 0x40, 0xda,
-	0x66, 0x22, 0x1e, 0xce, 0x44, 0x53, 0xf9, 0x24, 0xea, 0x05, 0xa0, 0x00, 0x3a, 0x23, 0x10, 0x82,
-	0xe5, 0xc6, 0xc1, 0xb0, 0xdf, 0xc2, 0x36, 0x3f, 0x50, 0x39, 0x2a, 0xdb, 0xa3, 0x22, 0x6f, 0xae,
-	0x62, 0x53, 0xf3, 0x4e, 0xfc, 0x3c, 0x79, 0x47, 0x79, 0x00, 0xcb, 0x53, 0x40, 0x1d, 0x41, 0x3a,
-	0xac, 0x17, 0x7e, 0xec, 0x88, 0xab, 0x40, 0x45, 0xdb, 0x34, 0x80, 0xb0, 0x18, 0x1d, 0xf3, 0x62,
-	0xf4, 0x5d, 0x2f, 0x8e, 0x7b, 0x90, 0x6e, 0xae, 0x13, 0xe5, 0x4f, 0x00, 0x19, 0x15, 0x3b, 0x16,
-	0x81, 0x1a, 0x68, 0x0b, 0xb2, 0x78, 0xd4, 0xc6, 0x96, 0x2b, 0xd0, 0xd9, 0x74, 0x8a, 0xc9, 0xb4,
-	0xeb, 0x42, 0x93, 0xe0, 0x46, 0xcf, 0x0c, 0xdd, 0xe5, 0x14, 0x3e, 0x9c, 0x8d, 0x73, 0x73, 0x99,
-	0xc3, 0xbf, 0x20, 0x38, 0x7c, 0x3c, 0x94, 0xd2, 0x31, 0xab, 0x31, 0x12, 0x7f, 0x97, 0x93, 0xf8,
-	0xc4, 0x9c, 0x8f, 0x05, 0x58, 0x7c, 0x2d, 0xc0, 0xe2, 0x53, 0x73, 0x86, 0x19, 0x42, 0xe3, 0x5f,
-	0x10, 0x34, 0x3e, 0x3d, 0xa7, 0xc7, 0x63, 0x3c, 0xfe, 0x41, 0x90, 0xc7, 0x67, 0x42, 0x42, 0xae,
-	0xb0, 0x0e, 0x25, 0xf2, 0xaf, 0x49, 0x44, 0x3e, 0x1b, 0xca, 0x60, 0x98, 0x93, 0x29, 0x4c, 0xbe,
-	0x16, 0x60, 0xf2, 0x30, 0x67, 0x0e, 0x42, 0xa8, 0xfc, 0x1b, 0x32, 0x95, 0xcf, 0x85, 0xb2, 0x0c,
-	0xbe, 0xde, 0xd3, 0xb8, 0xfc, 0xcb, 0x1e, 0x97, 0xcf, 0x87, 0x16, 0x23, 0xf8, 0x18, 0xc6, 0xc9,
-	0xfc, 0xfe, 0x04, 0x99, 0x67, 0xe4, 0xfb, 0xe9, 0x50, 0x17, 0x73, 0xd8, 0xfc, 0xfe, 0x04, 0x9b,
-	0x2f, 0xce, 0x71, 0x38, 0x87, 0xce, 0xff, 0x78, 0x3a, 0x9d, 0x0f, 0x27, 0xdc, 0xbc, 0x9b, 0x8b,
-	0xf1, 0x79, 0x2d, 0x84, 0xcf, 0x33, 0xce, 0xfd, 0x4c, 0xa8, 0xfb, 0x85, 0x09, 0xfd, 0xd1, 0x14,
-	0x42, 0xcf, 0xa8, 0xf7, 0xcd, 0x50, 0xe7, 0x0b, 0x30, 0xfa, 0xa3, 0x29, 0x8c, 0x1e, 0xcd, 0x75,
-	0x3b, 0x97, 0xd2, 0xbf, 0x21, 0x53, 0xfa, 0xe5, 0x79, 0x7b, 0x71, 0x1a, 0xa7, 0x7f, 0x1d, 0x32,
-	0x96, 0x8d, 0x8f, 0xb1, 0xdb, 0xee, 0x72, 0xf2, 0x7d, 0x6d, 0xd6, 0x38, 0xa9, 0x22, 0x71, 0x20,
-	0x8c, 0x64, 0xc6, 0x9c, 0x2c, 0xa7, 0x94, 0x5b, 0x84, 0xaf, 0x8c, 0x85, 0x4a, 0x02, 0xfa, 0xb0,
-	0x6d, 0x9b, 0x36, 0xe7, 0xbe, 0xac, 0xa1, 0xdc, 0x24, 0x0c, 0xca, 0x0f, 0x8b, 0x33, 0xd8, 0x35,
-	0x05, 0xd7, 0x52, 0x28, 0x54, 0x7e, 0x1b, 0xf5, 0x6d, 0x29, 0xf1, 0x90, 0xd9, 0x57, 0x96, 0xb3,
-	0x2f, 0x89, 0x73, 0xc7, 0x82, 0x9c, 0x7b, 0x0d, 0x72, 0x04, 0x34, 0x8f, 0xd1, 0x69, 0xdd, 0xf2,
-	0xe8, 0xf4, 0x6d, 0x58, 0xa2, 0x30, 0x85, 0x31, 0x73, 0x8e, 0x05, 0x12, 0x34, 0x8d, 0x94, 0xc8,
-	0x0b, 0x76, 0xa6, 0x19, 0x28, 0x78, 0x0e, 0x96, 0x25, 0x5d, 0x0f, 0x8c, 0x33, 0x6e, 0x59, 0xf6,
-	0xb4, 0x37, 0x39, 0x2a, 0xff, 0x7d, 0xd4, 0x9f, 0x21, 0x9f, 0x87, 0x4f, 0xa3, 0xcc, 0xd1, 0xef,
-	0x89, 0x32, 0xc7, 0xbe, 0x33, 0x65, 0x96, 0xc9, 0x45, 0x3c, 0x48, 0x2e, 0xfe, 0x11, 0xf5, 0xd7,
-	0xc4, 0x23, 0xc0, 0x6d, 0xb3, 0x83, 0x39, 0xdc, 0xa7, 0xcf, 0x04, 0x09, 0xf6, 0xcc, 0x13, 0x0e,
-	0xea, 0xc9, 0x23, 0xd1, 0xf2, 0x72, 0x57, 0x96, 0xa7, 0x26, 0x8f, 0x29, 0x30, 0xb4, 0xc5, 0x99,
-	0x42, 0x19, 0xe2, 0x8f, 0x31, 0x2b, 0x18, 0xe7, 0x55, 0xf2, 0x48, 0xf4, 0xe8, 0x56, 0xe3, 0xa8,
-	0x89, 0x35, 0xd0, 0x4b, 0x90, 0xa5, 0xa5, 0x7e, 0xcd, 0xb4, 0x1c, 0x9e, 0x59, 0x02, 0x80, 0x92,
-	0x55, 0xf4, 0xd7, 0x0f, 0x88, 0xce, 0xbe, 0xe5, 0x90, 0xad, 0xcb, 0x9e, 0x24, 0x98, 0x97, 0x0d,
-	0xc0, 0xbc, 0xcb, 0x90, 0x25, 0xbd, 0x77, 0x2c, 0xbd, 0x8d, 0x69, 0x96, 0xc8, 0xaa, 0xbe, 0x40,
-	0xf9, 0x29, 0xa0, 0xc9, 0x3c, 0x85, 0x1a, 0x90, 0xc2, 0xa7, 0x78, 0xe0, 0x32, 0xd8, 0x9b, 0xdb,
-	0xb8, 0x30, 0xc9, 0x27, 0xc8, 0xeb, 0xad, 0x0a, 0x99, 0xe4, 0xbf, 0x7f, 0xbd, 0x56, 0x66, 0xda,
-	0xcf, 0x9a, 0x7d, 0xc3, 0xc5, 0x7d, 0xcb, 0x3d, 0x53, 0xb9, 0x3d, 0xba, 0x02, 0x80, 0x47, 0xae,
-	0xad, 0x6b, 0x74, 0x43, 0x33, 0xa0, 0x93, 0xa5, 0x92, 0xfb, 0xba, 0xab, 0x2b, 0x7f, 0x89, 0x11,
-	0xb4, 0x17, 0x48, 0x71, 0x53, 0xa7, 0x5e, 0x9c, 0x88, 0x98, 0x54, 0x8f, 0x58, 0x6c, 0x39, 0x56,
-	0x01, 0x4e, 0x74, 0x47, 0xfb, 0x50, 0x1f, 0xb8, 0xb8, 0xc3, 0xd7, 0x44, 0x92, 0xa0, 0x2a, 0x64,
-	0x48, 0x6b, 0xe8, 0xe0, 0x0e, 0x2f, 0x8d, 0x78, 0x6d, 0x69, 0x1a, 0xd2, 0xff, 0xe2, 0x34, 0x04,
-	0x16, 0x21, 0x33, 0xb6, 0x08, 0x12, 0x61, 0xcc, 0xca, 0x84, 0x91, 0xf4, 0xcd, 0xb2, 0x0d, 0xd3,
-	0x36, 0xdc, 0x33, 0xba, 0x72, 0x71, 0xd5, 0x6b, 0xa3, 0xeb, 0x50, 0xe8, 0xe3, 0xbe, 0x65, 0x9a,
-	0x3d, 0x8d, 0x45, 0xa3, 0x1c, 0x35, 0xcd, 0x73, 0x61, 0x9d, 0x06, 0xa5, 0x4f, 0x62, 0xfe, 0xf1,
-	0xf4, 0x51, 0xe9, 0x7f, 0xdd, 0x04, 0x2b, 0xbf, 0xa1, 0xd5, 0xc2, 0x20, 0x88, 0x41, 0x87, 0xb0,
-	0xe4, 0x45, 0x07, 0x6d, 0x48, 0xa3, 0x86, 0xd8, 0xef, 0x8b, 0x86, 0x97, 0xf2, 0x69, 0x50, 0xec,
-	0xa0, 0x47, 0x70, 0x71, 0x2c, 0xf4, 0x79, 0xae, 0x63, 0x8b, 0x46, 0xc0, 0x27, 0x82, 0x11, 0x50,
-	0xb8, 0xf6, 0x27, 0x2b, 0xfe, 0xbd, 0x1e, 0xca, 0xc4, 0xf8, 0xa1, 0xdc, 0x86, 0x62, 0x10, 0xb2,
-	0x4d, 0xdd, 0x1d, 0xd7, 0xa1, 0x60, 0x63, 0x57, 0x37, 0x06, 0x5a, 0xa0, 0x02, 0x98, 0x67, 0x42,
-	0x5e, 0x57, 0x3c, 0x80, 0x27, 0xa6, 0x42, 0x37, 0xf4, 0x22, 0x64, 0x7d, 0xd4, 0xc7, 0x26, 0x7d,
-	0x46, 0x85, 0xc8, 0xd7, 0x55, 0x7e, 0x17, 0xf5, 0x5d, 0x06, 0x6b, 0x4e, 0x75, 0x48, 0xd9, 0xd8,
-	0x19, 0xf6, 0x18, 0x49, 0x2c, 0x6e, 0x3c, 0xb7, 0x18, 0xe8, 0x23, 0xd2, 0x61, 0xcf, 0x55, 0xb9,
-	0xb1, 0xf2, 0x2e, 0xa4, 0x98, 0x04, 0xe5, 0x20, 0x7d, 0xb4, 0xb7, 0xb3, 0xb7, 0xff, 0xce, 0x5e,
-	0x39, 0x82, 0x00, 0x52, 0x9b, 0xb5, 0x5a, 0xfd, 0xa0, 0x59, 0x8e, 0xa2, 0x2c, 0x24, 0x37, 0xb7,
-	0xf6, 0xd5, 0x66, 0x39, 0x46, 0xc4, 0x6a, 0xfd, 0xcd, 0x7a, 0xad, 0x59, 0x8e, 0xa3, 0x25, 0x28,
-	0xb0, 0x67, 0xed, 0xc1, 0xbe, 0xfa, 0xd6, 0x66, 0xb3, 0x9c, 0x90, 0x44, 0x87, 0xf5, 0xbd, 0xfb,
-	0x75, 0xb5, 0x9c, 0x54, 0xfe, 0x17, 0x2e, 0x85, 0xc2, 0x44, 0xbf, 0xa0, 0x14, 0x95, 0x0a, 0x4a,
-	0xca, 0xe7, 0x31, 0xa8, 0x86, 0x63, 0x3f, 0xf4, 0xe6, 0xd8, 0xc0, 0x37, 0xce, 0x01, 0x1c, 0xc7,
-	0x46, 0x8f, 0x9e, 0x82, 0x22, 0xc7, 0x42, 0x0c, 0x8b, 0xb2, 0x84, 0x5b, 0x50, 0x0b, 0x5c, 0x4a,
-	0x8d, 0x1c, 0xa6, 0xf6, 0x3e, 0x6e, 0xbb, 0x1a, 0x0b, 0x55, 0x6c, 0x4f, 0x66, 0x89, 0x1a, 0x91,
-	0x1e, 0x32, 0xa1, 0xf2, 0xde, 0xb9, 0xe6, 0x32, 0x0b, 0x49, 0xb5, 0xde, 0x54, 0x1f, 0x95, 0xe3,
-	0x08, 0x41, 0x91, 0x3e, 0x6a, 0x87, 0x7b, 0x9b, 0x07, 0x87, 0x8d, 0x7d, 0x32, 0x97, 0xcb, 0x50,
-	0x12, 0x73, 0x29, 0x84, 0x49, 0xe5, 0x19, 0xb8, 0x18, 0x02, 0x5c, 0x27, 0x0b, 0x37, 0xca, 0x2f,
-	0xa2, 0xb2, 0x76, 0x10, 0x7c, 0xee, 0x43, 0x8a, 0x50, 0xea, 0xa1, 0xc3, 0x27, 0xf1, 0xc5, 0x45,
-	0x91, 0xec, 0xba, 0x78, 0x38, 0xa4, 0xe6, 0x2a, 0x77, 0xa3, 0xdc, 0x83, 0x62, 0xf0, 0x4d, 0xf8,
-	0x1c, 0xf8, 0x9b, 0x28, 0xa6, 0xd8, 0x52, 0xa4, 0x12, 0xb0, 0x76, 0x06, 0x18, 0x89, 0xf9, 0xc1,
-	0x39, 0x10, 0x02, 0xe3, 0xe3, 0x39, 0xa6, 0x0a, 0x19, 0x9b, 0xfb, 0xe5, 0x27, 0xde, 0x6b, 0x2b,
-	0xaf, 0xfa, 0xdf, 0x14, 0xa8, 0x78, 0xea, 0x37, 0x3d, 0xe4, 0x1b, 0x93, 0x91, 0xef, 0x23, 0x00,
-	0xa9, 0x68, 0xbe, 0x02, 0x49, 0xdb, 0x1c, 0x0e, 0x3a, 0xd4, 0x30, 0xa9, 0xb2, 0x06, 0xba, 0x07,
-	0xc9, 0x53, 0x93, 0x05, 0xc1, 0xe9, 0x47, 0xfd, 0xa1, 0xe9, 0x62, 0xa9, 0x42, 0xc6, 0xb4, 0x15,
-	0x03, 0xd0, 0x64, 0xe1, 0x32, 0xe4, 0x13, 0xaf, 0x05, 0x3f, 0x71, 0x2d, 0xb4, 0x04, 0x3a, 0xfd,
-	0x53, 0x1f, 0x41, 0x92, 0x86, 0x4f, 0x32, 0x70, 0x5a, 0x7c, 0xe7, 0xe0, 0x9b, 0x3c, 0xa3, 0x9f,
-	0x00, 0xe8, 0xae, 0x6b, 0x1b, 0xad, 0xa1, 0xff, 0x81, 0xb5, 0xe9, 0xe1, 0x77, 0x53, 0xe8, 0x6d,
-	0x5d, 0xe6, 0x71, 0x78, 0xc5, 0x37, 0x95, 0x62, 0xb1, 0xe4, 0x50, 0xd9, 0x83, 0x62, 0xd0, 0x56,
-	0xc0, 0x45, 0xd6, 0x87, 0x20, 0x5c, 0xe4, 0x73, 0xcf, 0xe0, 0xa2, 0x07, 0x36, 0xe3, 0xec, 0x3f,
-	0x0b, 0x6d, 0x28, 0x9f, 0x46, 0x21, 0xd3, 0x1c, 0xf1, 0x93, 0x17, 0x52, 0xe3, 0xf7, 0x4d, 0x63,
-	0x72, 0x45, 0x9b, 0x15, 0xa4, 0xe2, 0xde, 0xaf, 0x88, 0x37, 0xbc, 0xd8, 0x92, 0x58, 0xb4, 0xc0,
-	0x20, 0x4a, 0x63, 0x3c, 0x9e, 0xbe, 0x02, 0x59, 0x2f, 0x79, 0x12, 0x16, 0x23, 0xca, 0x7f, 0xbc,
-	0x92, 0xc7, 0x9b, 0xf4, 0x8f, 0x91, 0xf9, 0x21, 0x2f, 0xbc, 0xc5, 0x55, 0xd6, 0x50, 0x7e, 0x1d,
-	0x85, 0xd2, 0x58, 0xea, 0x45, 0xaf, 0x40, 0xda, 0x1a, 0xb6, 0x34, 0x31, 0x3f, 0x63, 0x55, 0x52,
-	0x01, 0x90, 0x87, 0xad, 0x9e, 0xd1, 0xde, 0xc1, 0x67, 0xa2, 0x37, 0xd6, 0xb0, 0xb5, 0xc3, 0xa6,
-	0x91, 0x7d, 0x26, 0x26, 0x7d, 0x06, 0x5d, 0x84, 0x74, 0xab, 0xe7, 0x50, 0x97, 0x6c, 0xe8, 0xa9,
-	0x56, 0xcf, 0x21, 0xea, 0x37, 0xa0, 0x64, 0xe3, 0x9e, 0x7e, 0x26, 0x95, 0x2d, 0xd9, 0xe1, 0x29,
-	0x72, 0xb1, 0xa8, 0x5a, 0x9e, 0x42, 0x46, 0xec, 0x2b, 0xf4, 0xff, 0x90, 0xf5, 0x70, 0x81, 0xf7,
-	0x2f, 0x32, 0x14, 0x50, 0xf0, 0x0e, 0xfa, 0x26, 0x84, 0xaf, 0x39, 0xc6, 0xc9, 0x40, 0x54, 0x97,
-	0x59, 0x6d, 0x26, 0x46, 0x17, 0xb8, 0xc4, 0x5e, 0xec, 0x0a, 0x1e, 0xa6, 0xfc, 0x2a, 0x0a, 0xe5,
-	0xf1, 0x8d, 0xfd, 0xef, 0xec, 0x00, 0xc9, 0x04, 0xe4, 0x00, 0x69, 0x98, 0x74, 0xc2, 0x23, 0xa0,
-	0x79, 0xb5, 0x40, 0xa4, 0x75, 0x21, 0x54, 0x3e, 0x8e, 0x41, 0x4e, 0xaa, 0x5d, 0xa3, 0xff, 0x93,
-	0x4e, 0x59, 0x71, 0x0a, 0xde, 0x92, 0x74, 0xfd, 0xdf, 0x5c, 0xc1, 0x81, 0xc5, 0xce, 0x3f, 0xb0,
-	0xb0, 0xdf, 0x95, 0xa2, 0x14, 0x9e, 0x38, 0x77, 0x29, 0xfc, 0x59, 0x40, 0xae, 0xe9, 0xea, 0x3d,
-	0xed, 0xd4, 0x74, 0x8d, 0xc1, 0x89, 0xc6, 0x36, 0x17, 0x43, 0xc1, 0x65, 0xfa, 0xe6, 0x21, 0x7d,
-	0x71, 0x40, 0xb7, 0xf3, 0xcf, 0xa2, 0x90, 0xf1, 0xf0, 0xca, 0x79, 0xff, 0x5a, 0x5d, 0x80, 0x14,
-	0x4f, 0xc9, 0xec, 0xb7, 0x15, 0x6f, 0x4d, 0xad, 0xf9, 0x57, 0x21, 0xd3, 0xc7, 0xae, 0x4e, 0x41,
-	0x1b, 0xe3, 0xee, 0x5e, 0xfb, 0xf6, 0xcb, 0x90, 0x93, 0x7e, 0x20, 0x92, 0x50, 0xb3, 0x57, 0x7f,
-	0xa7, 0x1c, 0xa9, 0xa6, 0x3f, 0xfd, 0xe2, 0x6a, 0x7c, 0x0f, 0x7f, 0x48, 0x0e, 0xa9, 0x5a, 0xaf,
-	0x35, 0xea, 0xb5, 0x9d, 0x72, 0xb4, 0x9a, 0xfb, 0xf4, 0x8b, 0xab, 0x69, 0x15, 0xd3, 0xa2, 0xe3,
-	0xed, 0x1d, 0x28, 0x8d, 0x2d, 0x4c, 0x30, 0xa9, 0x21, 0x28, 0xde, 0x3f, 0x3a, 0xd8, 0xdd, 0xae,
-	0x6d, 0x36, 0xeb, 0xda, 0xc3, 0xfd, 0x66, 0xbd, 0x1c, 0x45, 0x17, 0x61, 0x79, 0x77, 0xfb, 0x07,
-	0x8d, 0xa6, 0x56, 0xdb, 0xdd, 0xae, 0xef, 0x35, 0xb5, 0xcd, 0x66, 0x73, 0xb3, 0xb6, 0x53, 0x8e,
-	0x6d, 0xfc, 0xb1, 0x00, 0xa5, 0xcd, 0xad, 0xda, 0x36, 0x01, 0x25, 0x46, 0x5b, 0xa7, 0xb5, 0x95,
-	0x1a, 0x24, 0x68, 0xf5, 0x64, 0xe6, 0xb5, 0xb1, 0xea, 0xec, 0x8a, 0x34, 0x7a, 0x00, 0x49, 0x5a,
-	0x58, 0x41, 0xb3, 0xef, 0x91, 0x55, 0xe7, 0x94, 0xa8, 0x49, 0x67, 0xe8, 0x71, 0x9a, 0x79, 0xb1,
-	0xac, 0x3a, 0xbb, 0x62, 0x8d, 0x54, 0xc8, 0xfa, 0xcc, 0x6b, 0xfe, 0x45, 0xab, 0xea, 0x02, 0x01,
-	0x16, 0xed, 0x42, 0x5a, 0x90, 0xe5, 0x79, 0x57, 0xbf, 0xaa, 0x73, 0x4b, 0xca, 0x64, 0xba, 0x18,
-	0xcc, 0x98, 0x7d, 0x8f, 0xad, 0x3a, 0xa7, 0x3e, 0x8e, 0xb6, 0x21, 0xc5, 0xe9, 0xc2, 0x9c, 0xeb,
-	0x5c, 0xd5, 0x79, 0x25, 0x62, 0x32, 0x69, 0x7e, 0x35, 0x69, 0xfe, 0xed, 0xbc, 0xea, 0x02, 0xa5,
-	0x7f, 0x74, 0x04, 0x20, 0x55, 0x38, 0x16, 0xb8, 0x76, 0x57, 0x5d, 0xa4, 0xa4, 0x8f, 0xf6, 0x21,
-	0xe3, 0x31, 0xca, 0xb9, 0x97, 0xe0, 0xaa, 0xf3, 0x6b, 0xeb, 0xe8, 0x5d, 0x28, 0x04, 0xa9, 0xd2,
-	0x62, 0x57, 0xdb, 0xaa, 0x0b, 0x16, 0xcd, 0x89, 0xff, 0x20, 0x6f, 0x5a, 0xec, 0xaa, 0x5b, 0x75,
-	0xc1, 0x1a, 0x3a, 0x7a, 0x1f, 0x96, 0x26, 0x79, 0xcd, 0xe2, 0x37, 0xdf, 0xaa, 0xe7, 0xa8, 0xaa,
-	0xa3, 0x3e, 0xa0, 0x29, 0x7c, 0xe8, 0x1c, 0x17, 0xe1, 0xaa, 0xe7, 0x29, 0xb2, 0xa3, 0x0e, 0x94,
-	0xc6, 0x49, 0xc6, 0xa2, 0x17, 0xe3, 0xaa, 0x0b, 0x17, 0xdc, 0xd9, 0x57, 0x82, 0xe4, 0x64, 0xd1,
-	0x8b, 0x72, 0xd5, 0x85, 0xeb, 0xef, 0x74, 0xdf, 0x0a, 0x7e, 0x31, 0xf7, 0xe2, 0x5c, 0x75, 0x7e,
-	0x1d, 0x1e, 0x3d, 0x82, 0x42, 0xf0, 0x17, 0xec, 0x62, 0xf7, 0xe8, 0xaa, 0xf3, 0x2b, 0xf4, 0xe8,
-	0x1d, 0xc8, 0x07, 0x7e, 0xab, 0x2e, 0x74, 0xa3, 0x6e, 0x11, 0xc7, 0x6f, 0x43, 0xd6, 0xff, 0xcf,
-	0x3a, 0xff, 0x76, 0xdd, 0x02, 0x2e, 0xb7, 0x36, 0xbf, 0xfc, 0x66, 0x35, 0xfa, 0xd5, 0x37, 0xab,
-	0xd1, 0xbf, 0x7d, 0xb3, 0x1a, 0xfd, 0xec, 0xdb, 0xd5, 0xc8, 0x57, 0xdf, 0xae, 0x46, 0xfe, 0xfc,
-	0xed, 0x6a, 0xe4, 0x87, 0x37, 0x4e, 0x0c, 0xb7, 0x3b, 0x6c, 0xad, 0xb7, 0xcd, 0xfe, 0x9d, 0xb6,
-	0xd9, 0xc7, 0x6e, 0xeb, 0xd8, 0xf5, 0x1f, 0xfc, 0x5b, 0xe1, 0xad, 0x14, 0xc5, 0x1d, 0x77, 0xff,
-	0x19, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x75, 0x95, 0x3a, 0x35, 0x2e, 0x00, 0x00,
+	0xd5, 0xd7, 0xfb, 0x71, 0xf4, 0xf4, 0xb5, 0x67, 0x46, 0x23, 0x66, 0xec, 0xa1, 0xf9, 0x60, 0x1e,
+	0x80, 0x87, 0xcf, 0xf3, 0xf1, 0x2a, 0xe0, 0x03, 0x5b, 0xa3, 0xf9, 0x64, 0xc6, 0xd8, 0xa6, 0x2d,
+	0x0f, 0x35, 0x5f, 0x12, 0x9a, 0x96, 0x74, 0x6d, 0x35, 0x23, 0xa9, 0x9b, 0xee, 0x2b, 0x63, 0x53,
+	0x95, 0x4d, 0x52, 0x54, 0xa5, 0x58, 0xcd, 0x92, 0x0d, 0x8b, 0x54, 0x2a, 0xd9, 0xe4, 0x1f, 0xc8,
+	0x2a, 0xab, 0x2c, 0x58, 0x64, 0x41, 0x55, 0x16, 0xc9, 0x8a, 0xa4, 0x60, 0x97, 0x65, 0x36, 0xd9,
+	0xa6, 0xee, 0xab, 0x75, 0x5b, 0x52, 0x4b, 0x32, 0xa1, 0x52, 0x95, 0xca, 0xee, 0xde, 0xd3, 0xe7,
+	0x9c, 0xbe, 0xaf, 0xf3, 0xf8, 0x9d, 0x7b, 0xe1, 0x09, 0x82, 0x07, 0x1d, 0xec, 0xf6, 0xad, 0x01,
+	0xb9, 0x6d, 0xb6, 0xda, 0xd6, 0x6d, 0x72, 0xe6, 0x60, 0x6f, 0xdd, 0x71, 0x6d, 0x62, 0xa3, 0xd2,
+	0xe8, 0xe3, 0x3a, 0xfd, 0x58, 0xbd, 0xaa, 0x70, 0xb7, 0xdd, 0x33, 0x87, 0xd8, 0xb7, 0x1d, 0xd7,
+	0xb6, 0x8f, 0x38, 0x7f, 0xf5, 0x8a, 0xf2, 0x99, 0xe9, 0x51, 0xb5, 0x55, 0xaf, 0x4c, 0x0a, 0x3f,
+	0xc2, 0x67, 0xf2, 0xeb, 0xd5, 0x09, 0x59, 0xc7, 0x74, 0xcd, 0xbe, 0xfc, 0xbc, 0x76, 0x6c, 0xdb,
+	0xc7, 0x3d, 0x7c, 0x9b, 0xf5, 0x5a, 0xc3, 0xa3, 0xdb, 0xc4, 0xea, 0x63, 0x8f, 0x98, 0x7d, 0x47,
+	0x30, 0xac, 0x1c, 0xdb, 0xc7, 0x36, 0x6b, 0xde, 0xa6, 0x2d, 0x4e, 0xd5, 0x7e, 0x91, 0x83, 0xb4,
+	0x8e, 0x3f, 0x1a, 0x62, 0x8f, 0xa0, 0x0d, 0x48, 0xe0, 0x76, 0xd7, 0xae, 0x44, 0xaf, 0x45, 0x6f,
+	0xe4, 0x36, 0xae, 0xac, 0x8f, 0x4d, 0x6e, 0x5d, 0xf0, 0xd5, 0xdb, 0x5d, 0xbb, 0x11, 0xd1, 0x19,
+	0x2f, 0x7a, 0x11, 0x92, 0x47, 0xbd, 0xa1, 0xd7, 0xad, 0xc4, 0x98, 0xd0, 0xd5, 0x30, 0xa1, 0x7b,
+	0x94, 0xa9, 0x11, 0xd1, 0x39, 0x37, 0xfd, 0x95, 0x35, 0x38, 0xb2, 0x2b, 0xf1, 0xd9, 0xbf, 0xda,
+	0x1e, 0x1c, 0xb1, 0x5f, 0x51, 0x5e, 0xb4, 0x05, 0x60, 0x0d, 0x2c, 0x62, 0xb4, 0xbb, 0xa6, 0x35,
+	0xa8, 0x24, 0x99, 0xe4, 0x93, 0xe1, 0x92, 0x16, 0xa9, 0x51, 0xc6, 0x46, 0x44, 0xcf, 0x5a, 0xb2,
+	0x43, 0x87, 0xfb, 0xd1, 0x10, 0xbb, 0x67, 0x95, 0xd4, 0xec, 0xe1, 0xbe, 0x4b, 0x99, 0xe8, 0x70,
+	0x19, 0x37, 0xaa, 0x43, 0xae, 0x85, 0x8f, 0xad, 0x81, 0xd1, 0xea, 0xd9, 0xed, 0x47, 0x95, 0x34,
+	0x13, 0xd6, 0xc2, 0x84, 0xb7, 0x28, 0xeb, 0x16, 0xe5, 0x6c, 0x44, 0x74, 0x68, 0xf9, 0x3d, 0xf4,
+	0x3a, 0x64, 0xda, 0x5d, 0xdc, 0x7e, 0x64, 0x90, 0xd3, 0x4a, 0x86, 0xe9, 0x58, 0x0b, 0xd3, 0x51,
+	0xa3, 0x7c, 0xcd, 0xd3, 0x46, 0x44, 0x4f, 0xb7, 0x79, 0x93, 0xce, 0xbf, 0x83, 0x7b, 0xd6, 0x09,
+	0x76, 0xa9, 0x7c, 0x76, 0xf6, 0xfc, 0xef, 0x72, 0x4e, 0xa6, 0x21, 0xdb, 0x91, 0x1d, 0xf4, 0x26,
+	0x64, 0xf1, 0xa0, 0x23, 0xa6, 0x01, 0x4c, 0xc5, 0xb5, 0xd0, 0x7d, 0x1e, 0x74, 0xe4, 0x24, 0x32,
+	0x58, 0xb4, 0xd1, 0x2b, 0x90, 0x6a, 0xdb, 0xfd, 0xbe, 0x45, 0x2a, 0x39, 0x26, 0xbd, 0x1a, 0x3a,
+	0x01, 0xc6, 0xd5, 0x88, 0xe8, 0x82, 0x1f, 0xed, 0x42, 0xb1, 0x67, 0x79, 0xc4, 0xf0, 0x06, 0xa6,
+	0xe3, 0x75, 0x6d, 0xe2, 0x55, 0xf2, 0x4c, 0xc3, 0xd3, 0x61, 0x1a, 0x76, 0x2c, 0x8f, 0x1c, 0x48,
+	0xe6, 0x46, 0x44, 0x2f, 0xf4, 0x54, 0x02, 0xd5, 0x67, 0x1f, 0x1d, 0x61, 0xd7, 0x57, 0x58, 0x29,
+	0xcc, 0xd6, 0xb7, 0x47, 0xb9, 0xa5, 0x3c, 0xd5, 0x67, 0xab, 0x04, 0xf4, 0x03, 0x58, 0xee, 0xd9,
+	0x66, 0xc7, 0x57, 0x67, 0xb4, 0xbb, 0xc3, 0xc1, 0xa3, 0x4a, 0x91, 0x29, 0xbd, 0x19, 0x3a, 0x48,
+	0xdb, 0xec, 0x48, 0x15, 0x35, 0x2a, 0xd0, 0x88, 0xe8, 0x4b, 0xbd, 0x71, 0x22, 0x7a, 0x1f, 0x56,
+	0x4c, 0xc7, 0xe9, 0x9d, 0x8d, 0x6b, 0x2f, 0x31, 0xed, 0xb7, 0xc2, 0xb4, 0x6f, 0x52, 0x99, 0x71,
+	0xf5, 0xc8, 0x9c, 0xa0, 0xa2, 0x26, 0x94, 0x1d, 0x17, 0x3b, 0xa6, 0x8b, 0x0d, 0xc7, 0xb5, 0x1d,
+	0xdb, 0x33, 0x7b, 0x95, 0x32, 0xd3, 0x7d, 0x3d, 0x4c, 0xf7, 0x3e, 0xe7, 0xdf, 0x17, 0xec, 0x8d,
+	0x88, 0x5e, 0x72, 0x82, 0x24, 0xae, 0xd5, 0x6e, 0x63, 0xcf, 0x1b, 0x69, 0x5d, 0x9a, 0xa7, 0x95,
+	0xf1, 0x07, 0xb5, 0x06, 0x48, 0xec, 0x0c, 0x92, 0xae, 0xc1, 0xed, 0x10, 0xcd, 0x39, 0x83, 0xa4,
+	0x2b, 0x4d, 0x31, 0x83, 0x45, 0x1b, 0xed, 0x01, 0x1d, 0xa9, 0xa1, 0x5a, 0xe4, 0xf2, 0xec, 0xad,
+	0xdf, 0x77, 0x71, 0xc0, 0x28, 0x0b, 0x8e, 0x4a, 0x40, 0x3b, 0x50, 0xa4, 0x0a, 0x15, 0xeb, 0x5a,
+	0x61, 0xfa, 0xfe, 0x6b, 0x86, 0x3e, 0xd5, 0xc0, 0xf2, 0x8e, 0xd2, 0xa7, 0x76, 0x4a, 0xb5, 0x09,
+	0x33, 0xb9, 0x30, 0xdb, 0x4e, 0xf7, 0x5d, 0xec, 0x5b, 0x4a, 0xd6, 0x91, 0x9d, 0xad, 0x34, 0x24,
+	0x4f, 0xcc, 0xde, 0x10, 0xbf, 0x9d, 0xc8, 0x24, 0xca, 0x49, 0xed, 0x3a, 0xe4, 0x14, 0xe7, 0x8b,
+	0x2a, 0x90, 0xee, 0x63, 0xcf, 0x33, 0x8f, 0x31, 0xf3, 0xd5, 0x59, 0x5d, 0x76, 0xb5, 0x22, 0xe4,
+	0x55, 0x87, 0xab, 0x3d, 0x8e, 0x42, 0x4e, 0xf1, 0xa5, 0x54, 0xf2, 0x04, 0xbb, 0x9e, 0x65, 0x0f,
+	0xa4, 0xa4, 0xe8, 0xa2, 0xa7, 0xa0, 0xc0, 0x96, 0xd2, 0x90, 0xdf, 0xa9, 0x43, 0x4f, 0xe8, 0x79,
+	0x46, 0x7c, 0x20, 0x98, 0xd6, 0x20, 0xe7, 0x6c, 0x38, 0x3e, 0x4b, 0x9c, 0xb1, 0x80, 0xb3, 0xe1,
+	0x48, 0x86, 0x27, 0x21, 0x4f, 0x67, 0xe7, 0x73, 0x24, 0xd8, 0x4f, 0x72, 0x94, 0x26, 0x58, 0xb4,
+	0xdf, 0xc7, 0xa0, 0x3c, 0xee, 0xa4, 0xd1, 0x2b, 0x90, 0xa0, 0xf1, 0x4a, 0x84, 0x9e, 0xea, 0x3a,
+	0x0f, 0x66, 0xeb, 0x32, 0x98, 0xad, 0x37, 0x65, 0x30, 0xdb, 0xca, 0x7c, 0xf9, 0xf5, 0x5a, 0xe4,
+	0xf1, 0x9f, 0xd7, 0xa2, 0x3a, 0x93, 0x40, 0x97, 0xa9, 0x4f, 0x35, 0xad, 0x81, 0x61, 0x75, 0xd8,
+	0x90, 0xb3, 0xd4, 0x61, 0x9a, 0xd6, 0x60, 0xbb, 0x83, 0x76, 0xa0, 0xdc, 0xb6, 0x07, 0x1e, 0x1e,
+	0x78, 0x43, 0xcf, 0xe0, 0xc1, 0xb2, 0x12, 0x9f, 0xdc, 0x0e, 0x1e, 0x82, 0x6b, 0x92, 0x73, 0x9f,
+	0x31, 0xea, 0xa5, 0x76, 0x90, 0x80, 0xee, 0x01, 0x9c, 0x98, 0x3d, 0xab, 0x63, 0x12, 0xdb, 0xf5,
+	0x2a, 0x89, 0x6b, 0xf1, 0xa9, 0xe7, 0xf6, 0x81, 0x64, 0x39, 0x74, 0x3a, 0x26, 0xc1, 0x5b, 0x09,
+	0x3a, 0x5c, 0x5d, 0x91, 0x44, 0xcf, 0x40, 0xc9, 0x74, 0x1c, 0xc3, 0x23, 0x26, 0xc1, 0x46, 0xeb,
+	0x8c, 0x60, 0x8f, 0xc5, 0xb2, 0xbc, 0x5e, 0x30, 0x1d, 0xe7, 0x80, 0x52, 0xb7, 0x28, 0x11, 0x3d,
+	0x0d, 0x45, 0x1a, 0xb7, 0x2c, 0xb3, 0x67, 0x74, 0xb1, 0x75, 0xdc, 0x25, 0x2c, 0x66, 0xc5, 0xf5,
+	0x82, 0xa0, 0x36, 0x18, 0x51, 0xeb, 0xf8, 0x3b, 0xce, 0x8d, 0x03, 0x41, 0xa2, 0x63, 0x12, 0x93,
+	0xad, 0x64, 0x5e, 0x67, 0x6d, 0x4a, 0x73, 0x4c, 0xd2, 0x15, 0xeb, 0xc3, 0xda, 0xe8, 0x22, 0xa4,
+	0x84, 0xda, 0x38, 0x53, 0x2b, 0x7a, 0x68, 0x05, 0x92, 0x8e, 0x6b, 0x9f, 0x60, 0xb6, 0x75, 0x19,
+	0x9d, 0x77, 0xb4, 0x9f, 0xc6, 0x60, 0x69, 0x22, 0xba, 0x51, 0xbd, 0x5d, 0xd3, 0xeb, 0xca, 0x7f,
+	0xd1, 0x36, 0x7a, 0x89, 0xea, 0x35, 0x3b, 0xd8, 0x15, 0x19, 0x41, 0x65, 0x72, 0xa9, 0x1b, 0xec,
+	0xbb, 0x58, 0x1a, 0xc1, 0x8d, 0xee, 0x43, 0xb9, 0x67, 0x7a, 0x44, 0x98, 0x8d, 0xa1, 0x64, 0x07,
+	0x4f, 0x4c, 0x2c, 0x32, 0x37, 0x12, 0x7a, 0xa0, 0x85, 0x92, 0x22, 0x15, 0x1d, 0x51, 0xd1, 0x21,
+	0xac, 0xb4, 0xce, 0x3e, 0x31, 0x07, 0xc4, 0x1a, 0x60, 0x63, 0x62, 0xd7, 0x26, 0xd3, 0x8d, 0x77,
+	0x2c, 0xaf, 0x85, 0xbb, 0xe6, 0x89, 0x65, 0xcb, 0x61, 0x2d, 0xfb, 0xf2, 0xfe, 0x8e, 0x7a, 0x9a,
+	0x0e, 0xc5, 0x60, 0x78, 0x46, 0x45, 0x88, 0x91, 0x53, 0x31, 0xff, 0x18, 0x39, 0x45, 0x2f, 0x40,
+	0x82, 0xce, 0x91, 0xcd, 0xbd, 0x38, 0xe5, 0x47, 0x42, 0xae, 0x79, 0xe6, 0x60, 0x9d, 0x71, 0x6a,
+	0x9a, 0x6f, 0x0d, 0x23, 0x0f, 0x32, 0xa6, 0x55, 0xbb, 0x09, 0xa5, 0xb1, 0x98, 0xac, 0x6c, 0x5f,
+	0x54, 0xdd, 0x3e, 0xad, 0x04, 0x85, 0x40, 0x00, 0xd6, 0x2e, 0xc2, 0xca, 0xb4, 0x78, 0xaa, 0x75,
+	0x61, 0x65, 0x5a, 0x5c, 0x44, 0x2f, 0x42, 0xc6, 0x0f, 0xa8, 0xdc, 0x1a, 0x2f, 0x4f, 0xcc, 0x42,
+	0x32, 0xeb, 0x3e, 0x2b, 0x35, 0x43, 0x7a, 0xaa, 0xd9, 0x71, 0x88, 0xb1, 0x81, 0xa7, 0x4d, 0xc7,
+	0x69, 0x98, 0x5e, 0x57, 0xfb, 0x00, 0x2a, 0x61, 0xc1, 0x72, 0x6c, 0x1a, 0x09, 0xff, 0x14, 0x5e,
+	0x84, 0xd4, 0x91, 0xed, 0xf6, 0x4d, 0xc2, 0x94, 0x15, 0x74, 0xd1, 0xa3, 0xa7, 0x93, 0x07, 0xce,
+	0x38, 0x23, 0xf3, 0x8e, 0x66, 0xc0, 0xe5, 0xd0, 0x80, 0x49, 0x45, 0xac, 0x41, 0x07, 0xf3, 0xf5,
+	0x2c, 0xe8, 0xbc, 0x33, 0x52, 0xc4, 0x07, 0xcb, 0x3b, 0xf4, 0xb7, 0x1e, 0x9b, 0x2b, 0xd3, 0x9f,
+	0xd5, 0x45, 0x4f, 0xfb, 0x3c, 0x0e, 0x17, 0xa7, 0x87, 0x4d, 0x74, 0x0d, 0xf2, 0x7d, 0xf3, 0xd4,
+	0x20, 0xa7, 0xc2, 0x96, 0xf9, 0x76, 0x40, 0xdf, 0x3c, 0x6d, 0x9e, 0x72, 0x43, 0x2e, 0x43, 0x9c,
+	0x9c, 0x7a, 0x95, 0xd8, 0xb5, 0xf8, 0x8d, 0xbc, 0x4e, 0x9b, 0xe8, 0x10, 0x96, 0x7a, 0x76, 0xdb,
+	0xec, 0x19, 0xca, 0x89, 0x17, 0x87, 0xfd, 0xa9, 0x89, 0xc5, 0xae, 0x9f, 0x32, 0x4a, 0x67, 0xe2,
+	0xd0, 0x97, 0x98, 0x8e, 0x1d, 0xff, 0xe4, 0xa3, 0xbb, 0x90, 0xeb, 0x8f, 0x0e, 0xf2, 0x39, 0x0e,
+	0xbb, 0x2a, 0xa6, 0x6c, 0x49, 0x32, 0xe0, 0x18, 0xa4, 0x8b, 0x4e, 0x9d, 0xdb, 0x45, 0xbf, 0x00,
+	0x2b, 0x03, 0x7c, 0x4a, 0x14, 0x43, 0xe4, 0xe7, 0x24, 0xcd, 0x96, 0x1e, 0xd1, 0x6f, 0x23, 0x23,
+	0xa3, 0x47, 0x06, 0xdd, 0x64, 0x89, 0x87, 0x63, 0x7b, 0xd8, 0x35, 0xcc, 0x4e, 0xc7, 0xc5, 0x9e,
+	0xc7, 0x12, 0xe6, 0xbc, 0x5e, 0x92, 0xf4, 0x4d, 0x4e, 0xd6, 0x7e, 0xa6, 0x6e, 0x4d, 0x30, 0xd1,
+	0x10, 0x0b, 0x1f, 0x1d, 0x2d, 0xfc, 0x01, 0xac, 0x08, 0xf9, 0x4e, 0x60, 0xed, 0x63, 0x8b, 0x3a,
+	0x1a, 0x24, 0xc5, 0xc3, 0x97, 0x3d, 0xfe, 0xdd, 0x96, 0x5d, 0xfa, 0xd2, 0x84, 0xe2, 0x4b, 0xff,
+	0xcd, 0xb6, 0xe2, 0xd9, 0x91, 0x9b, 0x92, 0xa9, 0x5a, 0x05, 0xd2, 0x2e, 0x27, 0x09, 0x77, 0x26,
+	0xbb, 0xda, 0xa7, 0x51, 0x58, 0x99, 0x96, 0x9d, 0xd1, 0x14, 0x82, 0xc7, 0xc6, 0xc1, 0xb0, 0xdf,
+	0xc2, 0xae, 0x30, 0xa8, 0x1c, 0xa3, 0xed, 0x32, 0x92, 0xbf, 0x56, 0xb1, 0xa9, 0x71, 0x27, 0x7e,
+	0x9e, 0xb8, 0xa3, 0xdd, 0x83, 0xe5, 0x29, 0x49, 0x1d, 0xcd, 0x74, 0xf8, 0x28, 0x46, 0xbe, 0x23,
+	0xae, 0x03, 0x23, 0x6d, 0x53, 0x8a, 0xf0, 0xd1, 0x31, 0xdf, 0x47, 0xdf, 0x81, 0xf2, 0x78, 0x4a,
+	0x37, 0x57, 0x89, 0xf6, 0x47, 0x80, 0x8c, 0x8e, 0x3d, 0xc7, 0x1e, 0x78, 0x18, 0x6d, 0x41, 0x16,
+	0x9f, 0xb6, 0xb1, 0x43, 0x64, 0x76, 0x36, 0x1d, 0x62, 0x72, 0xee, 0xba, 0xe4, 0xa4, 0x79, 0xa3,
+	0x2f, 0x86, 0xee, 0x08, 0x08, 0x1f, 0x8e, 0xc6, 0x85, 0xb8, 0x8a, 0xe1, 0x5f, 0x92, 0x18, 0x3e,
+	0x1e, 0x0a, 0xe9, 0xb8, 0xd4, 0x18, 0x88, 0xbf, 0x23, 0x40, 0x7c, 0x62, 0xce, 0xcf, 0x02, 0x28,
+	0xbe, 0x16, 0x40, 0xf1, 0xa9, 0x39, 0xd3, 0x0c, 0x81, 0xf1, 0x2f, 0x49, 0x18, 0x9f, 0x9e, 0x33,
+	0xe2, 0x31, 0x1c, 0x7f, 0x2f, 0x88, 0xe3, 0x33, 0x21, 0x2e, 0x57, 0x4a, 0x87, 0x02, 0xf9, 0x37,
+	0x14, 0x20, 0x9f, 0x0d, 0x45, 0x30, 0x5c, 0xc9, 0x14, 0x24, 0x5f, 0x0b, 0x20, 0x79, 0x98, 0xb3,
+	0x06, 0x21, 0x50, 0xfe, 0x2d, 0x15, 0xca, 0xe7, 0x42, 0x51, 0x86, 0xd8, 0xef, 0x69, 0x58, 0xfe,
+	0x55, 0x1f, 0xcb, 0xe7, 0x43, 0x8b, 0x11, 0x62, 0x0e, 0xe3, 0x60, 0x7e, 0x6f, 0x02, 0xcc, 0x73,
+	0xf0, 0xfd, 0x4c, 0xa8, 0x8a, 0x39, 0x68, 0x7e, 0x6f, 0x02, 0xcd, 0x17, 0xe7, 0x28, 0x9c, 0x03,
+	0xe7, 0x7f, 0x38, 0x1d, 0xce, 0x87, 0x03, 0x6e, 0x31, 0xcc, 0xc5, 0xf0, 0xbc, 0x11, 0x82, 0xe7,
+	0x39, 0xe6, 0x7e, 0x36, 0x54, 0xfd, 0xc2, 0x80, 0xfe, 0x70, 0x0a, 0xa0, 0xe7, 0xd0, 0xfb, 0x46,
+	0xa8, 0xf2, 0x05, 0x10, 0xfd, 0xe1, 0x14, 0x44, 0x8f, 0xe6, 0xaa, 0x9d, 0x0b, 0xe9, 0xdf, 0x52,
+	0x21, 0xfd, 0xf2, 0xbc, 0xb3, 0x38, 0x0d, 0xd3, 0xbf, 0x09, 0x19, 0xc7, 0xc5, 0x47, 0x98, 0xb4,
+	0xbb, 0x95, 0x95, 0x39, 0x0a, 0xf6, 0x05, 0x23, 0x55, 0x20, 0x85, 0x54, 0xc4, 0x9c, 0x2c, 0xa7,
+	0xb4, 0x9b, 0xb0, 0x24, 0xd9, 0x7d, 0x57, 0x49, 0x93, 0x3e, 0xec, 0xba, 0xb6, 0x2b, 0xb0, 0x2f,
+	0xef, 0x68, 0x37, 0x20, 0xef, 0xb3, 0xce, 0x46, 0xd7, 0x2c, 0xb9, 0x56, 0x5c, 0xa1, 0xf6, 0x9b,
+	0x28, 0xe4, 0x55, 0x2f, 0x17, 0x40, 0x5f, 0x59, 0x81, 0xbe, 0x14, 0xcc, 0x1d, 0x0b, 0x62, 0xee,
+	0x35, 0xc8, 0xd1, 0xa4, 0x79, 0x0c, 0x4e, 0x9b, 0x8e, 0x0f, 0xa7, 0x6f, 0xc1, 0x12, 0x4b, 0x53,
+	0x38, 0x32, 0x17, 0xb9, 0x40, 0x82, 0x85, 0x91, 0x12, 0xfd, 0xc0, 0x6d, 0x9a, 0x91, 0xd1, 0xf3,
+	0xb0, 0xac, 0xf0, 0xfa, 0xc9, 0x38, 0xc7, 0x96, 0x65, 0x9f, 0x7b, 0x53, 0x64, 0xe5, 0xbf, 0x8b,
+	0xc2, 0xd2, 0x84, 0x97, 0x9d, 0x0a, 0x99, 0xa3, 0xdf, 0x13, 0x64, 0x8e, 0x7d, 0x67, 0xc8, 0xac,
+	0x82, 0x8b, 0x78, 0x10, 0x5c, 0xfc, 0x3d, 0x3a, 0xda, 0x13, 0x1f, 0x00, 0xb7, 0xed, 0x0e, 0x16,
+	0xe9, 0x3e, 0x6b, 0xd3, 0x4c, 0xb0, 0x67, 0x1f, 0x8b, 0xa4, 0x9e, 0x36, 0x29, 0x97, 0x1f, 0xbb,
+	0xb2, 0x22, 0x34, 0xf9, 0x48, 0x81, 0x67, 0x5b, 0xbc, 0x43, 0x65, 0x1f, 0x61, 0x5e, 0x30, 0xce,
+	0xeb, 0xb4, 0x89, 0x56, 0xc4, 0x51, 0x13, 0x59, 0x13, 0xef, 0xa0, 0x57, 0x20, 0xcb, 0x4a, 0xfd,
+	0x86, 0xed, 0x78, 0x95, 0xcc, 0x64, 0x42, 0xc9, 0x2b, 0xfa, 0xeb, 0xfb, 0x94, 0x67, 0xcf, 0xf1,
+	0xe8, 0xd1, 0xe5, 0x2d, 0x25, 0xcd, 0xcb, 0x06, 0xd2, 0xbc, 0x2b, 0x90, 0xa5, 0xa3, 0xf7, 0x1c,
+	0xb3, 0x8d, 0x59, 0x94, 0xc8, 0xea, 0x23, 0x82, 0xf6, 0x63, 0x40, 0x93, 0x71, 0x0a, 0x35, 0x20,
+	0x85, 0x4f, 0xf0, 0x80, 0xf0, 0xb4, 0x37, 0xb7, 0x71, 0x71, 0x12, 0x4f, 0xd0, 0xcf, 0x5b, 0x15,
+	0xba, 0xc8, 0x7f, 0xfd, 0x7a, 0xad, 0xcc, 0xb9, 0x9f, 0xb3, 0xfb, 0x16, 0xc1, 0x7d, 0x87, 0x9c,
+	0xe9, 0x42, 0x1e, 0x5d, 0x05, 0xc0, 0xa7, 0xc4, 0x35, 0x0d, 0x76, 0xa0, 0x79, 0xa2, 0x93, 0x65,
+	0x94, 0xbb, 0x26, 0x31, 0xb5, 0xbf, 0xc5, 0xa0, 0x24, 0xff, 0x2f, 0xd1, 0xf0, 0xb4, 0xa5, 0x97,
+	0x16, 0x11, 0x53, 0xea, 0x11, 0x8b, 0x6d, 0xc7, 0x2a, 0xc0, 0xb1, 0xe9, 0x19, 0x1f, 0x9b, 0x03,
+	0x82, 0x3b, 0x62, 0x4f, 0x14, 0x0a, 0xaa, 0x42, 0x86, 0xf6, 0x86, 0x1e, 0xee, 0x88, 0xd2, 0x88,
+	0xdf, 0x57, 0x96, 0x21, 0xfd, 0x4f, 0x2e, 0x43, 0x60, 0x13, 0x32, 0x63, 0x9b, 0xa0, 0x00, 0xc6,
+	0xac, 0x0a, 0x18, 0xe9, 0xd8, 0x1c, 0xd7, 0xb2, 0x5d, 0x8b, 0x9c, 0xb1, 0x9d, 0x8b, 0xeb, 0x7e,
+	0x9f, 0x56, 0xda, 0xfa, 0xb8, 0xef, 0xd8, 0x76, 0xcf, 0xe0, 0xde, 0x28, 0xc7, 0x44, 0xf3, 0x82,
+	0x58, 0xa7, 0x34, 0x7a, 0xc6, 0x06, 0xf6, 0xa0, 0x8d, 0x59, 0x68, 0x4e, 0xe8, 0xbc, 0xa3, 0x7d,
+	0x1a, 0x83, 0xa5, 0x89, 0xb4, 0xe0, 0x3f, 0x6f, 0xd9, 0xb5, 0x5f, 0xb3, 0x1a, 0x62, 0x30, 0xb5,
+	0x41, 0x07, 0xb0, 0xe4, 0xfb, 0x0c, 0x63, 0xc8, 0x7c, 0x89, 0xb4, 0x82, 0x45, 0x9d, 0x4e, 0xf9,
+	0x24, 0x48, 0xf6, 0xd0, 0x43, 0xb8, 0x34, 0xe6, 0x10, 0x7d, 0xd5, 0xb1, 0x45, 0xfd, 0xe2, 0x85,
+	0xa0, 0x5f, 0x94, 0xaa, 0x47, 0x8b, 0x15, 0xff, 0x5e, 0x4d, 0x35, 0x31, 0x6e, 0xaa, 0x8f, 0xa3,
+	0x50, 0x94, 0xab, 0x25, 0x90, 0xc9, 0xb4, 0xe3, 0xf1, 0x14, 0x14, 0x5c, 0x4c, 0x68, 0x29, 0x35,
+	0x50, 0x18, 0xcc, 0x73, 0xa2, 0x88, 0x32, 0x75, 0x28, 0xba, 0x98, 0xe7, 0xbe, 0x47, 0x56, 0x8f,
+	0x60, 0xb7, 0x92, 0x08, 0x4d, 0xc1, 0x19, 0xdb, 0x3d, 0xc6, 0xa5, 0x17, 0x5c, 0xb5, 0xab, 0xed,
+	0xc3, 0x85, 0xa9, 0x89, 0x21, 0x7a, 0x19, 0xb2, 0xa3, 0x9c, 0x92, 0x6f, 0xde, 0x8c, 0xfa, 0xd3,
+	0x88, 0x57, 0xfb, 0x6d, 0x14, 0x2e, 0x4c, 0x4d, 0x0d, 0x51, 0x1d, 0x52, 0x2e, 0xf6, 0x86, 0x3d,
+	0x0e, 0x41, 0x8b, 0x1b, 0xcf, 0x2f, 0x96, 0x52, 0x52, 0xea, 0xb0, 0x47, 0x74, 0x21, 0xac, 0xbd,
+	0x0f, 0x29, 0x4e, 0x41, 0x39, 0x48, 0x1f, 0xee, 0xde, 0xdf, 0xdd, 0x7b, 0x6f, 0xb7, 0x1c, 0x41,
+	0x00, 0xa9, 0xcd, 0x5a, 0xad, 0xbe, 0xdf, 0x2c, 0x47, 0x51, 0x16, 0x92, 0x9b, 0x5b, 0x7b, 0x7a,
+	0xb3, 0x1c, 0xa3, 0x64, 0xbd, 0xfe, 0x76, 0xbd, 0xd6, 0x2c, 0xc7, 0xd1, 0x12, 0x14, 0x78, 0xdb,
+	0xb8, 0xb7, 0xa7, 0xbf, 0xb3, 0xd9, 0x2c, 0x27, 0x14, 0xd2, 0x41, 0x7d, 0xf7, 0x6e, 0x5d, 0x2f,
+	0x27, 0xb5, 0xff, 0x86, 0xcb, 0x72, 0x1c, 0x93, 0x75, 0x32, 0xbf, 0x5c, 0x15, 0x55, 0xca, 0x55,
+	0xda, 0xe7, 0x31, 0xa8, 0x86, 0x67, 0x96, 0xe8, 0xed, 0xb1, 0x89, 0x6f, 0x9c, 0x23, 0x2d, 0x1d,
+	0x9b, 0x3d, 0xad, 0x46, 0x8b, 0x4c, 0x8b, 0x67, 0xba, 0x3c, 0x9c, 0x17, 0xf4, 0x82, 0xa0, 0x32,
+	0x21, 0x8f, 0xb3, 0x7d, 0x88, 0xdb, 0xc4, 0xe0, 0x8e, 0x90, 0x9f, 0xed, 0xac, 0x5e, 0xe0, 0xd4,
+	0x03, 0x4e, 0xd4, 0x3e, 0x38, 0xd7, 0x5a, 0x66, 0x21, 0xa9, 0xd7, 0x9b, 0xfa, 0xc3, 0x72, 0x1c,
+	0x21, 0x28, 0xb2, 0xa6, 0x71, 0xb0, 0xbb, 0xb9, 0x7f, 0xd0, 0xd8, 0xa3, 0x6b, 0xb9, 0x0c, 0x25,
+	0xb9, 0x96, 0x92, 0x98, 0xd4, 0x9e, 0x85, 0x4b, 0x21, 0x69, 0xf1, 0x64, 0x59, 0x48, 0xfb, 0x79,
+	0x54, 0xe5, 0x0e, 0xa6, 0xb6, 0x7b, 0x90, 0xf2, 0x88, 0x49, 0x86, 0x9e, 0x58, 0xc4, 0x97, 0x17,
+	0xcd, 0x93, 0xd7, 0x65, 0xe3, 0x80, 0x89, 0xeb, 0x42, 0x8d, 0xf6, 0x22, 0x14, 0x83, 0x5f, 0xc2,
+	0xd7, 0x60, 0x74, 0x88, 0x62, 0x9a, 0xab, 0x78, 0x3c, 0x99, 0x34, 0xcf, 0x48, 0x75, 0x62, 0x23,
+	0x27, 0x1f, 0x70, 0xa5, 0xf1, 0xf1, 0x08, 0x56, 0x85, 0x8c, 0x2b, 0xf4, 0x0a, 0xcf, 0xe1, 0xf7,
+	0xb5, 0xd7, 0xa1, 0x3c, 0x9e, 0x73, 0x4f, 0xfd, 0xa7, 0x9f, 0x57, 0xc7, 0xd4, 0xbc, 0xfa, 0x21,
+	0x80, 0x52, 0x92, 0x5f, 0x81, 0xa4, 0x6b, 0x0f, 0x07, 0x1d, 0x26, 0x98, 0xd4, 0x79, 0x87, 0xde,
+	0xc7, 0x9f, 0xd8, 0xdc, 0x99, 0x4e, 0x37, 0xf5, 0x07, 0x36, 0xc1, 0x4a, 0xfd, 0x8d, 0x73, 0x6b,
+	0x16, 0xa0, 0xc9, 0xb2, 0x68, 0xc8, 0x2f, 0xde, 0x08, 0xfe, 0xe2, 0xc9, 0xd0, 0x02, 0xeb, 0xf4,
+	0x5f, 0x7d, 0x02, 0x49, 0xe6, 0x86, 0xe9, 0xc4, 0x59, 0x69, 0x5f, 0xa4, 0xf6, 0xb4, 0x8d, 0x7e,
+	0x04, 0x60, 0x12, 0xe2, 0x5a, 0xad, 0xe1, 0xe8, 0x07, 0x6b, 0xd3, 0xdd, 0xf8, 0xa6, 0xe4, 0xdb,
+	0xba, 0x22, 0xfc, 0xf9, 0xca, 0x48, 0x54, 0xf1, 0xe9, 0x8a, 0x42, 0x6d, 0x17, 0x8a, 0x41, 0x59,
+	0x99, 0x8c, 0xf2, 0x31, 0x04, 0x93, 0x51, 0xb1, 0xf6, 0xac, 0x33, 0x4a, 0x65, 0xe3, 0xfc, 0x16,
+	0x87, 0x75, 0xb4, 0xcf, 0xa2, 0x90, 0x69, 0x9e, 0x0a, 0xcb, 0x0b, 0xb9, 0x41, 0x18, 0x89, 0xc6,
+	0xd4, 0x7a, 0x39, 0x2f, 0x77, 0xc5, 0xfd, 0x8b, 0x8e, 0xb7, 0x7c, 0xdf, 0x92, 0x58, 0xb4, 0x7c,
+	0x21, 0x0b, 0x6f, 0xc2, 0x9f, 0xde, 0xa4, 0x89, 0xbb, 0x12, 0x13, 0x28, 0x4e, 0x92, 0x4e, 0x23,
+	0xca, 0x9c, 0x86, 0xec, 0x6a, 0xaf, 0x41, 0xd6, 0x8f, 0xd7, 0x94, 0x4d, 0xd6, 0x21, 0x45, 0x49,
+	0x51, 0x74, 0xe9, 0xc8, 0x1d, 0xfb, 0x63, 0x51, 0x01, 0x8c, 0xeb, 0xbc, 0xa3, 0xfd, 0x2a, 0x0a,
+	0xa5, 0xb1, 0x68, 0x8f, 0x5e, 0x83, 0xb4, 0x33, 0x6c, 0x19, 0x72, 0x29, 0xc7, 0xca, 0xb5, 0x32,
+	0x53, 0x1f, 0xb6, 0x7a, 0x56, 0xfb, 0x3e, 0x3e, 0x93, 0x03, 0x77, 0x86, 0xad, 0xfb, 0x7c, 0xc5,
+	0xf9, 0x6f, 0x62, 0xca, 0x6f, 0xd0, 0x25, 0x48, 0xb7, 0x7a, 0x1e, 0x53, 0xc9, 0x57, 0x29, 0xd5,
+	0xea, 0x79, 0x94, 0xfd, 0x3a, 0x94, 0x5c, 0xdc, 0x33, 0xcf, 0x94, 0xfa, 0x29, 0xb7, 0xb3, 0xa2,
+	0x20, 0xcb, 0xf2, 0xe9, 0x09, 0x64, 0xe4, 0x11, 0x44, 0xff, 0x0b, 0x59, 0x3f, 0x15, 0xf1, 0x2f,
+	0x45, 0x43, 0x73, 0x18, 0x31, 0xc0, 0x91, 0x08, 0x05, 0x8e, 0x9e, 0x75, 0x3c, 0x90, 0x65, 0x6e,
+	0x5e, 0x24, 0x8a, 0xb1, 0xb3, 0x50, 0xe2, 0x1f, 0x76, 0x24, 0x20, 0xd4, 0x7e, 0x19, 0x85, 0xf2,
+	0xb8, 0x0d, 0xfc, 0x2b, 0x07, 0x40, 0x83, 0x06, 0xb5, 0x35, 0x03, 0xd3, 0x41, 0xf8, 0x48, 0x38,
+	0xaf, 0x17, 0x28, 0xb5, 0x2e, 0x89, 0xf4, 0x0e, 0x32, 0xa7, 0x14, 0xd1, 0xd1, 0xff, 0x28, 0x06,
+	0x59, 0x9c, 0x92, 0xe2, 0x29, 0xbc, 0xa3, 0xfb, 0xb6, 0xe0, 0xc4, 0x62, 0xe7, 0x9f, 0x58, 0xd8,
+	0xbd, 0xa9, 0xac, 0xc9, 0x27, 0xce, 0x5d, 0x93, 0x7f, 0x0e, 0x10, 0xb1, 0x89, 0xd9, 0x33, 0x4e,
+	0x6c, 0x62, 0x0d, 0x8e, 0x0d, 0x7e, 0xb8, 0x78, 0xe2, 0x5d, 0x66, 0x5f, 0x1e, 0xb0, 0x0f, 0xfb,
+	0xec, 0x38, 0xff, 0x24, 0x0a, 0x19, 0x3f, 0xb5, 0x39, 0xef, 0xf5, 0xd9, 0x45, 0x48, 0x89, 0xe8,
+	0xcd, 0xef, 0xcf, 0x44, 0x6f, 0xea, 0xe5, 0x43, 0x15, 0x32, 0x7d, 0x4c, 0x4c, 0x96, 0x26, 0xf2,
+	0x22, 0x82, 0xdf, 0xbf, 0xf5, 0x2a, 0xe4, 0x94, 0x9b, 0x4c, 0xea, 0x95, 0x76, 0xeb, 0xef, 0x95,
+	0x23, 0xd5, 0xf4, 0x67, 0x5f, 0x5c, 0x8b, 0xef, 0xe2, 0x8f, 0xa9, 0x91, 0xea, 0xf5, 0x5a, 0xa3,
+	0x5e, 0xbb, 0x5f, 0x8e, 0x56, 0x73, 0x9f, 0x7d, 0x71, 0x2d, 0x2d, 0x6c, 0xfd, 0xd6, 0x7d, 0x28,
+	0x8d, 0x6d, 0x4c, 0x30, 0xfe, 0x21, 0x28, 0xde, 0x3d, 0xdc, 0xdf, 0xd9, 0xae, 0x6d, 0x36, 0xeb,
+	0xc6, 0x83, 0xbd, 0x66, 0xbd, 0x1c, 0x45, 0x97, 0x60, 0x79, 0x67, 0xfb, 0xff, 0x1a, 0x4d, 0xa3,
+	0xb6, 0xb3, 0x5d, 0xdf, 0x6d, 0x1a, 0x9b, 0xcd, 0xe6, 0x66, 0xed, 0x7e, 0x39, 0xb6, 0xf1, 0x87,
+	0x02, 0x94, 0x36, 0xb7, 0x6a, 0xdb, 0x34, 0x7f, 0xb1, 0xda, 0x26, 0x2b, 0xf2, 0xd4, 0x20, 0xc1,
+	0xca, 0x38, 0x33, 0xdf, 0xaf, 0x55, 0x67, 0x97, 0xc6, 0xd1, 0x3d, 0x48, 0xb2, 0x0a, 0x0f, 0x9a,
+	0xfd, 0xa0, 0xad, 0x3a, 0xa7, 0x56, 0x4e, 0x07, 0xc3, 0xcc, 0x69, 0xe6, 0x0b, 0xb7, 0xea, 0xec,
+	0xd2, 0x39, 0xd2, 0x21, 0x3b, 0x02, 0x7b, 0xf3, 0x5f, 0x7c, 0x55, 0x17, 0xf0, 0xc5, 0x68, 0x07,
+	0xd2, 0x12, 0xb5, 0xcf, 0x7b, 0x83, 0x56, 0x9d, 0x5b, 0xdb, 0xa6, 0xcb, 0xc5, 0x33, 0x92, 0xd9,
+	0x0f, 0xea, 0xaa, 0x73, 0x0a, 0xf5, 0x68, 0x1b, 0x52, 0x02, 0xa0, 0xcc, 0x79, 0x57, 0x56, 0x9d,
+	0x57, 0xab, 0xa6, 0x8b, 0x36, 0x2a, 0x6b, 0xcd, 0x7f, 0x26, 0x58, 0x5d, 0xe0, 0x0e, 0x02, 0x1d,
+	0x02, 0x28, 0xa5, 0x96, 0x05, 0xde, 0xff, 0x55, 0x17, 0xb9, 0x5b, 0x40, 0x7b, 0x90, 0xf1, 0x41,
+	0xec, 0xdc, 0xd7, 0x78, 0xd5, 0xf9, 0x45, 0x7e, 0xf4, 0x3e, 0x14, 0x82, 0xa8, 0x6a, 0xb1, 0x37,
+	0x76, 0xd5, 0x05, 0xab, 0xf7, 0x54, 0x7f, 0x10, 0x62, 0x2d, 0xf6, 0xe6, 0xae, 0xba, 0x60, 0x31,
+	0x1f, 0x7d, 0x08, 0x4b, 0x93, 0x10, 0x68, 0xf1, 0x27, 0x78, 0xd5, 0x73, 0x94, 0xf7, 0x51, 0x1f,
+	0xd0, 0x14, 0xe8, 0x74, 0x8e, 0x17, 0x79, 0xd5, 0xf3, 0x54, 0xfb, 0x51, 0x07, 0x4a, 0xe3, 0x78,
+	0x64, 0xd1, 0x17, 0x7a, 0xd5, 0x85, 0x2b, 0xff, 0xfc, 0x2f, 0x41, 0x1c, 0xb3, 0xe8, 0x8b, 0xbd,
+	0xea, 0xc2, 0x17, 0x01, 0xec, 0xdc, 0x4a, 0x28, 0x32, 0xf7, 0x05, 0x5f, 0x75, 0xfe, 0x85, 0x00,
+	0x7a, 0x08, 0x85, 0xe0, 0x5d, 0xf0, 0x62, 0x0f, 0xfa, 0xaa, 0xf3, 0xaf, 0x0a, 0xd0, 0x7b, 0x90,
+	0x0f, 0xdc, 0xef, 0x2e, 0xf4, 0xb4, 0x6f, 0x11, 0xc5, 0xef, 0x42, 0x76, 0x74, 0xe1, 0x3b, 0xff,
+	0x99, 0xdf, 0x02, 0x2a, 0xb7, 0x36, 0xbf, 0xfc, 0x66, 0x35, 0xfa, 0xd5, 0x37, 0xab, 0xd1, 0xbf,
+	0x7c, 0xb3, 0x1a, 0x7d, 0xfc, 0xed, 0x6a, 0xe4, 0xab, 0x6f, 0x57, 0x23, 0x7f, 0xfa, 0x76, 0x35,
+	0xf2, 0xff, 0xd7, 0x8f, 0x2d, 0xd2, 0x1d, 0xb6, 0xd6, 0xdb, 0x76, 0xff, 0x76, 0xdb, 0xee, 0x63,
+	0xd2, 0x3a, 0x22, 0xa3, 0xc6, 0xe8, 0x79, 0x7a, 0x2b, 0xc5, 0xf2, 0x8e, 0x3b, 0xff, 0x18, 0x00,
+	0x68, 0xd5, 0xfe, 0xec, 0xbe, 0x2e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6990,6 +7061,11 @@ func (m *ResponseCheckTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x60
+	}
 	if len(m.MempoolError) > 0 {
 		i -= len(m.MempoolError)
 		copy(dAtA[i:], m.MempoolError)
@@ -7239,6 +7315,18 @@ func (m *ResponseCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RecheckFilter != nil {
+		{
+			size, err := m.RecheckFilter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.RetainHeight != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.RetainHeight))
 		i--
@@ -7379,20 +7467,20 @@ func (m *ResponseApplySnapshotChunk) MarshalToSizedBuffer(dAtA []byte) (int, err
 		}
 	}
 	if len(m.RefetchChunks) > 0 {
-		dAtA54 := make([]byte, len(m.RefetchChunks)*10)
-		var j53 int
+		dAtA55 := make([]byte, len(m.RefetchChunks)*10)
+		var j54 int
 		for _, num := range m.RefetchChunks {
 			for num >= 1<<7 {
-				dAtA54[j53] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA55[j54] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j53++
+				j54++
 			}
-			dAtA54[j53] = uint8(num)
-			j53++
+			dAtA55[j54] = uint8(num)
+			j54++
 		}
-		i -= j53
-		copy(dAtA[i:], dAtA54[:j53])
-		i = encodeVarintTypes(dAtA, i, uint64(j53))
+		i -= j54
+		copy(dAtA[i:], dAtA55[:j54])
+		i = encodeVarintTypes(dAtA, i, uint64(j54))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -7773,6 +7861,38 @@ func (m *TxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RecheckFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecheckFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecheckFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Senders) > 0 {
+		for iNdEx := len(m.Senders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Senders[iNdEx])
+			copy(dAtA[i:], m.Senders[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Senders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Validator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7978,12 +8098,12 @@ func (m *Misbehavior) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n59, err59 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err59 != nil {
-		return 0, err59
+	n60, err60 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err60 != nil {
+		return 0, err60
 	}
-	i -= n59
-	i = encodeVarintTypes(dAtA, i, uint64(n59))
+	i -= n60
+	i = encodeVarintTypes(dAtA, i, uint64(n60))
 	i--
 	dAtA[i] = 0x22
 	if m.Height != 0 {
@@ -9133,6 +9253,9 @@ func (m *ResponseCheckTx) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
 	return n
 }
 
@@ -9218,6 +9341,10 @@ func (m *ResponseCommit) Size() (n int) {
 	if m.RetainHeight != 0 {
 		n += 1 + sovTypes(uint64(m.RetainHeight))
 	}
+	if m.RecheckFilter != nil {
+		l = m.RecheckFilter.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -9449,6 +9576,21 @@ func (m *TxResult) Size() (n int) {
 	return n
 }
 
+func (m *RecheckFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Senders) > 0 {
+		for _, s := range m.Senders {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Validator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -14912,6 +15054,25 @@ func (m *ResponseCheckTx) Unmarshal(dAtA []byte) error {
 			}
 			m.MempoolError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -15474,6 +15635,42 @@ func (m *ResponseCommit) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecheckFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RecheckFilter == nil {
+				m.RecheckFilter = &RecheckFilter{}
+			}
+			if err := m.RecheckFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -16939,6 +17136,88 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RecheckFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecheckFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecheckFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Senders = append(m.Senders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Validator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -203,26 +203,41 @@ func (pool *BlockPool) PeekTwoBlocks() (first *types.Block, second *types.Block)
 	return
 }
 
-// PopRequest pops the first block at pool.height.
+// PeekBlock returns the block at the given height, or nil if it hasn't been received yet.
+// The caller will verify the commit.
+func (pool *BlockPool) PeekBlock(height int64) *types.Block {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+
+	if r := pool.requesters[height]; r != nil {
+		return r.getBlock()
+	}
+	return nil
+}
+
+// PopRequest pops the first block at pool.height, and returns the ID of the
+// peer it was received from.
 // It must have been validated by 'second'.Commit from PeekTwoBlocks().
-func (pool *BlockPool) PopRequest() {
+func (pool *BlockPool) PopRequest() p2p.ID {
 	pool.mtx.Lock()
 	defer pool.mtx.Unlock()
 
-	if r := pool.requesters[pool.height]; r != nil {
-		/*  The block can disappear at any time, due to removePeer().
-		if r := pool.requesters[pool.height]; r == nil || r.block == nil {
-			PanicSanity("PopRequest() requires a valid block")
-		}
-		*/
-		if err := r.Stop(); err != nil {
-			pool.Logger.Error("Error stopping requester", "err", err)
-		}
-		delete(pool.requesters, pool.height)
-		pool.height++
-	} else {
+	r := pool.requesters[pool.height]
+	if r == nil {
 		panic(fmt.Sprintf("Expected requester to pop, got nothing at height %v", pool.height))
 	}
+	/*  The block can disappear at any time, due to removePeer().
+	if r := pool.requesters[pool.height]; r == nil || r.block == nil {
+		PanicSanity("PopRequest() requires a valid block")
+	}
+	*/
+	peerID := r.getPeerID()
+	if err := r.Stop(); err != nil {
+		pool.Logger.Error("Error stopping requester", "err", err)
+	}
+	delete(pool.requesters, pool.height)
+	pool.height++
+	return peerID
 }
 
 // RedoRequest invalidates the block at pool.height,
@@ -152,6 +152,7 @@ func (bcR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			RecvBufferCapacity:  50 * 4096,
 			RecvMessageCapacity: MaxMsgSize,
 			MessageType:         &bcproto.Message{},
+			Compress:            true,
 		},
 	}
 }
@@ -206,6 +207,7 @@ func (bcR *Reactor) respondToPeer(msg *bcproto.BlockRequest,
 func (bcR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 	if err := ValidateMsg(e.Message); err != nil {
 		bcR.Logger.Error("Peer sent us invalid msg", "peer", e.Src, "msg", e.Message, "err", err)
+		bcR.Switch.MarkPeerBadEvent(e.Src, err)
 		bcR.Switch.StopPeerForError(e.Src, err)
 		return
 	}
@@ -264,6 +266,9 @@ func (bcR *Reactor) poolRoutine(stateSynced bool) {
 
 	didProcessCh := make(chan struct{}, 1)
 
+	// the block verified for prefetching, which isn't verified again when it's applied
+	var next *verifiedBlock
+
 	go func() {
 		for {
 			select {
@@ -347,21 +352,33 @@ FOR_LOOP:
 				didProcessCh <- struct{}{}
 			}
 
-			firstParts, err := first.MakePartSet(types.BlockPartSizeBytes)
-			if err != nil {
-				bcR.Logger.Error("failed to make ",
-					"height", first.Height,
-					"err", err.Error())
-				break FOR_LOOP
+			var (
+				err        error
+				firstParts *types.PartSet
+				firstID    types.BlockID
+			)
+			// a block verified for prefetching with the same commit is already verified
+			verified := next != nil && next.block == first && next.commit == second.LastCommit
+			if verified {
+				firstParts, firstID = next.parts, next.id
+			} else {
+				firstParts, err = first.MakePartSet(types.BlockPartSizeBytes)
+				if err != nil {
+					bcR.Logger.Error("failed to make ",
+						"height", first.Height,
+						"err", err.Error())
+					break FOR_LOOP
+				}
+				firstPartSetHeader := firstParts.Header()
+				firstID = types.BlockID{Hash: first.Hash(), PartSetHeader: firstPartSetHeader}
+				// Finally, verify the first block using the second's commit
+				// NOTE: we can probably make this more efficient, but note that calling
+				// first.Hash() doesn't verify the tx contents, so MakePartSet() is
+				// currently necessary.
+				err = state.Validators.VerifyCommitLight(
+					chainID, firstID, first.Height, second.LastCommit)
 			}
-			firstPartSetHeader := firstParts.Header()
-			firstID := types.BlockID{Hash: first.Hash(), PartSetHeader: firstPartSetHeader}
-			// Finally, verify the first block using the second's commit
-			// NOTE: we can probably make this more efficient, but note that calling
-			// first.Hash() doesn't verify the tx contents, so MakePartSet() is
-			// currently necessary.
-			err = state.Validators.VerifyCommitLight(
-				chainID, firstID, first.Height, second.LastCommit)
+			next = nil
 
 			if err == nil {
 				// validate the block before we persist it
@@ -375,6 +392,7 @@ FOR_LOOP:
 				if peer != nil {
 					// NOTE: we've already removed the peer's request, but we
 					// still need to clean up the rest.
+					bcR.Switch.MarkPeerBadEvent(peer, err)
 					bcR.Switch.StopPeerForError(peer, fmt.Errorf("Reactor validation error: %v", err))
 				}
 				peerID2 := bcR.pool.RedoRequest(second.Height)
@@ -382,19 +400,28 @@ FOR_LOOP:
 				if peer2 != nil && peer2 != peer {
 					// NOTE: we've already removed the peer's request, but we
 					// still need to clean up the rest.
+					bcR.Switch.MarkPeerBadEvent(peer2, err)
 					bcR.Switch.StopPeerForError(peer2, fmt.Errorf("Reactor validation error: %v", err))
 				}
 				continue FOR_LOOP
 			}
 
-			bcR.pool.PopRequest()
+			if peer := bcR.Switch.Peers().Get(bcR.pool.PopRequest()); peer != nil {
+				bcR.Switch.MarkPeerGoodEvent(peer)
+			}
 
 			// TODO: batch saves so we dont persist to disk every block
 			bcR.store.SaveBlock(first, firstParts, second.LastCommit)
 
 			// TODO: same thing for app - but we would need a way to
 			// get the hash without persisting the state
-			state, _, err = bcR.blockExec.ApplyBlock(state, firstID, first, bcR.skipAppHashVerify)
+			// The second block is prefetched while the first one is being applied,
+			// but only once its commit has been verified.
+			var prefetch *types.Block
+			if next = bcR.verifyNextBlock(state, second); next != nil {
+				prefetch = second
+			}
+			state, _, err = bcR.blockExec.ApplyBlockWithNext(state, firstID, first, prefetch, bcR.skipAppHashVerify)
 			if err != nil {
 				// TODO This is bad, are we zombie?
 				panic(fmt.Sprintf("Failed to process committed block (%d:%X): %v", first.Height, first.Hash(), err))
@@ -416,6 +443,34 @@ FOR_LOOP:
 	}
 }
 
+// verifiedBlock is a block whose commit has been verified, with its part set and ID.
+type verifiedBlock struct {
+	block  *types.Block
+	parts  *types.PartSet
+	id     types.BlockID
+	commit *types.Commit
+}
+
+// verifyNextBlock verifies the block following the one being applied with the commit of the block
+// after it, so that only committed blocks are prefetched. It returns nil if the block can't be verified.
+func (bcR *Reactor) verifyNextBlock(state sm.State, next *types.Block) *verifiedBlock {
+	third := bcR.pool.PeekBlock(next.Height + 1)
+	if third == nil {
+		return nil
+	}
+	nextParts, err := next.MakePartSet(types.BlockPartSizeBytes)
+	if err != nil {
+		return nil
+	}
+	nextID := types.BlockID{Hash: next.Hash(), PartSetHeader: nextParts.Header()}
+	// the state hasn't been updated with the applied block yet, so the next block is signed by
+	// the next validators
+	if err := state.NextValidators.VerifyCommitLight(state.ChainID, nextID, next.Height, third.LastCommit); err != nil {
+		return nil
+	}
+	return &verifiedBlock{block: next, parts: nextParts, id: nextID, commit: third.LastCommit}
+}
+
 // BroadcastStatusRequest broadcasts `BlockStore` base and height.
 func (bcR *Reactor) BroadcastStatusRequest() {
 	bcR.Switch.BroadcastEnvelope(p2p.Envelope{
@@ -333,3 +333,29 @@ func (app *testApp) Commit() abci.ResponseCommit {
 func (app *testApp) Query(reqQuery abci.RequestQuery) (resQuery abci.ResponseQuery) {
 	return
 }
+
+func TestReactorVerifyNextBlock(t *testing.T) {
+	config = cfg.ResetTestRoot("blockchain_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := randGenesisDoc(1, false, 30)
+
+	pair := newReactor(t, log.TestingLogger(), genDoc, privVals, 3)
+	defer pair.app.Stop() //nolint:errcheck // ignore for tests
+	bcR := pair.reactor
+	state := bcR.initialState
+	next, third := bcR.store.LoadBlock(2), bcR.store.LoadBlock(3)
+
+	// the next block isn't verified without the block after it
+	assert.Nil(t, bcR.verifyNextBlock(state, next))
+
+	// nor with a commit for another block
+	bcR.pool.requesters[3] = &bpRequester{block: next}
+	assert.Nil(t, bcR.verifyNextBlock(state, next))
+
+	bcR.pool.requesters[3] = &bpRequester{block: third}
+	verified := bcR.verifyNextBlock(state, next)
+	require.NotNil(t, verified)
+	assert.Equal(t, third.LastCommit.BlockID, verified.id)
+	assert.Equal(t, third.LastCommit.BlockID.PartSetHeader, verified.parts.Header())
+	assert.Same(t, third.LastCommit, verified.commit)
+}
@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/mempool"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+)
+
+var mempoolRPCAddr string
+
+// MempoolCmd contains the subcommands to export the txs in the mempool of a
+// node, and to import them into another node.
+var MempoolCmd = &cobra.Command{
+	Use:   "mempool",
+	Short: "Export and import the transactions in the mempool of a node",
+}
+
+var exportMempoolCmd = &cobra.Command{
+	Use:   "export [file]",
+	Short: "Write the transactions in the mempool of a running node to a file",
+	Long: `
+Write all the transactions in the mempool of a running node, with their height,
+gas wanted, sender and priority, to a new file. The file is written by the node
+through the unsafe_export_mempool RPC endpoint, so the unsafe RPC endpoints must
+be enabled, and the file is created on the node's host.
+`,
+	Example: `
+	cometbft mempool export mempool.snapshot --rpc-laddr tcp://127.0.0.1:26657
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: exportMempool,
+}
+
+var importMempoolCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Check the transactions of a file written by export on a running node",
+	Long: `
+Send the transactions of a file written by "cometbft mempool export" to a running
+node through the broadcast_tx_sync RPC endpoint, in the order they were written,
+so that they are checked and added to its mempool.
+`,
+	Example: `
+	cometbft mempool import mempool.snapshot --rpc-laddr tcp://127.0.0.1:26657
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: importMempool,
+}
+
+func init() {
+	MempoolCmd.PersistentFlags().StringVar(
+		&mempoolRPCAddr,
+		"rpc-laddr",
+		"tcp://127.0.0.1:26657",
+		"the CometBFT node's RPC address (<host>:<port>)",
+	)
+
+	MempoolCmd.AddCommand(exportMempoolCmd)
+	MempoolCmd.AddCommand(importMempoolCmd)
+}
+
+func exportMempool(_ *cobra.Command, args []string) error {
+	path, err := filepath.Abs(args[0])
+	if err != nil {
+		return err
+	}
+
+	client, err := rpcclient.New(mempoolRPCAddr)
+	if err != nil {
+		return fmt.Errorf("failed to create RPC client: %w", err)
+	}
+	result := new(ctypes.ResultUnsafeExportMempool)
+	params := map[string]interface{}{"path": path}
+	if _, err := client.Call(context.Background(), "unsafe_export_mempool", params, result); err != nil {
+		return fmt.Errorf("failed to export mempool: %w", err)
+	}
+
+	fmt.Printf("Exported %d txs to %s\n", result.NumTxs, result.Path)
+	return nil
+}
+
+func importMempool(_ *cobra.Command, args []string) error {
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	client, err := rpchttp.New(mempoolRPCAddr, "/websocket")
+	if err != nil {
+		return fmt.Errorf("failed to create RPC client: %w", err)
+	}
+
+	var accepted, rejected int
+	err = mempool.ReadSnapshot(f, func(stx mempool.SnapshotTx) error {
+		res, err := client.BroadcastTxSync(context.Background(), stx.Tx)
+		switch {
+		case err != nil:
+			logger.Info("Transaction rejected", "tx", stx.Tx.Hash(), "err", err)
+			rejected++
+		case res.Code != abci.CodeTypeOK:
+			logger.Info("Transaction rejected", "tx", stx.Tx.Hash(), "code", res.Code, "log", res.Log)
+			rejected++
+		case res.MempoolError != "":
+			logger.Info("Transaction rejected", "tx", stx.Tx.Hash(), "err", res.MempoolError)
+			rejected++
+		default:
+			accepted++
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to import mempool: %w", err)
+	}
+
+	fmt.Printf("Imported %d txs, %d rejected\n", accepted, rejected)
+	return nil
+}
@@ -72,6 +72,10 @@ func AddNodeFlags(cmd *cobra.Command) {
 	cmd.Flags().Bool("p2p.pex", config.P2P.PexReactor, "enable/disable Peer-Exchange")
 	cmd.Flags().Bool("p2p.seed_mode", config.P2P.SeedMode, "enable/disable seed mode")
 	cmd.Flags().String("p2p.private_peer_ids", config.P2P.PrivatePeerIDs, "comma-delimited private peer IDs")
+	cmd.Flags().String("p2p.topology", config.P2P.Topology,
+		"role of the node in the network topology: public | sentry | validator-behind-sentries")
+	cmd.Flags().String("p2p.transport", config.P2P.Transport,
+		"transport used to connect to peers: tcp | quic")
 
 	// consensus flags
 	cmd.Flags().Bool(
@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -28,6 +30,19 @@ const (
 	// Default is v0.
 	MempoolV0 = "v0"
 	MempoolV1 = "v1"
+
+	// P2P topologies. A validator behind sentries only connects to its
+	// sentries, its persistent peers, and a sentry keeps the validators behind
+	// it, its private peers, out of the peer exchange. Default is public.
+	TopologyPublic                  = "public"
+	TopologySentry                  = "sentry"
+	TopologyValidatorBehindSentries = "validator-behind-sentries"
+
+	// P2P transports. The QUIC transport also accepts TCP connections, and
+	// falls back to TCP to dial the peers which don't support QUIC. Default is
+	// TCP.
+	TransportTCP  = "tcp"
+	TransportQUIC = "quic"
 )
 
 // NOTE: Most of the structs & relevant comments + the
@@ -77,6 +92,8 @@ type Config struct {
 	// https://github.com/tendermint/tendermint/issues/9279
 	DeprecatedFastSyncConfig map[interface{}]interface{} `mapstructure:"fastsync"`
 	Consensus                *ConsensusConfig            `mapstructure:"consensus"`
+	Prefetch                 *PrefetchConfig             `mapstructure:"prefetch"`
+	VotePool                 *VotePoolConfig             `mapstructure:"votepool"`
 	Storage                  *StorageConfig              `mapstructure:"storage"`
 	TxIndex                  *TxIndexConfig              `mapstructure:"tx_index"`
 	Instrumentation          *InstrumentationConfig      `mapstructure:"instrumentation"`
@@ -92,6 +109,8 @@ func DefaultConfig() *Config {
 		StateSync:       DefaultStateSyncConfig(),
 		BlockSync:       DefaultBlockSyncConfig(),
 		Consensus:       DefaultConsensusConfig(),
+		Prefetch:        DefaultPrefetchConfig(),
+		VotePool:        DefaultVotePoolConfig(),
 		Storage:         DefaultStorageConfig(),
 		TxIndex:         DefaultTxIndexConfig(),
 		Instrumentation: DefaultInstrumentationConfig(),
@@ -108,6 +127,8 @@ func TestConfig() *Config {
 		StateSync:       TestStateSyncConfig(),
 		BlockSync:       TestBlockSyncConfig(),
 		Consensus:       TestConsensusConfig(),
+		Prefetch:        TestPrefetchConfig(),
+		VotePool:        TestVotePoolConfig(),
 		Storage:         TestStorageConfig(),
 		TxIndex:         TestTxIndexConfig(),
 		Instrumentation: TestInstrumentationConfig(),
@@ -148,6 +169,12 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.Consensus.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [consensus] section: %w", err)
 	}
+	if err := cfg.Prefetch.ValidateBasic(); err != nil {
+		return fmt.Errorf("error in [prefetch] section: %w", err)
+	}
+	if err := cfg.VotePool.ValidateBasic(); err != nil {
+		return fmt.Errorf("error in [votepool] section: %w", err)
+	}
 	if err := cfg.Instrumentation.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [instrumentation] section: %w", err)
 	}
@@ -366,6 +393,10 @@ type RPCConfig struct {
 	// 0 - unlimited.
 	GRPCMaxOpenConnections int `mapstructure:"grpc_max_open_connections"`
 
+	// TCP or UNIX socket address for the Ethereum JSON-RPC server to listen on
+	// NOTE: This server only supports the methods forwarded to the application by /eth_query
+	EthListenAddress string `mapstructure:"eth_laddr"`
+
 	// Activate unsafe RPC commands like /dial_persistent_peers and /unsafe_flush_mempool
 	Unsafe bool `mapstructure:"unsafe"`
 
@@ -454,6 +485,7 @@ func DefaultRPCConfig() *RPCConfig {
 		CORSAllowedHeaders:     []string{"Origin", "Accept", "Content-Type", "X-Requested-With", "X-Server-Time"},
 		GRPCListenAddress:      "",
 		GRPCMaxOpenConnections: 900,
+		EthListenAddress:       "",
 
 		Unsafe:             false,
 		MaxOpenConnections: 900,
@@ -558,6 +590,16 @@ type P2PConfig struct { //nolint: maligned
 	// Address to advertise to peers for them to dial
 	ExternalAddress string `mapstructure:"external_address"`
 
+	// Transport used to connect to peers:
+	// 1) "tcp" - a secret connection multiplexing all the channels over a TCP
+	//   stream.
+	// 2) "quic" - a QUIC connection, on UDP at the port of laddr, with a
+	//   stream per channel, so the messages of a channel are never delayed by
+	//   the ones of another. TCP connections are accepted too, and for an
+	//   hour after they connected, the peers which don't advertise QUIC are
+	//   dialed over TCP.
+	Transport string `mapstructure:"transport"`
+
 	// Comma separated list of seed nodes to connect to
 	// We only use these if we can’t connect to peers in the addrbook
 	Seeds string `mapstructure:"seeds"`
@@ -599,6 +641,18 @@ type P2PConfig struct { //nolint: maligned
 	// Rate at which packets can be received, in bytes/second
 	RecvRate int64 `mapstructure:"recv_rate"`
 
+	// Comma separated list of channel_id:rate pairs, capping the rate at which
+	// packets can be sent on a channel to each peer, in bytes/second. The
+	// channel IDs are in hex, e.g. "0x61:1024000" caps the state sync chunks
+	// at 1 MB/s, leaving room for consensus. The other channels are only
+	// limited by send_rate.
+	ChannelSendRates string `mapstructure:"channel_send_rates"`
+
+	// Set true to compress the messages of the channels which support it
+	// (blocks, block parts, txs and snapshot chunks) with snappy. A channel is
+	// only compressed with the peers which enabled compression on it too.
+	Compression bool `mapstructure:"compression"`
+
 	// Set true to enable the peer-exchange reactor
 	PexReactor bool `mapstructure:"pex"`
 
@@ -612,6 +666,16 @@ type P2PConfig struct { //nolint: maligned
 	// other peers)
 	PrivatePeerIDs string `mapstructure:"private_peer_ids"`
 
+	// Role of the node in the network topology:
+	// 1) "public" - the behavior is only driven by the other options.
+	// 2) "sentry" - private_peer_ids lists the validators behind the node,
+	//   which are unconditional peers.
+	// 3) "validator-behind-sentries" - persistent_peers lists the sentries of
+	//   the node, which are unconditional peers and the only peers it connects
+	//   to. The peer-exchange reactor is disabled, and seeds aren't allowed, so
+	//   the address of the node is never gossiped.
+	Topology string `mapstructure:"topology"`
+
 	// Toggle to disable guard against peers connecting from the same ip.
 	AllowDuplicateIP bool `mapstructure:"allow_duplicate_ip"`
 
@@ -619,6 +683,25 @@ type P2PConfig struct { //nolint: maligned
 	HandshakeTimeout time.Duration `mapstructure:"handshake_timeout"`
 	DialTimeout      time.Duration `mapstructure:"dial_timeout"`
 
+	// Set true to track the behavior of peers in trust metrics, persisted in
+	// the trusthistory DB. The trust scores of peers drive the dial priority,
+	// the eviction of inbound peers, and the temporary bans. The metrics of the
+	// last 1000 disconnected peers are kept.
+	TrustMetric bool `mapstructure:"trust_metric"`
+
+	// Peers whose trust score drops below this are banned for
+	// TrustBanDuration (0 disables the bans). Persistent and unconditional
+	// peers are never banned.
+	TrustBanThreshold int `mapstructure:"trust_ban_threshold"`
+
+	// How long a peer is banned for
+	TrustBanDuration time.Duration `mapstructure:"trust_ban_duration"`
+
+	// When the inbound peers are full, an inbound peer whose trust score is
+	// below this is evicted for a new peer with a higher score (0 disables
+	// the evictions).
+	TrustEvictThreshold int `mapstructure:"trust_evict_threshold"`
+
 	// Testing params.
 	// Force dial to fail
 	TestDialFail bool `mapstructure:"test_dial_fail"`
@@ -632,6 +715,7 @@ func DefaultP2PConfig() *P2PConfig {
 	return &P2PConfig{
 		ListenAddress:                "tcp://0.0.0.0:26656",
 		ExternalAddress:              "",
+		Transport:                    TransportTCP,
 		UPNP:                         false,
 		AddrBook:                     defaultAddrBookPath,
 		AddrBookStrict:               true,
@@ -642,11 +726,18 @@ func DefaultP2PConfig() *P2PConfig {
 		MaxPacketMsgPayloadSize:      1024,    // 1 kB
 		SendRate:                     5120000, // 5 mB/s
 		RecvRate:                     5120000, // 5 mB/s
+		ChannelSendRates:             "",
+		Compression:                  false,
 		PexReactor:                   true,
 		SeedMode:                     false,
+		Topology:                     TopologyPublic,
 		AllowDuplicateIP:             false,
 		HandshakeTimeout:             20 * time.Second,
 		DialTimeout:                  3 * time.Second,
+		TrustMetric:                  false,
+		TrustBanThreshold:            10,
+		TrustBanDuration:             10 * time.Minute,
+		TrustEvictThreshold:          50,
 		TestDialFail:                 false,
 		TestFuzz:                     false,
 		TestFuzzConfig:               DefaultFuzzConnConfig(),
@@ -667,6 +758,68 @@ func (cfg *P2PConfig) AddrBookFile() string {
 	return rootify(cfg.AddrBook, cfg.RootDir)
 }
 
+// ChannelSendRateCaps returns the send rates of the channels listed in
+// ChannelSendRates, by channel ID.
+func (cfg *P2PConfig) ChannelSendRateCaps() (map[byte]int64, error) {
+	rates := make(map[byte]int64)
+	for _, pair := range strings.Split(cfg.ChannelSendRates, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		id, rate, ok := strings.Cut(pair, ":")
+		if !ok {
+			return nil, fmt.Errorf("%q: expected channel_id:rate", pair)
+		}
+		chID, err := strconv.ParseUint(strings.TrimSpace(id), 0, 8)
+		if err != nil {
+			return nil, fmt.Errorf("%q: invalid channel ID: %w", pair, err)
+		}
+		r, err := strconv.ParseInt(strings.TrimSpace(rate), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q: invalid rate: %w", pair, err)
+		}
+		if r <= 0 {
+			return nil, fmt.Errorf("%q: rate must be positive", pair)
+		}
+		if _, ok := rates[byte(chID)]; ok {
+			return nil, fmt.Errorf("duplicate channel %#x", chID)
+		}
+		rates[byte(chID)] = r
+	}
+	return rates, nil
+}
+
+// PexEnabled returns true if the peer-exchange reactor is enabled, which is
+// never the case for a validator behind sentries.
+func (cfg *P2PConfig) PexEnabled() bool {
+	return cfg.PexReactor && cfg.Topology != TopologyValidatorBehindSentries
+}
+
+func (cfg *P2PConfig) validateTopology() error {
+	switch cfg.Topology {
+	case TopologyPublic:
+	case TopologySentry:
+		if strings.TrimSpace(cfg.PrivatePeerIDs) == "" {
+			return errors.New("a sentry needs the IDs of the validators behind it in private_peer_ids")
+		}
+	case TopologyValidatorBehindSentries:
+		// the address of the node must only be known to its sentries
+		if strings.TrimSpace(cfg.PersistentPeers) == "" {
+			return errors.New("a validator behind sentries needs its sentries in persistent_peers")
+		}
+		if strings.TrimSpace(cfg.Seeds) != "" {
+			return errors.New("a validator behind sentries can't have seeds, which would gossip its address")
+		}
+		if cfg.SeedMode {
+			return errors.New("a validator behind sentries can't be in seed mode")
+		}
+	default:
+		return fmt.Errorf("unknown topology %q, must be %s, %s or %s",
+			cfg.Topology, TopologyPublic, TopologySentry, TopologyValidatorBehindSentries)
+	}
+	return nil
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *P2PConfig) ValidateBasic() error {
@@ -691,6 +844,26 @@ func (cfg *P2PConfig) ValidateBasic() error {
 	if cfg.RecvRate < 0 {
 		return errors.New("recv_rate can't be negative")
 	}
+	if _, err := cfg.ChannelSendRateCaps(); err != nil {
+		return fmt.Errorf("channel_send_rates: %w", err)
+	}
+	if err := cfg.validateTopology(); err != nil {
+		return err
+	}
+	switch cfg.Transport {
+	case TransportTCP, TransportQUIC:
+	default:
+		return fmt.Errorf("unknown transport %q, must be %s or %s", cfg.Transport, TransportTCP, TransportQUIC)
+	}
+	if cfg.TrustBanThreshold < 0 || cfg.TrustBanThreshold > 100 {
+		return errors.New("trust_ban_threshold must be in [0, 100]")
+	}
+	if cfg.TrustBanDuration < 0 {
+		return errors.New("trust_ban_duration can't be negative")
+	}
+	if cfg.TrustEvictThreshold < 0 || cfg.TrustEvictThreshold > 100 {
+		return errors.New("trust_evict_threshold must be in [0, 100]")
+	}
 	return nil
 }
 
@@ -731,7 +904,8 @@ type MempoolConfig struct {
 	// validity for all remaining transaction in the mempool after a block.
 	// Since a block affects the application state, some transactions in the
 	// mempool may become invalid. If this does not apply to your application,
-	// you can disable rechecking.
+	// you can disable rechecking. The application can also restrict the
+	// recheck to the txs of some senders with ResponseCommit.recheck_filter.
 	Recheck bool `mapstructure:"recheck"`
 	// Broadcast (default: true) defines whether the mempool should relay
 	// transactions to other peers. Setting this to false will stop the mempool
@@ -742,7 +916,8 @@ type MempoolConfig struct {
 	// WalPath (default: "") configures the location of the Write Ahead Log
 	// (WAL) for the mempool. The WAL is disabled by default. To enable, set
 	// WalPath to where you want the WAL to be written (e.g.
-	// "data/mempool.wal").
+	// "data/mempool.wal"). The txs in the WAL are replayed through CheckTx
+	// when the node starts.
 	WalPath string `mapstructure:"wal_dir"`
 	// Maximum number of transactions in the mempool
 	Size int `mapstructure:"size"`
@@ -761,8 +936,43 @@ type MempoolConfig struct {
 	MaxTxBytes int `mapstructure:"max_tx_bytes"`
 	// Maximum size of a batch of transactions to send to a peer
 	// Including space needed by encoding (one varint per transaction).
-	// XXX: Unused due to https://github.com/tendermint/tendermint/issues/5796
+	// A batch never exceeds the size of a message with a single transaction
+	// of MaxTxBytes, so that it's accepted by peers. 0 disables batching.
 	MaxBatchBytes int `mapstructure:"max_batch_bytes"`
+	// Number of workers checking the txs received from peers and the RPC. The
+	// txs received from a peer are always checked in the order they were
+	// received, while the txs of different peers may be checked concurrently.
+	// The txs of a sender received from different peers are only checked in
+	// order if the node is given the sender of txs (see node.MempoolTxSender).
+	// Only used by the v0 mempool.
+	CheckTxWorkers int `mapstructure:"check_tx_workers"`
+	// Order the txs of each sender by the nonce returned by the app in
+	// ResponseCheckTx. A tx whose nonce is ahead of the next one expected for
+	// its sender is held, and neither gossiped nor reaped, until the missing
+	// txs are received. The txs of a sender are reaped in nonce order.
+	// Not supported by the v1 mempool.
+	SenderNonceOrdering bool `mapstructure:"sender_nonce_ordering"`
+	// Maximum number of held txs, which don't count against Size and
+	// MaxTxsBytes. 0 means unlimited.
+	MaxHeldTxs int `mapstructure:"max_held_txs"`
+	// Maximum number of held txs of a sender. 0 means unlimited.
+	SenderMaxHeldTxs int `mapstructure:"sender_max_held_txs"`
+	// Number of blocks after which a held tx is dropped, if its missing nonces
+	// weren't received. 0 means never.
+	HeldTxTTLNumBlocks int64 `mapstructure:"held_tx_ttl_num_blocks"`
+	// Maximum number of bytes of txs received from a peer per second, with
+	// bursts of up to a second of traffic. The txs received beyond the limit
+	// are dropped. 0 means unlimited.
+	// Only used by the v0 mempool.
+	PeerMaxBytesPerSecond int64 `mapstructure:"peer_max_bytes_per_second"`
+	// Maximum number of txs in the mempool first received from a peer.
+	// 0 means unlimited.
+	// Only used by the v0 mempool.
+	PeerMaxTxs int `mapstructure:"peer_max_txs"`
+	// Maximum number of txs in the mempool, including the held ones, of a
+	// sender returned by the app in ResponseCheckTx. 0 means unlimited.
+	// Only used by the v0 mempool.
+	SenderMaxTxs int `mapstructure:"sender_max_txs"`
 
 	// TTLDuration, if non-zero, defines the maximum amount of time a transaction
 	// can exist for in the mempool.
@@ -796,12 +1006,18 @@ func DefaultMempoolConfig() *MempoolConfig {
 		WalPath:   "",
 		// Each signature verification takes .5ms, Size reduced until we implement
 		// ABCI Recheck
-		Size:         5000,
-		MaxTxsBytes:  1024 * 1024 * 1024, // 1GB
-		CacheSize:    10000,
-		MaxTxBytes:   1024 * 1024, // 1MB
-		TTLDuration:  0 * time.Second,
-		TTLNumBlocks: 0,
+		Size:           5000,
+		MaxTxsBytes:    1024 * 1024 * 1024, // 1GB
+		CacheSize:      10000,
+		MaxTxBytes:     1024 * 1024, // 1MB
+		MaxBatchBytes:  1024 * 1024, // 1MB
+		CheckTxWorkers: 1,
+		// The held txs are bounded separately from the txs in the mempool
+		MaxHeldTxs:         1000,
+		SenderMaxHeldTxs:   16,
+		HeldTxTTLNumBlocks: 100,
+		TTLDuration:        0 * time.Second,
+		TTLNumBlocks:       0,
 	}
 }
 
@@ -837,6 +1053,33 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.MaxTxBytes < 0 {
 		return errors.New("max_tx_bytes can't be negative")
 	}
+	if cfg.MaxBatchBytes < 0 {
+		return errors.New("max_batch_bytes can't be negative")
+	}
+	if cfg.CheckTxWorkers < 0 {
+		return errors.New("check_tx_workers can't be negative")
+	}
+	if cfg.SenderNonceOrdering && cfg.Version == MempoolV1 {
+		return errors.New("sender_nonce_ordering is not supported by the v1 mempool")
+	}
+	if cfg.MaxHeldTxs < 0 {
+		return errors.New("max_held_txs can't be negative")
+	}
+	if cfg.SenderMaxHeldTxs < 0 {
+		return errors.New("sender_max_held_txs can't be negative")
+	}
+	if cfg.HeldTxTTLNumBlocks < 0 {
+		return errors.New("held_tx_ttl_num_blocks can't be negative")
+	}
+	if cfg.PeerMaxBytesPerSecond < 0 {
+		return errors.New("peer_max_bytes_per_second can't be negative")
+	}
+	if cfg.PeerMaxTxs < 0 {
+		return errors.New("peer_max_txs can't be negative")
+	}
+	if cfg.SenderMaxTxs < 0 {
+		return errors.New("sender_max_txs can't be negative")
+	}
 	return nil
 }
 
@@ -1127,6 +1370,130 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	return nil
 }
 
+//-----------------------------------------------------------------------------
+// PrefetchConfig
+
+// PrefetchConfig defines the configuration for prefetching, which pre-executes
+// the txs of a block in parallel to warm up the application's cache while the
+// block is being executed.
+type PrefetchConfig struct {
+	// Enabled controls whether blocks are prefetched.
+	Enabled bool `mapstructure:"enabled"`
+
+	// MinTxs is the min number of txs in a block to prefetch it.
+	MinTxs int `mapstructure:"min_txs"`
+
+	// TxsPerWorker and BaseWorkers decide the number of workers prefetching a
+	// block with n txs: n / TxsPerWorker + BaseWorkers.
+	TxsPerWorker int `mapstructure:"txs_per_worker"`
+	BaseWorkers  int `mapstructure:"base_workers"`
+
+	// MaxWorkers is the max number of workers prefetching a block.
+	MaxWorkers int `mapstructure:"max_workers"`
+
+	// Adaptive controls whether the number of workers is sized from recent
+	// block execution and prefetch times instead of the number of txs.
+	Adaptive bool `mapstructure:"adaptive"`
+}
+
+// DefaultPrefetchConfig returns a default configuration for prefetching.
+func DefaultPrefetchConfig() *PrefetchConfig {
+	return &PrefetchConfig{
+		Enabled:      true,
+		MinTxs:       100,
+		TxsPerWorker: 100,
+		BaseWorkers:  3,
+		MaxWorkers:   64,
+		Adaptive:     false,
+	}
+}
+
+// TestPrefetchConfig returns a configuration for testing prefetching.
+func TestPrefetchConfig() *PrefetchConfig {
+	return DefaultPrefetchConfig()
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *PrefetchConfig) ValidateBasic() error {
+	if cfg.MinTxs < 0 {
+		return errors.New("min_txs can't be negative")
+	}
+	if cfg.TxsPerWorker <= 0 {
+		return errors.New("txs_per_worker must be positive")
+	}
+	if cfg.BaseWorkers < 0 {
+		return errors.New("base_workers can't be negative")
+	}
+	if cfg.MaxWorkers <= 0 {
+		return errors.New("max_workers must be positive")
+	}
+	return nil
+}
+
+//-----------------------------------------------------------------------------
+// VotePoolConfig
+
+// VotePoolConfig defines the configuration for the vote pool, which collects
+// cross chain and challenge votes from validators.
+type VotePoolConfig struct {
+	// Persistent controls whether votes are stored in a local database so that
+	// unexpired votes survive a node restart.
+	Persistent bool `mapstructure:"persistent"`
+
+	// EventTypes declares the event types supported by the vote pool. The
+	// built-in cross chain and challenge event types are used if it is empty.
+	EventTypes []VoteEventTypeConfig `mapstructure:"event_types"`
+}
+
+// VoteEventTypeConfig declares an event type supported by the vote pool.
+type VoteEventTypeConfig struct {
+	// Type is the numeric event type carried by votes.
+	Type uint8 `mapstructure:"type"`
+
+	// KeepAlive is how long a vote of this type is kept in the vote pool.
+	KeepAlive time.Duration `mapstructure:"keep_alive"`
+
+	// MaxVotes is the max number of votes of this type in the vote pool,
+	// 0 means no limit.
+	MaxVotes int `mapstructure:"max_votes"`
+}
+
+// DefaultVotePoolConfig returns a default configuration for the vote pool.
+func DefaultVotePoolConfig() *VotePoolConfig {
+	return &VotePoolConfig{
+		Persistent: false,
+		EventTypes: []VoteEventTypeConfig{},
+	}
+}
+
+// TestVotePoolConfig returns a configuration for testing the vote pool.
+func TestVotePoolConfig() *VotePoolConfig {
+	return DefaultVotePoolConfig()
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *VotePoolConfig) ValidateBasic() error {
+	seen := make(map[uint8]struct{}, len(cfg.EventTypes))
+	for _, et := range cfg.EventTypes {
+		if et.Type == 0 {
+			return errors.New("event type 0 is reserved")
+		}
+		if _, ok := seen[et.Type]; ok {
+			return fmt.Errorf("duplicated event type %d", et.Type)
+		}
+		seen[et.Type] = struct{}{}
+		if et.KeepAlive <= 0 {
+			return fmt.Errorf("keep_alive of event type %d must be positive", et.Type)
+		}
+		if et.MaxVotes < 0 {
+			return fmt.Errorf("max_votes of event type %d can't be negative", et.Type)
+		}
+	}
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 // StorageConfig
 
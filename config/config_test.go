@@ -96,6 +96,9 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 		"MaxPacketMsgPayloadSize",
 		"SendRate",
 		"RecvRate",
+		"TrustBanThreshold",
+		"TrustBanDuration",
+		"TrustEvictThreshold",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -105,6 +108,59 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 	}
 }
 
+func TestP2PConfigChannelSendRates(t *testing.T) {
+	cfg := TestP2PConfig()
+	rates, err := cfg.ChannelSendRateCaps()
+	require.NoError(t, err)
+	assert.Empty(t, rates)
+
+	cfg.ChannelSendRates = " 0x61:1024000, 64:2048000 ,"
+	rates, err = cfg.ChannelSendRateCaps()
+	require.NoError(t, err)
+	assert.Equal(t, map[byte]int64{0x61: 1024000, 0x40: 2048000}, rates)
+	assert.NoError(t, cfg.ValidateBasic())
+
+	for _, invalid := range []string{"0x61", "0x100:1000", "0x61:-1", "0x61:0", "0x61:abc", "0x61:1,0x61:2"} {
+		cfg.ChannelSendRates = invalid
+		assert.Error(t, cfg.ValidateBasic(), invalid)
+	}
+}
+
+func TestP2PConfigTopology(t *testing.T) {
+	cfg := TestP2PConfig()
+	assert.True(t, cfg.PexEnabled())
+
+	cfg.Topology = "hub"
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg.Topology = TopologySentry
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.PrivatePeerIDs = "6dc7cfdfa18b077c01af3969b8c7cf94737c232c"
+	assert.NoError(t, cfg.ValidateBasic())
+	assert.True(t, cfg.PexEnabled())
+
+	cfg = TestP2PConfig()
+	cfg.Topology = TopologyValidatorBehindSentries
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.PersistentPeers = "6dc7cfdfa18b077c01af3969b8c7cf94737c232c@127.0.0.1:26656"
+	assert.NoError(t, cfg.ValidateBasic())
+	assert.False(t, cfg.PexEnabled())
+	cfg.Seeds = "de0d1b7c9a1a9c0b8d6a8e6e8f5b4e0b7f9a1c2d@127.0.0.1:26656"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.Seeds = ""
+	cfg.SeedMode = true
+	assert.Error(t, cfg.ValidateBasic())
+}
+
+func TestP2PConfigTransport(t *testing.T) {
+	cfg := TestP2PConfig()
+	assert.Equal(t, TransportTCP, cfg.Transport)
+	cfg.Transport = TransportQUIC
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.Transport = "udp"
+	assert.Error(t, cfg.ValidateBasic())
+}
+
 func TestMempoolConfigValidateBasic(t *testing.T) {
 	cfg := TestMempoolConfig()
 	assert.NoError(t, cfg.ValidateBasic())
@@ -114,6 +170,14 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		"MaxTxsBytes",
 		"CacheSize",
 		"MaxTxBytes",
+		"MaxBatchBytes",
+		"CheckTxWorkers",
+		"PeerMaxBytesPerSecond",
+		"PeerMaxTxs",
+		"SenderMaxTxs",
+		"MaxHeldTxs",
+		"SenderMaxHeldTxs",
+		"HeldTxTTLNumBlocks",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -121,6 +185,11 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	cfg.SenderNonceOrdering = true
+	assert.NoError(t, cfg.ValidateBasic())
+	cfg.Version = MempoolV1
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestStateSyncConfigValidateBasic(t *testing.T) {
@@ -190,3 +259,42 @@ func TestInstrumentationConfigValidateBasic(t *testing.T) {
 	cfg.MaxOpenConnections = -1
 	assert.Error(t, cfg.ValidateBasic())
 }
+
+func TestPrefetchConfigValidateBasic(t *testing.T) {
+	cfg := TestPrefetchConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	fieldsToTest := []string{
+		"MinTxs",
+		"TxsPerWorker",
+		"BaseWorkers",
+		"MaxWorkers",
+	}
+
+	for _, fieldName := range fieldsToTest {
+		field := reflect.ValueOf(cfg).Elem().FieldByName(fieldName)
+		value := field.Int()
+		field.SetInt(-1)
+		assert.Error(t, cfg.ValidateBasic())
+		field.SetInt(value)
+	}
+}
+
+func TestVotePoolConfigValidateBasic(t *testing.T) {
+	cfg := TestVotePoolConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.EventTypes = []VoteEventTypeConfig{{Type: 6, KeepAlive: time.Minute, MaxVotes: 100}}
+	assert.NoError(t, cfg.ValidateBasic())
+
+	testCases := []VoteEventTypeConfig{
+		{Type: 0, KeepAlive: time.Minute},
+		{Type: 6, KeepAlive: time.Minute},
+		{Type: 7, KeepAlive: 0},
+		{Type: 7, KeepAlive: time.Minute, MaxVotes: -1},
+	}
+	for _, tc := range testCases {
+		cfg.EventTypes = []VoteEventTypeConfig{{Type: 6, KeepAlive: time.Minute}, tc}
+		assert.Error(t, cfg.ValidateBasic(), "%+v", tc)
+	}
+}
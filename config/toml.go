@@ -190,6 +190,11 @@ grpc_laddr = "{{ .RPC.GRPCListenAddress }}"
 # 1024 - 40 - 10 - 50 = 924 = ~900
 grpc_max_open_connections = {{ .RPC.GRPCMaxOpenConnections }}
 
+# TCP or UNIX socket address for the Ethereum JSON-RPC server to listen on,
+# so that Ethereum wallets can connect to the node directly
+# NOTE: This server only supports the methods forwarded to the application by /eth_query
+eth_laddr = "{{ .RPC.EthListenAddress }}"
+
 # Activate unsafe RPC commands like /dial_seeds and /unsafe_flush_mempool
 unsafe = {{ .RPC.Unsafe }}
 
@@ -284,6 +289,15 @@ laddr = "{{ .P2P.ListenAddress }}"
 # example: 159.89.10.97:26656
 external_address = "{{ .P2P.ExternalAddress }}"
 
+# Transport used to connect to peers:
+# 1) "tcp" - a secret connection multiplexing all the channels over a TCP
+#   stream.
+# 2) "quic" - a QUIC connection, on UDP at the port of laddr, with a stream
+#   per channel, so the messages of a channel are never delayed by the ones of
+#   another. TCP connections are accepted too, and for an hour after they
+#   connected, the peers which don't advertise QUIC are dialed over TCP.
+transport = "{{ .P2P.Transport }}"
+
 # Comma separated list of seed nodes to connect to
 seeds = "{{ .P2P.Seeds }}"
 
@@ -324,6 +338,17 @@ send_rate = {{ .P2P.SendRate }}
 # Rate at which packets can be received, in bytes/second
 recv_rate = {{ .P2P.RecvRate }}
 
+# Comma separated list of channel_id:rate pairs, capping the rate at which
+# packets can be sent on a channel to each peer, in bytes/second. The channel
+# IDs are in hex, e.g. "0x61:1024000" caps the state sync chunks at 1 MB/s,
+# leaving room for consensus. The other channels are only limited by send_rate.
+channel_send_rates = "{{ .P2P.ChannelSendRates }}"
+
+# Set true to compress the messages of the channels which support it (blocks,
+# block parts, txs and snapshot chunks) with snappy. A channel is only
+# compressed with the peers which enabled compression on it too.
+compression = {{ .P2P.Compression }}
+
 # Set true to enable the peer-exchange reactor
 pex = {{ .P2P.PexReactor }}
 
@@ -336,6 +361,16 @@ seed_mode = {{ .P2P.SeedMode }}
 # Comma separated list of peer IDs to keep private (will not be gossiped to other peers)
 private_peer_ids = "{{ .P2P.PrivatePeerIDs }}"
 
+# Role of the node in the network topology:
+# 1) "public" - the behavior is only driven by the other options.
+# 2) "sentry" - private_peer_ids lists the validators behind the node, which
+#   are unconditional peers.
+# 3) "validator-behind-sentries" - persistent_peers lists the sentries of the
+#   node, which are unconditional peers and the only peers it connects to. The
+#   peer-exchange reactor is disabled, and seeds aren't allowed, so the address
+#   of the node is never gossiped.
+topology = "{{ .P2P.Topology }}"
+
 # Toggle to disable guard against peers connecting from the same ip.
 allow_duplicate_ip = {{ .P2P.AllowDuplicateIP }}
 
@@ -343,6 +378,21 @@ allow_duplicate_ip = {{ .P2P.AllowDuplicateIP }}
 handshake_timeout = "{{ .P2P.HandshakeTimeout }}"
 dial_timeout = "{{ .P2P.DialTimeout }}"
 
+# Set true to track the behavior of peers in trust metrics, persisted in the
+# trusthistory DB. The trust scores of peers, between 0 and 100, drive the dial
+# priority, the eviction of inbound peers, and the temporary bans, and are
+# shown in /net_info. The metrics of the last 1000 disconnected peers are kept.
+trust_metric = {{ .P2P.TrustMetric }}
+
+# Peers whose trust score drops below this are banned for trust_ban_duration
+# (0 disables the bans). Persistent and unconditional peers are never banned.
+trust_ban_threshold = {{ .P2P.TrustBanThreshold }}
+trust_ban_duration = "{{ .P2P.TrustBanDuration }}"
+
+# When the inbound peers are full, an inbound peer whose trust score is below
+# this is evicted for a new peer with a higher score (0 disables the evictions).
+trust_evict_threshold = {{ .P2P.TrustEvictThreshold }}
+
 #######################################################
 ###          Mempool Configuration Option          ###
 #######################################################
@@ -355,6 +405,10 @@ version = "{{ .Mempool.Version }}"
 
 recheck = {{ .Mempool.Recheck }}
 broadcast = {{ .Mempool.Broadcast }}
+
+# Directory of the mempool write-ahead log (e.g. "data/mempool.wal"). If set,
+# the txs in the mempool are written to it, and replayed through CheckTx when
+# the node restarts, so that pending txs are not lost. Disabled if empty.
 wal_dir = "{{ js .Mempool.WalPath }}"
 
 # Maximum number of transactions in the mempool
@@ -379,9 +433,52 @@ max_tx_bytes = {{ .Mempool.MaxTxBytes }}
 
 # Maximum size of a batch of transactions to send to a peer
 # Including space needed by encoding (one varint per transaction).
-# XXX: Unused due to https://github.com/tendermint/tendermint/issues/5796
+# A batch never exceeds the size of a message with a single transaction of
+# max_tx_bytes, so that it's accepted by peers. 0 disables batching.
 max_batch_bytes = {{ .Mempool.MaxBatchBytes }}
 
+# Number of workers checking the txs received from peers and the RPC.
+# The txs received from a peer are always checked in the order they were
+# received, while the txs of different peers may be checked concurrently.
+# The txs of a sender received from different peers are only checked in order
+# if the node is given the sender of txs (see node.MempoolTxSender).
+# Only used by the v0 mempool.
+check_tx_workers = {{ .Mempool.CheckTxWorkers }}
+
+# Order the txs of each sender by the nonce returned by the app in
+# ResponseCheckTx. A tx whose nonce is ahead of the next one expected for its
+# sender is held, and neither gossiped nor reaped, until the missing txs are
+# received. The txs of a sender are reaped in nonce order.
+# Not supported by the v1 mempool.
+sender_nonce_ordering = {{ .Mempool.SenderNonceOrdering }}
+
+# Maximum number of held txs, which don't count against size and
+# max_txs_bytes. 0 means unlimited.
+max_held_txs = {{ .Mempool.MaxHeldTxs }}
+
+# Maximum number of held txs of a sender. 0 means unlimited.
+sender_max_held_txs = {{ .Mempool.SenderMaxHeldTxs }}
+
+# Number of blocks after which a held tx is dropped, if its missing nonces
+# weren't received. 0 means never.
+held_tx_ttl_num_blocks = {{ .Mempool.HeldTxTTLNumBlocks }}
+
+# Maximum number of bytes of txs received from a peer per second, with bursts
+# of up to a second of traffic. The txs received beyond the limit are dropped.
+# 0 means unlimited.
+# Only used by the v0 mempool.
+peer_max_bytes_per_second = {{ .Mempool.PeerMaxBytesPerSecond }}
+
+# Maximum number of txs in the mempool first received from a peer.
+# 0 means unlimited.
+# Only used by the v0 mempool.
+peer_max_txs = {{ .Mempool.PeerMaxTxs }}
+
+# Maximum number of txs in the mempool, including the held ones, of a sender
+# returned by the app in ResponseCheckTx. 0 means unlimited.
+# Only used by the v0 mempool.
+sender_max_txs = {{ .Mempool.SenderMaxTxs }}
+
 # ttl-duration, if non-zero, defines the maximum amount of time a transaction
 # can exist for in the mempool.
 #
@@ -492,6 +589,53 @@ create_empty_blocks_interval = "{{ .Consensus.CreateEmptyBlocksInterval }}"
 peer_gossip_sleep_duration = "{{ .Consensus.PeerGossipSleepDuration }}"
 peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 
+#######################################################
+###          Prefetch Configuration Options         ###
+#######################################################
+[prefetch]
+
+# Set to true to pre-execute the txs of a block in parallel while the block is
+# being executed, which warms up the application's cache.
+enabled = {{ .Prefetch.Enabled }}
+
+# Blocks with fewer txs are not prefetched.
+min_txs = {{ .Prefetch.MinTxs }}
+
+# A block with n txs is prefetched by n / txs_per_worker + base_workers workers,
+# but no more than max_workers.
+txs_per_worker = {{ .Prefetch.TxsPerWorker }}
+base_workers = {{ .Prefetch.BaseWorkers }}
+max_workers = {{ .Prefetch.MaxWorkers }}
+
+# Set to true to size the workers from recent block execution and prefetch
+# times instead, so that prefetching keeps ahead of the block execution.
+adaptive = {{ .Prefetch.Adaptive }}
+
+#######################################################
+###         Vote Pool Configuration Options         ###
+#######################################################
+[votepool]
+
+# Set to true to store votes in the "votepool" database, so that unexpired
+# votes are reloaded after a restart instead of being re-broadcast by relayers.
+persistent = {{ .VotePool.Persistent }}
+
+# Event types supported by the vote pool. If none is declared, the built-in
+# cross chain and data availability challenge event types (1 to 5) are used,
+# with votes kept for 30s and no limit on the number of votes.
+#
+# Each event type is declared in its own table, for example:
+#
+# [[votepool.event_types]]
+# type = 6
+# keep_alive = "30s"
+# max_votes = 10000
+{{ range .VotePool.EventTypes }}
+[[votepool.event_types]]
+type = {{ .Type }}
+keep_alive = "{{ .KeepAlive }}"
+max_votes = {{ .MaxVotes }}
+{{ end }}
 #######################################################
 ###         Storage Configuration Options           ###
 #######################################################
@@ -5,7 +5,9 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -92,3 +94,25 @@ func checkConfig(configFile string) bool {
 	}
 	return valid
 }
+
+func TestVotePoolEventTypesRoundTrip(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "config-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	cfg := DefaultConfig()
+	cfg.VotePool.EventTypes = []VoteEventTypeConfig{
+		{Type: 6, KeepAlive: time.Minute, MaxVotes: 100},
+		{Type: 7, KeepAlive: 30 * time.Second},
+	}
+	configFilePath := filepath.Join(tmpDir, "config.toml")
+	WriteConfigFile(configFilePath, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(configFilePath)
+	require.NoError(t, v.ReadInConfig())
+
+	loaded := DefaultConfig()
+	require.NoError(t, v.Unmarshal(loaded))
+	assert.Equal(t, cfg.VotePool.EventTypes, loaded.VotePool.EventTypes)
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/proxy"
@@ -208,6 +209,8 @@ type Handshaker struct {
 	nBlocks int // number of blocks applied to the state
 
 	skipAppHashVerify bool // skip app hash verification
+
+	prefetchConfig *cfg.PrefetchConfig // prefetch the next block while replaying blocks
 }
 
 type HandshakerOption func(*Handshaker)
@@ -223,6 +226,8 @@ func NewHandshaker(stateStore sm.Store, state sm.State,
 		genDoc:       genDoc,
 		logger:       log.NewNopLogger(),
 		nBlocks:      0,
+
+		prefetchConfig: cfg.DefaultPrefetchConfig(),
 	}
 
 	for _, option := range options {
@@ -423,7 +428,8 @@ func (h *Handshaker) ReplayBlocks(
 			// NOTE: We could instead use the cs.WAL on cs.Start,
 			// but we'd have to allow the WAL to replay a block that wrote it's #ENDHEIGHT
 			h.logger.Info("Replay last block using real app")
-			state, err = h.replayBlock(state, storeBlockHeight, proxyApp.Consensus())
+			blockExec := h.newBlockExecutor(proxyApp.Consensus(), proxyApp.Prefetch())
+			state, err = h.replayBlock(state, storeBlockHeight, blockExec, false)
 			return state.AppHash, err
 
 		case appBlockHeight == storeBlockHeight:
@@ -434,7 +440,7 @@ func (h *Handshaker) ReplayBlocks(
 			}
 			mockApp := newMockProxyApp(appHash, abciResponses)
 			h.logger.Info("Replay last block using mock app")
-			state, err = h.replayBlock(state, storeBlockHeight, mockApp)
+			state, err = h.replayBlock(state, storeBlockHeight, h.newBlockExecutor(mockApp, nil), false)
 			return state.AppHash, err
 		}
 
@@ -470,15 +476,27 @@ func (h *Handshaker) replayBlocks(
 	if firstBlock == 1 {
 		firstBlock = state.InitialHeight
 	}
+	// the next block is prefetched while a block is being replayed
+	blockExec := h.newBlockExecutor(proxyApp.Consensus(), proxyApp.Prefetch())
+	var next *types.Block
 	for i := firstBlock; i <= finalBlock; i++ {
 		h.logger.Info("Applying block", "height", i)
-		block := h.store.LoadBlock(i)
+		block := next
+		if block == nil {
+			block = h.store.LoadBlock(i)
+		}
 		// Extra check to ensure the app was not changed in a way it shouldn't have.
 		if len(appHash) > 0 {
 			assertAppHashEqualsOneFromBlock(appHash, block)
 		}
 
+		next = nil
+		if i < storeBlockHeight {
+			next = h.store.LoadBlock(i + 1)
+		}
+		stopPrefetch := blockExec.Prefetch(next)
 		appHash, err = sm.ExecCommitBlock(proxyApp.Consensus(), block, h.logger, h.stateStore, h.genDoc.InitialHeight)
+		stopPrefetch()
 		if err != nil {
 			return nil, err
 		}
@@ -487,8 +505,8 @@ func (h *Handshaker) replayBlocks(
 	}
 
 	if mutateState {
-		// sync the final block
-		state, err = h.replayBlock(state, storeBlockHeight, proxyApp.Consensus())
+		// sync the final block, which has already been prefetched with the last replayed block
+		state, err = h.replayBlock(state, storeBlockHeight, blockExec, next != nil)
 		if err != nil {
 			return nil, err
 		}
@@ -499,18 +517,37 @@ func (h *Handshaker) replayBlocks(
 	return appHash, nil
 }
 
-// ApplyBlock on the proxyApp with the last block.
-func (h *Handshaker) replayBlock(state sm.State, height int64, proxyApp proxy.AppConnConsensus) (sm.State, error) {
-	block := h.store.LoadBlock(height)
-	meta := h.store.LoadBlockMeta(height)
-
+// newBlockExecutor returns the executor used to replay blocks on the proxyApp, which prefetches
+// blocks on the prefetch connection unless it's nil.
+func (h *Handshaker) newBlockExecutor(
+	proxyApp proxy.AppConnConsensus,
+	proxyPrefetchApp proxy.AppConnPrefetch,
+) *sm.BlockExecutor {
 	// Use stubs for both mempool and evidence pool since no transactions nor
 	// evidence are needed here - block already exists.
-	blockExec := sm.NewBlockExecutor(h.stateStore, h.logger, proxyApp, nil, emptyMempool{}, sm.EmptyEvidencePool{})
+	blockExec := sm.NewBlockExecutor(h.stateStore, h.logger, proxyApp, proxyPrefetchApp,
+		emptyMempool{}, sm.EmptyEvidencePool{}, sm.BlockExecutorWithPrefetchConfig(h.prefetchConfig))
 	blockExec.SetEventBus(h.eventBus)
+	return blockExec
+}
+
+// ApplyBlock on the proxyApp with the last block.
+// The block isn't prefetched again if it already has been.
+func (h *Handshaker) replayBlock(
+	state sm.State,
+	height int64,
+	blockExec *sm.BlockExecutor,
+	prefetched bool,
+) (sm.State, error) {
+	block := h.store.LoadBlock(height)
+	meta := h.store.LoadBlockMeta(height)
 
 	var err error
-	state, _, err = blockExec.ApplyBlock(state, meta.BlockID, block, h.skipAppHashVerify)
+	if prefetched {
+		state, _, err = blockExec.ApplyBlockWithNext(state, meta.BlockID, block, nil, h.skipAppHashVerify)
+	} else {
+		state, _, err = blockExec.ApplyBlock(state, meta.BlockID, block, h.skipAppHashVerify)
+	}
 	if err != nil {
 		return sm.State{}, err
 	}
@@ -525,6 +562,11 @@ func HandshakerSkipAppHashVerify(skipAppHashVerify bool) HandshakerOption {
 	return func(handshaker *Handshaker) { handshaker.skipAppHashVerify = skipAppHashVerify }
 }
 
+// HandshakerPrefetchConfig sets the configuration for prefetching replayed blocks
+func HandshakerPrefetchConfig(prefetchConfig *cfg.PrefetchConfig) HandshakerOption {
+	return func(handshaker *Handshaker) { handshaker.prefetchConfig = prefetchConfig }
+}
+
 func assertAppHashEqualsOneFromBlock(appHash []byte, block *types.Block) {
 	if !bytes.Equal(appHash, block.AppHash) {
 		panic(fmt.Sprintf(`block.AppHash does not match AppHash after replay. Got %X, expected %X.
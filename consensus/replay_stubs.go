@@ -34,6 +34,7 @@ func (emptyMempool) Update(
 	_ int64,
 	_ types.Txs,
 	_ []*abci.ResponseDeliverTx,
+	_ *abci.RecheckFilter,
 	_ mempl.PreCheckFunc,
 	_ mempl.PostCheckFunc,
 ) error {
@@ -51,6 +52,9 @@ func (emptyMempool) TxsWaitChan() <-chan struct{} { return nil }
 func (emptyMempool) InitWAL() error { return nil }
 func (emptyMempool) CloseWAL()      {}
 
+func (emptyMempool) TxStatus(types.TxKey) mempl.TxStatus { return mempl.TxStatusUnknown }
+func (emptyMempool) SnapshotTxs() []mempl.SnapshotTx     { return nil }
+
 //-----------------------------------------------------------------------------
 // mockProxyApp uses ABCIResponses to give the right results.
 //
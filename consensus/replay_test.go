@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -726,7 +727,8 @@ func testHandshakeReplay(t *testing.T, config *cfg.Config, nBlocks int, mode uin
 	kvstoreApp := kvstore.NewPersistentKVStoreApplication(
 		filepath.Join(config.DBDir(), fmt.Sprintf("replay_test_%d_%d_a", nBlocks, mode)))
 
-	clientCreator2 := proxy.NewLocalClientCreator(kvstoreApp)
+	prefetchApp := &prefetchCountingApp{Application: kvstoreApp, prefetched: make(map[int64]int)}
+	clientCreator2 := proxy.NewLocalClientCreator(prefetchApp)
 	if nBlocks > 0 {
 		// run nBlocks against a new client to build up the app state.
 		// use a throwaway CometBFT state
@@ -751,7 +753,9 @@ func testHandshakeReplay(t *testing.T, config *cfg.Config, nBlocks int, mode uin
 
 	// now start the app using the handshake - it should sync
 	genDoc, _ := sm.MakeGenesisDocFromFile(config.GenesisFile())
-	handshaker := NewHandshaker(stateStore, state, store, genDoc)
+	prefetchConfig := cfg.DefaultPrefetchConfig()
+	prefetchConfig.MinTxs = 0
+	handshaker := NewHandshaker(stateStore, state, store, genDoc, HandshakerPrefetchConfig(prefetchConfig))
 	proxyApp := proxy.NewAppConns(clientCreator2, proxy.NopMetrics())
 	if err := proxyApp.Start(); err != nil {
 		t.Fatalf("Error starting proxy app connections: %v", err)
@@ -795,6 +799,43 @@ func testHandshakeReplay(t *testing.T, config *cfg.Config, nBlocks int, mode uin
 	if handshaker.NBlocks() != expectedBlocksToSync {
 		t.Fatalf("Expected handshake to sync %d blocks, got %d", expectedBlocksToSync, handshaker.NBlocks())
 	}
+
+	// no block is prefetched twice
+	for height, n := range prefetchApp.numPrefetched() {
+		assert.Equal(t, 1, n, "block %d was prefetched %d times", height, n)
+	}
+}
+
+// prefetchCountingApp counts how many times each block is prefetched. The prefetch
+// requests aren't forwarded, since the kvstore application doesn't support them.
+type prefetchCountingApp struct {
+	abci.Application
+
+	mtx        sync.Mutex
+	prefetched map[int64]int
+}
+
+func (app *prefetchCountingApp) PreBeginBlock(req abci.RequestPreBeginBlock) abci.ResponsePrefetch {
+	app.mtx.Lock()
+	app.prefetched[req.Header.Height]++
+	app.mtx.Unlock()
+	return abci.ResponsePrefetch{}
+}
+
+func (app *prefetchCountingApp) PreDeliverTx(req abci.RequestPreDeliverTx) {}
+
+func (app *prefetchCountingApp) PreCommit(req abci.RequestPreCommit) abci.ResponsePrefetch {
+	return abci.ResponsePrefetch{}
+}
+
+func (app *prefetchCountingApp) numPrefetched() map[int64]int {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	prefetched := make(map[int64]int, len(app.prefetched))
+	for height, n := range app.prefetched {
+		prefetched[height] = n
+	}
+	return prefetched
 }
 
 func applyBlock(t *testing.T, stateStore sm.Store, st sm.State, blk *types.Block, proxyApp proxy.AppConns) sm.State {
@@ -215,6 +215,10 @@ func (c *Client) CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultCheckT
 	return c.next.CheckTx(ctx, tx)
 }
 
+func (c *Client) TxStatus(ctx context.Context, hash []byte) (*ctypes.ResultTxStatus, error) {
+	return c.next.TxStatus(ctx, hash)
+}
+
 func (c *Client) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	return c.next.NetInfo(ctx)
 }
@@ -560,6 +564,10 @@ func (c *Client) QueryVote(ctx context.Context, eventType int, eventHash []byte)
 	return c.next.QueryVote(ctx, eventType, eventHash)
 }
 
+func (c *Client) QueryVoteQuorum(ctx context.Context, eventType int, eventHash []byte) (*ctypes.ResultQueryVoteQuorum, error) {
+	return c.next.QueryVoteQuorum(ctx, eventType, eventHash)
+}
+
 func (c *Client) Subscribe(ctx context.Context, subscriber, query string,
 	outCapacity ...int) (out <-chan ctypes.ResultEvent, err error) {
 	return c.next.Subscribe(ctx, subscriber, query, outCapacity...)
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/types"
 )
 
 func TestCacheRemove(t *testing.T) {
@@ -33,3 +35,17 @@ func TestCacheRemove(t *testing.T) {
 		require.Equal(t, numTxs-(i+1), cache.list.Len())
 	}
 }
+
+func TestCacheHasKey(t *testing.T) {
+	cache := NewLRUTxCache(1)
+	tx1, tx2 := types.Tx("tx1"), types.Tx("tx2")
+
+	require.True(t, cache.Push(tx1))
+	require.True(t, cache.HasKey(tx1.Key()))
+	require.False(t, cache.HasKey(tx2.Key()))
+
+	// the oldest tx is evicted once the cache is full
+	require.True(t, cache.Push(tx2))
+	require.False(t, cache.HasKey(tx1.Key()))
+	require.True(t, cache.HasKey(tx2.Key()))
+}
@@ -7,6 +7,7 @@ import (
 	"math"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -59,7 +60,8 @@ type Mempool interface {
 	Unlock()
 
 	// Update informs the mempool that the given txs were committed and can be
-	// discarded.
+	// discarded. If recheck is enabled, the txs left are rechecked, only those
+	// selected by recheckFilter if it isn't nil.
 	//
 	// NOTE:
 	// 1. This should be called *after* block is committed by consensus.
@@ -68,6 +70,7 @@ type Mempool interface {
 		blockHeight int64,
 		blockTxs types.Txs,
 		deliverTxResponses []*abci.ResponseDeliverTx,
+		recheckFilter *abci.RecheckFilter,
 		newPreFn PreCheckFunc,
 		newPostFn PostCheckFunc,
 	) error
@@ -98,8 +101,40 @@ type Mempool interface {
 
 	// SizeBytes returns the total size of all txs in the mempool.
 	SizeBytes() int64
+
+	// InitWAL opens the write-ahead log of the mempool in the configured
+	// directory, and replays the txs in it through CheckTx. It must be called
+	// before any other tx is checked, so that all of them are written to it.
+	InitWAL() error
+
+	// CloseWAL flushes and closes the write-ahead log. Any further changes to
+	// the mempool will not be written to disk.
+	CloseWAL()
+
+	// TxStatus returns the status of the tx with the given key in the mempool
+	// and its cache.
+	TxStatus(txKey types.TxKey) TxStatus
+
+	// SnapshotTxs returns all the txs in the mempool, with their metadata, in
+	// the order they should be checked again to be accepted by another node.
+	SnapshotTxs() []SnapshotTx
 }
 
+// TxStatus is the status of a tx in the mempool.
+type TxStatus string
+
+const (
+	// The tx is in the mempool, waiting to be included in a block.
+	TxStatusPending TxStatus = "pending"
+	// The tx is held until the missing nonces of its sender are received.
+	TxStatusHeld TxStatus = "held"
+	// The tx is not in the mempool, but was seen recently. It may have been
+	// committed, or rejected or removed by the mempool.
+	TxStatusInCache TxStatus = "in_cache"
+	// The tx was never seen, or is no longer in the cache.
+	TxStatusUnknown TxStatus = "unknown"
+)
+
 // TxChecker defines a TxCheck function that will be called by the rpc and p2p
 // to make sure the sequence is written to mempool sequentially.
 type TxChecker func(req CheckTxRequest)
@@ -148,6 +183,30 @@ func PostCheckMaxGas(maxGas int64) PostCheckFunc {
 	}
 }
 
+// RecheckSelector returns whether a tx of the given sender is rechecked once a
+// block is committed.
+type RecheckSelector func(sender string) bool
+
+// NewRecheckSelector returns a RecheckSelector which selects the txs of the
+// senders in the filter, and the txs without a sender. All the txs are
+// selected if the filter is nil.
+func NewRecheckSelector(filter *abci.RecheckFilter) RecheckSelector {
+	if filter == nil {
+		return func(string) bool { return true }
+	}
+	senders := make(map[string]struct{}, len(filter.Senders))
+	for _, sender := range filter.Senders {
+		senders[sender] = struct{}{}
+	}
+	return func(sender string) bool {
+		if sender == "" {
+			return true
+		}
+		_, ok := senders[sender]
+		return ok
+	}
+}
+
 // ErrTxInCache is returned to the client if we saw tx earlier
 var ErrTxInCache = errors.New("tx already exists in cache")
 
@@ -184,6 +243,58 @@ func (e ErrMempoolIsFull) Error() string {
 	)
 }
 
+// ErrNonceTaken defines an error where the nonce of a transaction is already
+// used by another transaction of its sender in the mempool, or too low to be
+// added before them.
+type ErrNonceTaken struct {
+	Sender string
+	Nonce  uint64
+}
+
+func (e ErrNonceTaken) Error() string {
+	return fmt.Sprintf("nonce %d of sender %s is already taken", e.Nonce, e.Sender)
+}
+
+// ErrTooManyHeldTxs defines an error where a transaction can't be held until
+// the missing nonces of its sender are received, since its sender, or the
+// mempool if Sender is empty, already holds as many transactions as allowed.
+type ErrTooManyHeldTxs struct {
+	Sender string
+	NumTxs int
+	MaxTxs int
+}
+
+func (e ErrTooManyHeldTxs) Error() string {
+	if e.Sender == "" {
+		return fmt.Sprintf("mempool holds too many txs: number of held txs %d (max: %d)", e.NumTxs, e.MaxTxs)
+	}
+	return fmt.Sprintf("sender %s holds too many txs: number of held txs %d (max: %d)", e.Sender, e.NumTxs, e.MaxTxs)
+}
+
+// ErrPeerQuotaExceeded defines an error where a peer has as many transactions
+// in the mempool as its quota allows.
+type ErrPeerQuotaExceeded struct {
+	Peer   p2p.ID
+	NumTxs int
+	MaxTxs int
+}
+
+func (e ErrPeerQuotaExceeded) Error() string {
+	return fmt.Sprintf("quota of peer %s is exceeded: number of txs %d (max: %d)", e.Peer, e.NumTxs, e.MaxTxs)
+}
+
+// ErrSenderQuotaExceeded defines an error where a sender has as many
+// transactions in the mempool as its quota allows.
+type ErrSenderQuotaExceeded struct {
+	Sender string
+	NumTxs int
+	MaxTxs int
+}
+
+func (e ErrSenderQuotaExceeded) Error() string {
+	return fmt.Sprintf("quota of sender %s is exceeded: number of txs %d (max: %d)", e.Sender, e.NumTxs, e.MaxTxs)
+}
+
 // ErrPreCheck defines an error where a transaction fails a pre-check.
 type ErrPreCheck struct {
 	Reason error
@@ -52,16 +52,53 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "recheck_times",
 			Help:      "Number of times transactions are rechecked in the mempool.",
 		}, labels).With(labelsAndValues...),
+		HeldTxs: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "held_txs",
+			Help:      "Number of transactions held until the missing nonces of their sender are received.",
+		}, labels).With(labelsAndValues...),
+		QuotaRejectedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "quota_rejected_txs",
+			Help:      "Number of transactions dropped or rejected because a peer or a sender exceeded its quota, by reason.",
+		}, append(labels, "reason")).With(labelsAndValues...),
+		CheckTxQueueSize: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "check_tx_queue_size",
+			Help:      "Number of transactions waiting to be checked, per CheckTx worker.",
+		}, append(labels, "worker")).With(labelsAndValues...),
+		CheckTxWaitSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "check_tx_wait_seconds",
+			Help:      "Time a transaction waits to be checked, per CheckTx worker.",
+
+			Buckets: stdprometheus.ExponentialBucketsRange(0.0001, 10, 10),
+		}, append(labels, "worker")).With(labelsAndValues...),
+		CheckTxDroppedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "check_tx_dropped_txs",
+			Help:      "Number of transactions received from peers dropped because the queue of their CheckTx worker was full.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
 func NopMetrics() *Metrics {
 	return &Metrics{
-		Size:         discard.NewGauge(),
-		TxSizeBytes:  discard.NewHistogram(),
-		FailedTxs:    discard.NewCounter(),
-		RejectedTxs:  discard.NewCounter(),
-		EvictedTxs:   discard.NewCounter(),
-		RecheckTimes: discard.NewCounter(),
+		Size:               discard.NewGauge(),
+		TxSizeBytes:        discard.NewHistogram(),
+		FailedTxs:          discard.NewCounter(),
+		RejectedTxs:        discard.NewCounter(),
+		EvictedTxs:         discard.NewCounter(),
+		RecheckTimes:       discard.NewCounter(),
+		HeldTxs:            discard.NewGauge(),
+		QuotaRejectedTxs:   discard.NewCounter(),
+		CheckTxQueueSize:   discard.NewGauge(),
+		CheckTxWaitSeconds: discard.NewHistogram(),
+		CheckTxDroppedTxs:  discard.NewCounter(),
 	}
 }
@@ -40,4 +40,22 @@ type Metrics struct {
 
 	// Number of times transactions are rechecked in the mempool.
 	RecheckTimes metrics.Counter
+
+	// Number of transactions held until the missing nonces of their sender
+	// are received.
+	HeldTxs metrics.Gauge
+
+	// Number of transactions dropped or rejected because a peer or a sender
+	// exceeded its quota, by reason.
+	QuotaRejectedTxs metrics.Counter `metrics_labels:"reason"`
+
+	// Number of transactions waiting to be checked, per CheckTx worker.
+	CheckTxQueueSize metrics.Gauge `metrics_labels:"worker"`
+
+	// Time a transaction waits to be checked, per CheckTx worker.
+	CheckTxWaitSeconds metrics.Histogram `metrics_labels:"worker" metrics_buckettype:"exprange" metrics_bucketsizes:"0.0001, 10, 10"`
+
+	// Number of transactions received from peers dropped because the queue
+	// of their CheckTx worker was full.
+	CheckTxDroppedTxs metrics.Counter
 }
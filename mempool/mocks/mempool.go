@@ -30,6 +30,11 @@ func (_m *Mempool) CheckTx(tx types.Tx, callback func(*abcitypes.Response), txIn
 	return r0
 }
 
+// CloseWAL provides a mock function with given fields:
+func (_m *Mempool) CloseWAL() {
+	_m.Called()
+}
+
 // EnableTxsAvailable provides a mock function with given fields:
 func (_m *Mempool) EnableTxsAvailable() {
 	_m.Called()
@@ -54,6 +59,20 @@ func (_m *Mempool) FlushAppConn() error {
 	return r0
 }
 
+// InitWAL provides a mock function with given fields:
+func (_m *Mempool) InitWAL() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Lock provides a mock function with given fields:
 func (_m *Mempool) Lock() {
 	_m.Called()
@@ -133,6 +152,36 @@ func (_m *Mempool) SizeBytes() int64 {
 	return r0
 }
 
+// SnapshotTxs provides a mock function with given fields:
+func (_m *Mempool) SnapshotTxs() []mempool.SnapshotTx {
+	ret := _m.Called()
+
+	var r0 []mempool.SnapshotTx
+	if rf, ok := ret.Get(0).(func() []mempool.SnapshotTx); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]mempool.SnapshotTx)
+		}
+	}
+
+	return r0
+}
+
+// TxStatus provides a mock function with given fields: txKey
+func (_m *Mempool) TxStatus(txKey types.TxKey) mempool.TxStatus {
+	ret := _m.Called(txKey)
+
+	var r0 mempool.TxStatus
+	if rf, ok := ret.Get(0).(func(types.TxKey) mempool.TxStatus); ok {
+		r0 = rf(txKey)
+	} else {
+		r0 = ret.Get(0).(mempool.TxStatus)
+	}
+
+	return r0
+}
+
 // TxsAvailable provides a mock function with given fields:
 func (_m *Mempool) TxsAvailable() <-chan struct{} {
 	ret := _m.Called()
@@ -154,13 +203,13 @@ func (_m *Mempool) Unlock() {
 	_m.Called()
 }
 
-// Update provides a mock function with given fields: blockHeight, blockTxs, deliverTxResponses, newPreFn, newPostFn
-func (_m *Mempool) Update(blockHeight int64, blockTxs types.Txs, deliverTxResponses []*abcitypes.ResponseDeliverTx, newPreFn mempool.PreCheckFunc, newPostFn mempool.PostCheckFunc) error {
-	ret := _m.Called(blockHeight, blockTxs, deliverTxResponses, newPreFn, newPostFn)
+// Update provides a mock function with given fields: blockHeight, blockTxs, deliverTxResponses, recheckFilter, newPreFn, newPostFn
+func (_m *Mempool) Update(blockHeight int64, blockTxs types.Txs, deliverTxResponses []*abcitypes.ResponseDeliverTx, recheckFilter *abcitypes.RecheckFilter, newPreFn mempool.PreCheckFunc, newPostFn mempool.PostCheckFunc) error {
+	ret := _m.Called(blockHeight, blockTxs, deliverTxResponses, recheckFilter, newPreFn, newPostFn)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(int64, types.Txs, []*abcitypes.ResponseDeliverTx, mempool.PreCheckFunc, mempool.PostCheckFunc) error); ok {
-		r0 = rf(blockHeight, blockTxs, deliverTxResponses, newPreFn, newPostFn)
+	if rf, ok := ret.Get(0).(func(int64, types.Txs, []*abcitypes.ResponseDeliverTx, *abcitypes.RecheckFilter, mempool.PreCheckFunc, mempool.PostCheckFunc) error); ok {
+		r0 = rf(blockHeight, blockTxs, deliverTxResponses, recheckFilter, newPreFn, newPostFn)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -0,0 +1,76 @@
+package mempool
+
+import (
+	"time"
+
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// PeerMetrics contains the metrics of the CheckTx queue of each peer. Unlike
+// the ones of Metrics, the series of a peer are removed once it's removed, so
+// that their number is bounded by the number of connected peers.
+type PeerMetrics struct {
+	// Number of transactions received from a peer waiting to be checked.
+	checkTxQueueSize *stdprometheus.GaugeVec
+	// Time a transaction received from a peer waits to be checked.
+	checkTxWaitSeconds *stdprometheus.HistogramVec
+}
+
+// PrometheusPeerMetrics returns the PeerMetrics, registered in the default
+// Prometheus registry.
+func PrometheusPeerMetrics(namespace string, labelsAndValues ...string) *PeerMetrics {
+	labels := stdprometheus.Labels{}
+	for i := 0; i+1 < len(labelsAndValues); i += 2 {
+		labels[labelsAndValues[i]] = labelsAndValues[i+1]
+	}
+	m := &PeerMetrics{
+		checkTxQueueSize: stdprometheus.NewGaugeVec(stdprometheus.GaugeOpts{
+			Namespace:   namespace,
+			Subsystem:   MetricsSubsystem,
+			Name:        "check_tx_peer_queue_size",
+			Help:        "Number of transactions received from a peer waiting to be checked.",
+			ConstLabels: labels,
+		}, []string{"peer_id"}),
+		checkTxWaitSeconds: stdprometheus.NewHistogramVec(stdprometheus.HistogramOpts{
+			Namespace:   namespace,
+			Subsystem:   MetricsSubsystem,
+			Name:        "check_tx_peer_wait_seconds",
+			Help:        "Time a transaction received from a peer waits to be checked.",
+			ConstLabels: labels,
+
+			Buckets: stdprometheus.ExponentialBucketsRange(0.0001, 10, 10),
+		}, []string{"peer_id"}),
+	}
+	stdprometheus.MustRegister(m.checkTxQueueSize, m.checkTxWaitSeconds)
+	return m
+}
+
+// NopPeerMetrics returns PeerMetrics which aren't recorded.
+func NopPeerMetrics() *PeerMetrics {
+	return &PeerMetrics{}
+}
+
+// SetCheckTxQueueSize records the number of txs of a peer waiting to be
+// checked.
+func (m *PeerMetrics) SetCheckTxQueueSize(peerID string, size int) {
+	if m.checkTxQueueSize != nil {
+		m.checkTxQueueSize.WithLabelValues(peerID).Set(float64(size))
+	}
+}
+
+// ObserveCheckTxWait records the time a tx of a peer waited to be checked.
+func (m *PeerMetrics) ObserveCheckTxWait(peerID string, wait time.Duration) {
+	if m.checkTxWaitSeconds != nil {
+		m.checkTxWaitSeconds.WithLabelValues(peerID).Observe(wait.Seconds())
+	}
+}
+
+// RemovePeer removes the series of a peer.
+func (m *PeerMetrics) RemovePeer(peerID string) {
+	if m.checkTxQueueSize != nil {
+		m.checkTxQueueSize.DeleteLabelValues(peerID)
+	}
+	if m.checkTxWaitSeconds != nil {
+		m.checkTxWaitSeconds.DeleteLabelValues(peerID)
+	}
+}
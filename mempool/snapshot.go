@@ -0,0 +1,85 @@
+package mempool
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cometbft/cometbft/libs/protoio"
+	protomem "github.com/cometbft/cometbft/proto/tendermint/mempool"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Max size of a tx in a snapshot, with its metadata.
+const maxSnapshotTxSize = types.MaxBlockSizeBytes + 1024
+
+// SnapshotTx is a tx in the mempool, with the metadata the mempool holds about
+// it. Held txs are included.
+type SnapshotTx struct {
+	Tx        types.Tx
+	Height    int64  // height the tx was last checked at
+	GasWanted int64  // gas the tx states it will require
+	Sender    string // sender returned by the app, if any
+	Priority  int64  // priority returned by the app, if any
+}
+
+// ToProto converts a SnapshotTx to its protobuf representation.
+func (stx SnapshotTx) ToProto() *protomem.SnapshotTx {
+	return &protomem.SnapshotTx{
+		Tx:        stx.Tx,
+		Height:    stx.Height,
+		GasWanted: stx.GasWanted,
+		Sender:    stx.Sender,
+		Priority:  stx.Priority,
+	}
+}
+
+// SnapshotTxFromProto converts a protobuf SnapshotTx to a SnapshotTx.
+func SnapshotTxFromProto(pb *protomem.SnapshotTx) (SnapshotTx, error) {
+	if len(pb.Tx) == 0 {
+		return SnapshotTx{}, errors.New("empty tx")
+	}
+	return SnapshotTx{
+		Tx:        pb.Tx,
+		Height:    pb.Height,
+		GasWanted: pb.GasWanted,
+		Sender:    pb.Sender,
+		Priority:  pb.Priority,
+	}, nil
+}
+
+// WriteSnapshot writes the given txs to w, each as a length-prefixed
+// protobuf SnapshotTx, and returns the number of txs written.
+func WriteSnapshot(w io.Writer, txs []SnapshotTx) (int, error) {
+	bw := bufio.NewWriter(w)
+	pw := protoio.NewDelimitedWriter(bw)
+	for i, stx := range txs {
+		if _, err := pw.WriteMsg(stx.ToProto()); err != nil {
+			return i, fmt.Errorf("failed to write tx %v: %w", stx.Tx.Hash(), err)
+		}
+	}
+	return len(txs), bw.Flush()
+}
+
+// ReadSnapshot reads the txs written by WriteSnapshot from r, and passes them
+// to fn in order. Reading stops at the first error returned by fn.
+func ReadSnapshot(r io.Reader, fn func(SnapshotTx) error) error {
+	pr := protoio.NewDelimitedReader(bufio.NewReader(r), maxSnapshotTxSize)
+	for i := 0; ; i++ {
+		var pb protomem.SnapshotTx
+		if _, err := pr.ReadMsg(&pb); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("failed to read tx #%d: %w", i, err)
+		}
+		stx, err := SnapshotTxFromProto(&pb)
+		if err != nil {
+			return fmt.Errorf("invalid tx #%d: %w", i, err)
+		}
+		if err := fn(stx); err != nil {
+			return err
+		}
+	}
+}
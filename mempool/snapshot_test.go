@@ -0,0 +1,45 @@
+package mempool
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestSnapshot_WriteRead(t *testing.T) {
+	txs := []SnapshotTx{
+		{Tx: types.Tx("tx1"), Height: 1, GasWanted: 10, Sender: "a", Priority: 5},
+		{Tx: types.Tx("tx2"), Height: 2},
+		{Tx: make(types.Tx, 1024), Height: 3, GasWanted: 1, Sender: "b"},
+	}
+
+	var buf bytes.Buffer
+	n, err := WriteSnapshot(&buf, txs)
+	require.NoError(t, err)
+	require.Equal(t, len(txs), n)
+
+	read := make([]SnapshotTx, 0)
+	require.NoError(t, ReadSnapshot(bytes.NewReader(buf.Bytes()), func(stx SnapshotTx) error {
+		read = append(read, stx)
+		return nil
+	}))
+	require.Equal(t, txs, read)
+
+	// reading stops at the first error of the callback
+	errStop := errors.New("stop")
+	read = read[:0]
+	err = ReadSnapshot(bytes.NewReader(buf.Bytes()), func(stx SnapshotTx) error {
+		read = append(read, stx)
+		return errStop
+	})
+	require.ErrorIs(t, err, errStop)
+	require.Len(t, read, 1)
+
+	// a truncated snapshot is an error
+	err = ReadSnapshot(bytes.NewReader(buf.Bytes()[:buf.Len()-1]), func(SnapshotTx) error { return nil })
+	require.Error(t, err)
+}
@@ -0,0 +1,56 @@
+package mempool
+
+import (
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/types"
+)
+
+// TxEventQueue publishes the lifecycle events of the txs in the background, in
+// the order they were pushed, so that the mempool is never blocked by slow
+// subscribers, e.g. while proposing or committing a block.
+type TxEventQueue struct {
+	publish func(types.EventDataMempoolTx)
+
+	mtx      cmtsync.Mutex
+	events   []types.EventDataMempoolTx
+	draining bool // whether a routine is publishing the events
+}
+
+// NewTxEventQueue returns a queue which publishes the events with publish.
+func NewTxEventQueue(publish func(types.EventDataMempoolTx)) *TxEventQueue {
+	return &TxEventQueue{publish: publish}
+}
+
+// Push queues the events, to be published after the ones already queued.
+func (q *TxEventQueue) Push(events ...types.EventDataMempoolTx) {
+	if len(events) == 0 {
+		return
+	}
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	q.events = append(q.events, events...)
+	if !q.draining {
+		q.draining = true
+		go q.drain()
+	}
+}
+
+// drain publishes the queued events until there are none left.
+func (q *TxEventQueue) drain() {
+	for {
+		q.mtx.Lock()
+		events := q.events
+		q.events = nil
+		if len(events) == 0 {
+			q.draining = false
+			q.mtx.Unlock()
+			return
+		}
+		q.mtx.Unlock()
+
+		for _, event := range events {
+			q.publish(event)
+		}
+	}
+}
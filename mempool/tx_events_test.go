@@ -0,0 +1,44 @@
+package mempool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestTxEventQueueOrder(t *testing.T) {
+	published := make(chan types.EventDataMempoolTx)
+	q := NewTxEventQueue(func(data types.EventDataMempoolTx) { published <- data })
+
+	// the events are queued while the first one is being published
+	tx := types.Tx("tx")
+	statuses := []string{types.MempoolTxAdded, types.MempoolTxReaped, types.MempoolTxCommitted}
+	for _, status := range statuses {
+		q.Push(types.EventDataMempoolTx{Hash: tx.Hash(), Status: status})
+	}
+	for _, status := range statuses {
+		select {
+		case data := <-published:
+			require.Equal(t, status, data.Status)
+		case <-time.After(time.Second):
+			t.Fatalf("did not receive a %s event", status)
+		}
+	}
+
+	// the queue publishes the events pushed once it's drained
+	require.Eventually(t, func() bool {
+		q.mtx.Lock()
+		defer q.mtx.Unlock()
+		return !q.draining
+	}, time.Second, time.Millisecond)
+	q.Push(types.EventDataMempoolTx{Hash: tx.Hash(), Status: types.MempoolTxEvicted})
+	select {
+	case data := <-published:
+		require.Equal(t, types.MempoolTxEvicted, data.Status)
+	case <-time.After(time.Second):
+		t.Fatal("did not receive an evicted event")
+	}
+}
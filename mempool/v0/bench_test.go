@@ -4,10 +4,14 @@ import (
 	"encoding/binary"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/cometbft/cometbft/abci/example/kvstore"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
 )
 
 func BenchmarkReap(b *testing.B) {
@@ -99,3 +103,56 @@ func BenchmarkCheckDuplicateTx(b *testing.B) {
 		}
 	}
 }
+
+// BenchmarkReactorBroadcastTxs measures the throughput of gossiping txs from a
+// reactor to a peer, sending either a tx or a batch of txs per message.
+func BenchmarkReactorBroadcastTxs(b *testing.B) {
+	testCases := []struct {
+		name          string
+		maxBatchBytes int
+	}{
+		{"single", 0},
+		{"batch", cfg.DefaultMempoolConfig().MaxBatchBytes},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		b.Run(tc.name, func(b *testing.B) {
+			const txsPerIter = 1000
+
+			config := cfg.TestConfig()
+			config.Mempool.MaxBatchBytes = tc.maxBatchBytes
+			reactors := makeAndConnectReactors(config, 2)
+			defer func() {
+				for _, r := range reactors {
+					_ = r.Stop()
+				}
+			}()
+			for _, r := range reactors {
+				r.SetLogger(log.NewNopLogger())
+				for _, peer := range r.Switch.Peers().List() {
+					peer.Set(types.PeerStateKey, peerState{1})
+				}
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < txsPerIter; j++ {
+					tx := make([]byte, 256)
+					binary.BigEndian.PutUint64(tx, uint64(i*txsPerIter+j))
+					if err := reactors[0].mempool.CheckTx(tx, nil, mempool.TxInfo{}); err != nil {
+						b.Fatal(err)
+					}
+				}
+				for reactors[1].mempool.Size() < txsPerIter {
+					time.Sleep(time.Millisecond)
+				}
+
+				b.StopTimer()
+				reactors[0].mempool.Flush()
+				reactors[1].mempool.Flush()
+				b.StartTimer()
+			}
+			b.ReportMetric(float64(b.N*txsPerIter)/b.Elapsed().Seconds(), "txs/s")
+		})
+	}
+}
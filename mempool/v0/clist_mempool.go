@@ -3,6 +3,7 @@ package v0
 import (
 	"bytes"
 	"errors"
+	"sort"
 	"sync"
 	"sync/atomic"
 
@@ -57,8 +58,27 @@ type CListMempool struct {
 	// This reduces the pressure on the proxyApp.
 	cache mempool.TxCache
 
-	logger  log.Logger
-	metrics *mempool.Metrics
+	// Write-ahead log of the txs in the mempool, nil if disabled.
+	wal *mempool.WAL
+
+	// Txs of each sender, if txs are ordered by sender nonce. The held txs
+	// are not in the list until the missing nonces of their sender are
+	// received.
+	sendersMtx   cmtsync.Mutex
+	senders      map[string]*senderTxs
+	heldTxs      map[types.TxKey]*mempoolTx
+	heldTxsBytes int64
+
+	// Number of txs in the mempool, including the held ones, of each peer and
+	// sender, to enforce their quotas.
+	quotasMtx    cmtsync.Mutex
+	peerNumTxs   map[p2p.ID]int // peer node ID -> number of txs first received from the peer
+	senderNumTxs map[string]int // sender -> number of txs of the sender
+
+	eventBus types.MempoolEventPublisher
+	txEvents *mempool.TxEventQueue // lifecycle events of the txs to publish
+	logger   log.Logger
+	metrics  *mempool.Metrics
 }
 
 var _ mempool.Mempool = &CListMempool{}
@@ -81,9 +101,15 @@ func NewCListMempool(
 		height:        height,
 		recheckCursor: nil,
 		recheckEnd:    nil,
+		senders:       make(map[string]*senderTxs),
+		heldTxs:       make(map[types.TxKey]*mempoolTx),
+		peerNumTxs:    make(map[p2p.ID]int),
+		senderNumTxs:  make(map[string]int),
+		eventBus:      types.NopEventBus{},
 		logger:        log.NewNopLogger(),
 		metrics:       mempool.NopMetrics(),
 	}
+	mp.txEvents = mempool.NewTxEventQueue(mp.publishTxEventData)
 
 	if cfg.CacheSize > 0 {
 		mp.cache = mempool.NewLRUTxCache(cfg.CacheSize)
@@ -129,6 +155,64 @@ func WithMetrics(metrics *mempool.Metrics) CListMempoolOption {
 	return func(mem *CListMempool) { mem.metrics = metrics }
 }
 
+// WithEventBus sets the event bus the lifecycle events of the txs are
+// published to.
+func WithEventBus(eventBus types.MempoolEventPublisher) CListMempoolOption {
+	return func(mem *CListMempool) { mem.eventBus = eventBus }
+}
+
+// InitWAL opens the write-ahead log in the configured directory, and replays
+// the txs in it through CheckTx.
+//
+// NOTE: not thread safe - should only be called once, on startup, before any
+// tx is checked
+func (mem *CListMempool) InitWAL() error {
+	wal, err := mempool.NewWAL(mem.config.WalDir())
+	if err != nil {
+		return err
+	}
+	wal.SetLogger(mem.logger.With("wal", mem.config.WalDir()))
+	if err := wal.Start(); err != nil {
+		return err
+	}
+	mem.wal = wal
+
+	return wal.Replay(func(tx types.Tx) error {
+		return mem.CheckTx(tx, nil, mempool.TxInfo{SenderID: mempool.UnknownPeerID})
+	}, mem.proxyAppConn.FlushSync)
+}
+
+// CloseWAL flushes and closes the write-ahead log.
+func (mem *CListMempool) CloseWAL() {
+	if mem.wal == nil {
+		return
+	}
+	if err := mem.wal.Stop(); err != nil {
+		mem.logger.Error("Error closing WAL", "err", err)
+	}
+}
+
+// TxStatus returns the status of a tx in the mempool and its cache.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *CListMempool) TxStatus(txKey types.TxKey) mempool.TxStatus {
+	if _, ok := mem.txsMap.Load(txKey); ok {
+		return mempool.TxStatusPending
+	}
+	if mem.config.SenderNonceOrdering {
+		mem.sendersMtx.Lock()
+		_, held := mem.heldTxs[txKey]
+		mem.sendersMtx.Unlock()
+		if held {
+			return mempool.TxStatusHeld
+		}
+	}
+	if mem.cache.HasKey(txKey) {
+		return mempool.TxStatusInCache
+	}
+	return mempool.TxStatusUnknown
+}
+
 // Safe for concurrent use by multiple goroutines.
 func (mem *CListMempool) Lock() {
 	mem.updateMtx.Lock()
@@ -165,12 +249,16 @@ func (mem *CListMempool) Flush() {
 	for e := mem.txs.Front(); e != nil; e = e.Next() {
 		mem.txs.Remove(e)
 		e.DetachPrev()
+		mem.removeTxFromWAL(e.Value.(*mempoolTx).tx)
 	}
 
 	mem.txsMap.Range(func(key, _ interface{}) bool {
 		mem.txsMap.Delete(key)
 		return true
 	})
+
+	mem.resetSenders()
+	mem.resetQuotas()
 }
 
 // TxsFront returns the first transaction in the ordered list for peer
@@ -311,7 +399,9 @@ func (mem *CListMempool) reqResCb(
 
 // Called from:
 //   - resCbFirstTime (lock not held) if tx is valid
-func (mem *CListMempool) addTx(memTx *mempoolTx) {
+//
+// Returns the element of the tx in the list.
+func (mem *CListMempool) addTx(memTx *mempoolTx) *clist.CElement {
 	e := mem.txs.PushBack(memTx)
 	if e == nil {
 		panic("failed to push tx into mempool")
@@ -319,35 +409,90 @@ func (mem *CListMempool) addTx(memTx *mempoolTx) {
 	mem.txsMap.Store(memTx.tx.Key(), e)
 	atomic.AddInt64(&mem.txsBytes, int64(len(memTx.tx)))
 	mem.metrics.TxSizeBytes.Observe(float64(len(memTx.tx)))
+	mem.acquireQuotas(memTx)
+	mem.publishTxEvent(memTx.tx, types.MempoolTxAdded, "")
+
+	if mem.wal != nil {
+		if err := mem.wal.AddTx(memTx.tx); err != nil {
+			mem.logger.Error("Error writing tx to WAL", "tx", memTx.tx.Hash(), "err", err)
+		}
+	}
+	return e
 }
 
 // Called from:
 //   - Update (lock held) if tx was committed
 //   - resCbRecheck (lock not held) if tx was invalidated
-func (mem *CListMempool) removeTx(tx types.Tx, elem *clist.CElement, removeFromCache bool) {
+func (mem *CListMempool) removeTx(tx types.Tx, elem *clist.CElement, removeFromCache, committed bool) {
 	mem.txs.Remove(elem)
 	elem.DetachPrev()
 	mem.txsMap.Delete(tx.Key())
 	atomic.AddInt64(&mem.txsBytes, int64(-len(tx)))
+	mem.removeTxFromWAL(tx)
+	mem.removeSenderTx(elem.Value.(*mempoolTx), committed)
+	mem.releaseQuotas(elem.Value.(*mempoolTx))
 
 	if removeFromCache {
 		mem.cache.Remove(tx)
 	}
 }
 
+// publishTxEvent queues a lifecycle event of a tx, see publishTxEvents.
+func (mem *CListMempool) publishTxEvent(tx types.Tx, status, reason string) {
+	mem.publishTxEvents(types.Txs{tx}, status, reason)
+}
+
+// publishTxEvents queues the same lifecycle event of several txs. The events
+// are published in the background, in order, so that the mempool isn't blocked
+// by slow subscribers.
+func (mem *CListMempool) publishTxEvents(txs types.Txs, status, reason string) {
+	if _, ok := mem.eventBus.(types.NopEventBus); ok || len(txs) == 0 {
+		return
+	}
+	events := make([]types.EventDataMempoolTx, len(txs))
+	for i, tx := range txs {
+		events[i] = types.EventDataMempoolTx{
+			Hash:   tx.Hash(),
+			Status: status,
+			Height: mem.height,
+			Reason: reason,
+		}
+	}
+	mem.txEvents.Push(events...)
+}
+
+func (mem *CListMempool) publishTxEventData(data types.EventDataMempoolTx) {
+	if err := mem.eventBus.PublishEventMempoolTx(data); err != nil {
+		mem.logger.Error("Error publishing mempool tx event", "tx", data.Hash, "status", data.Status, "err", err)
+	}
+}
+
+func (mem *CListMempool) removeTxFromWAL(tx types.Tx) {
+	if mem.wal != nil {
+		if err := mem.wal.RemoveTx(tx); err != nil {
+			mem.logger.Error("Error writing removed tx to WAL", "tx", tx.Hash(), "err", err)
+		}
+	}
+}
+
 // RemoveTxByKey removes a transaction from the mempool by its TxKey index.
 func (mem *CListMempool) RemoveTxByKey(txKey types.TxKey) error {
 	if e, ok := mem.txsMap.Load(txKey); ok {
 		memTx := e.(*clist.CElement).Value.(*mempoolTx)
 		if memTx != nil {
-			mem.removeTx(memTx.tx, e.(*clist.CElement), false)
+			mem.removeTx(memTx.tx, e.(*clist.CElement), false, false)
 			return nil
 		}
 		return errors.New("transaction not found")
 	}
+	if mem.removeHeldTx(txKey, false) {
+		return nil
+	}
 	return errors.New("invalid transaction found")
 }
 
+// isFull doesn't count the held txs, which are bounded on their own by
+// MaxHeldTxs.
 func (mem *CListMempool) isFull(txSize int) error {
 	var (
 		memSize  = mem.Size()
@@ -389,6 +534,7 @@ func (mem *CListMempool) resCbFirstTime(
 				// remove from cache (mempool might have a space later)
 				mem.cache.Remove(tx)
 				mem.logger.Error(err.Error())
+				mem.publishTxEvent(tx, types.MempoolTxEvicted, err.Error())
 				return
 			}
 
@@ -410,9 +556,46 @@ func (mem *CListMempool) resCbFirstTime(
 				height:    mem.height,
 				gasWanted: r.CheckTx.GasWanted,
 				tx:        tx,
+				priority:  r.CheckTx.Priority,
+				peer:      peerP2PID,
+				sender:    r.CheckTx.Sender,
 			}
 			memTx.senders.Store(peerID, true)
-			mem.addTx(memTx)
+
+			if err := mem.checkQuotas(memTx); err != nil {
+				// remove from cache (the quota might be available later)
+				mem.cache.Remove(tx)
+				r.CheckTx.MempoolError = err.Error()
+				mem.metrics.RejectedTxs.Add(1)
+				mem.logger.Debug("rejected transaction", "tx", types.Tx(tx).Hash(), "err", err)
+				mem.publishTxEvent(tx, types.MempoolTxEvicted, err.Error())
+				return
+			}
+
+			if mem.config.SenderNonceOrdering && memTx.sender != "" {
+				memTx.nonce = r.CheckTx.Nonce
+				held, err := mem.addSenderTx(memTx)
+				if err != nil {
+					// remove from cache (the nonce might be free later)
+					mem.cache.Remove(tx)
+					r.CheckTx.MempoolError = err.Error()
+					mem.metrics.RejectedTxs.Add(1)
+					mem.logger.Debug("rejected transaction", "tx", types.Tx(tx).Hash(), "err", err)
+					mem.publishTxEvent(tx, types.MempoolTxEvicted, err.Error())
+					return
+				}
+				if held {
+					mem.logger.Debug(
+						"holding transaction until the missing nonces are received",
+						"tx", types.Tx(tx).Hash(),
+						"sender", memTx.sender,
+						"nonce", memTx.nonce,
+					)
+					return
+				}
+			} else {
+				mem.addTx(memTx)
+			}
 			mem.logger.Debug(
 				"added good transaction",
 				"tx", types.Tx(tx).Hash(),
@@ -463,13 +646,19 @@ func (mem *CListMempool) resCbRecheck(req *abci.Request, res *abci.Response) {
 				break
 			}
 
-			mem.logger.Error(
-				"re-CheckTx transaction mismatch",
-				"got", types.Tx(tx),
-				"expected", memTx.tx,
-			)
+			// The txs which were not selected for recheck are skipped.
+			if memTx.recheck {
+				mem.logger.Error(
+					"re-CheckTx transaction mismatch",
+					"got", types.Tx(tx),
+					"expected", memTx.tx,
+				)
+			}
 
-			if mem.recheckCursor == mem.recheckEnd {
+			// The end of the list is reached early if the last txs were held
+			// again during the recheck.
+			next := mem.recheckCursor.Next()
+			if mem.recheckCursor == mem.recheckEnd || next == nil {
 				// we reached the end of the recheckTx list without finding a tx
 				// matching the one we received from the ABCI application.
 				// Return without processing any tx.
@@ -477,7 +666,7 @@ func (mem *CListMempool) resCbRecheck(req *abci.Request, res *abci.Response) {
 				return
 			}
 
-			mem.recheckCursor = mem.recheckCursor.Next()
+			mem.recheckCursor = next
 			memTx = mem.recheckCursor.Value.(*mempoolTx)
 		}
 
@@ -492,7 +681,18 @@ func (mem *CListMempool) resCbRecheck(req *abci.Request, res *abci.Response) {
 			// Tx became invalidated due to newly committed block.
 			mem.logger.Debug("tx is no longer valid", "tx", types.Tx(tx).Hash(), "res", r, "err", postCheckErr)
 			// NOTE: we remove tx from the cache because it might be good later
-			mem.removeTx(tx, mem.recheckCursor, !mem.config.KeepInvalidTxsInCache)
+			if mem.recheckCursor.Removed() {
+				// The tx was held again, once a tx of its sender with a lower
+				// nonce was invalidated by the ongoing recheck.
+				mem.removeRecheckedTx(tx)
+			} else {
+				mem.removeTx(tx, mem.recheckCursor, !mem.config.KeepInvalidTxsInCache, false)
+			}
+			reason := r.CheckTx.Log
+			if postCheckErr != nil {
+				reason = postCheckErr.Error()
+			}
+			mem.publishTxEvent(tx, types.MempoolTxRecheckInvalid, reason)
 		}
 		if mem.recheckCursor == mem.recheckEnd {
 			mem.recheckCursor = nil
@@ -549,6 +749,37 @@ func (mem *CListMempool) ReapMaxTxs(max int) types.Txs {
 	return txs
 }
 
+// SnapshotTxs returns the txs in the order they are reaped, followed by the
+// held txs of each sender in nonce order.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *CListMempool) SnapshotTxs() []mempool.SnapshotTx {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+
+	txs := make([]mempool.SnapshotTx, 0, mem.txs.Len())
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		txs = append(txs, e.Value.(*mempoolTx).snapshot())
+	}
+
+	mem.sendersMtx.Lock()
+	held := make([]*mempoolTx, 0, len(mem.heldTxs))
+	for _, memTx := range mem.heldTxs {
+		held = append(held, memTx)
+	}
+	mem.sendersMtx.Unlock()
+	sort.Slice(held, func(i, j int) bool {
+		if held[i].sender == held[j].sender {
+			return held[i].nonce < held[j].nonce
+		}
+		return held[i].sender < held[j].sender
+	})
+	for _, memTx := range held {
+		txs = append(txs, memTx.snapshot())
+	}
+	return txs
+}
+
 // Safe for concurrent use by multiple goroutines.
 func (mem *CListMempool) ReapMaxTxsMaxBytesMaxGas(maxTxs int, maxBytes, maxGas int64) types.Txs {
 	mem.updateMtx.RLock()
@@ -568,7 +799,7 @@ func (mem *CListMempool) ReapMaxTxsMaxBytesMaxGas(maxTxs int, maxBytes, maxGas i
 	for e := mem.txs.Front(); e != nil; e = e.Next() {
 		totalTxs++
 		if totalTxs > maxTxs {
-			return txs
+			break
 		}
 
 		memTx := e.Value.(*mempoolTx)
@@ -579,7 +810,8 @@ func (mem *CListMempool) ReapMaxTxsMaxBytesMaxGas(maxTxs int, maxBytes, maxGas i
 
 		// Check total size requirement
 		if maxBytes > -1 && runningSize+dataSize > maxBytes {
-			return txs[:len(txs)-1]
+			txs = txs[:len(txs)-1]
+			break
 		}
 
 		runningSize += dataSize
@@ -590,10 +822,13 @@ func (mem *CListMempool) ReapMaxTxsMaxBytesMaxGas(maxTxs int, maxBytes, maxGas i
 		// must be non-negative, it follows that this won't overflow.
 		newTotalGas := totalGas + memTx.gasWanted
 		if maxGas > -1 && newTotalGas > maxGas {
-			return txs[:len(txs)-1]
+			txs = txs[:len(txs)-1]
+			break
 		}
 		totalGas = newTotalGas
 	}
+
+	mem.publishTxEvents(txs, types.MempoolTxReaped, "")
 	return txs
 }
 
@@ -602,6 +837,7 @@ func (mem *CListMempool) Update(
 	height int64,
 	txs types.Txs,
 	deliverTxResponses []*abci.ResponseDeliverTx,
+	recheckFilter *abci.RecheckFilter,
 	preCheck mempool.PreCheckFunc,
 	postCheck mempool.PostCheckFunc,
 ) error {
@@ -616,6 +852,7 @@ func (mem *CListMempool) Update(
 		mem.postCheck = postCheck
 	}
 
+	committed := make(types.Txs, 0, len(txs))
 	for i, tx := range txs {
 		if deliverTxResponses[i].Code == abci.CodeTypeOK {
 			// Add valid committed tx to the cache (if missing).
@@ -636,7 +873,23 @@ func (mem *CListMempool) Update(
 		//   100
 		// https://github.com/tendermint/tendermint/issues/3322.
 		if e, ok := mem.txsMap.Load(tx.Key()); ok {
-			mem.removeTx(tx, e.(*clist.CElement), false)
+			mem.removeTx(tx, e.(*clist.CElement), false, true)
+			committed = append(committed, tx)
+		} else if mem.config.SenderNonceOrdering {
+			mem.removeHeldTx(tx.Key(), true)
+		}
+	}
+	mem.publishTxEvents(committed, types.MempoolTxCommitted, "")
+
+	if mem.config.SenderNonceOrdering {
+		mem.updateSenders()
+	}
+
+	// Sync the removal of the committed txs to the WAL, which is compacted
+	// once most of it is made of removed txs.
+	if mem.wal != nil {
+		if err := mem.wal.Trim(mem.allTxs); err != nil {
+			mem.logger.Error("Error trimming WAL", "err", err)
 		}
 	}
 
@@ -645,7 +898,7 @@ func (mem *CListMempool) Update(
 	if mem.Size() > 0 {
 		if mem.config.Recheck {
 			mem.logger.Debug("recheck txs", "numtxs", mem.Size(), "height", height)
-			mem.recheckTxs()
+			mem.recheckTxs(mempool.NewRecheckSelector(recheckFilter))
 			// At this point, mem.txs are being rechecked.
 			// mem.recheckCursor re-scans mem.txs and possibly removes some txs.
 			// Before mem.Reap(), we should wait for mem.recheckCursor to be nil.
@@ -660,23 +913,60 @@ func (mem *CListMempool) Update(
 	return nil
 }
 
-func (mem *CListMempool) recheckTxs() {
+// allTxs returns the txs in the mempool.
+// Lock() must be held by the caller.
+func (mem *CListMempool) allTxs() types.Txs {
+	txs := make(types.Txs, 0, mem.txs.Len())
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		txs = append(txs, e.Value.(*mempoolTx).tx)
+	}
+	return txs
+}
+
+// recheckTxs rechecks the txs selected by the given selector. The recheck
+// cursor goes from the first to the last selected tx, skipping the others.
+func (mem *CListMempool) recheckTxs(selector mempool.RecheckSelector) {
 	if mem.Size() == 0 {
 		panic("recheckTxs is called, but the mempool is empty")
 	}
 
-	mem.recheckCursor = mem.txs.Front()
-	mem.recheckEnd = mem.txs.Back()
+	mem.recheckCursor = nil
+	mem.recheckEnd = nil
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		memTx := e.Value.(*mempoolTx)
+		memTx.recheck = selector(memTx.sender)
+		if !memTx.recheck {
+			continue
+		}
+		if mem.recheckCursor == nil {
+			mem.recheckCursor = e
+		}
+		mem.recheckEnd = e
+	}
+
+	if mem.recheckCursor == nil {
+		mem.logger.Debug("no txs selected for recheck")
+		mem.notifyTxsAvailable()
+		return
+	}
 
 	// Push txs to proxyAppConn
 	// NOTE: globalCb may be called concurrently.
-	for e := mem.txs.Front(); e != nil; e = e.Next() {
+	numTxs := 0
+	for e := mem.recheckCursor; e != nil; e = e.Next() {
 		memTx := e.Value.(*mempoolTx)
-		mem.proxyAppConn.CheckTxAsync(abci.RequestCheckTx{
-			Tx:   memTx.tx,
-			Type: abci.CheckTxType_Recheck,
-		})
+		if memTx.recheck {
+			mem.proxyAppConn.CheckTxAsync(abci.RequestCheckTx{
+				Tx:   memTx.tx,
+				Type: abci.CheckTxType_Recheck,
+			})
+			numTxs++
+		}
+		if e == mem.recheckEnd {
+			break
+		}
 	}
+	mem.logger.Debug("rechecking txs", "selected", numTxs, "skipped", mem.Size()-numTxs)
 
 	mem.proxyAppConn.FlushAsync()
 }
@@ -688,6 +978,11 @@ type mempoolTx struct {
 	height    int64    // height that this tx had been validated in
 	gasWanted int64    // amount of gas this tx states it will require
 	tx        types.Tx //
+	priority  int64    // priority of the tx returned by the app
+	peer      p2p.ID   // node ID of the peer the tx was first received from
+	sender    string   // sender of the tx returned by the app, if any
+	nonce     uint64   // nonce of the tx for its sender, if txs are ordered by sender nonce
+	recheck   bool     // whether the tx is selected by the ongoing recheck
 
 	// ids of peers who've sent us this tx (as a map for quick lookups).
 	// senders: PeerID -> bool
@@ -698,3 +993,13 @@ type mempoolTx struct {
 func (memTx *mempoolTx) Height() int64 {
 	return atomic.LoadInt64(&memTx.height)
 }
+
+func (memTx *mempoolTx) snapshot() mempool.SnapshotTx {
+	return mempool.SnapshotTx{
+		Tx:        memTx.tx,
+		Height:    memTx.Height(),
+		GasWanted: memTx.gasWanted,
+		Sender:    memTx.sender,
+		Priority:  memTx.priority,
+	}
+}
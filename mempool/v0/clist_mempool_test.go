@@ -1,12 +1,15 @@
 package v0
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/binary"
 	"fmt"
 	mrand "math/rand"
 	"os"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -26,6 +29,7 @@ import (
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	"github.com/cometbft/cometbft/libs/service"
 	"github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/proxy"
 	"github.com/cometbft/cometbft/types"
 )
@@ -204,7 +208,7 @@ func TestMempoolFilters(t *testing.T) {
 		{10, mempool.PreCheckMaxBytes(22), mempool.PostCheckMaxGas(0), 0},
 	}
 	for tcIndex, tt := range tests {
-		err := mp.Update(1, emptyTxArr, abciResponses(len(emptyTxArr), abci.CodeTypeOK), tt.preFilter, tt.postFilter)
+		err := mp.Update(1, emptyTxArr, abciResponses(len(emptyTxArr), abci.CodeTypeOK), nil, tt.preFilter, tt.postFilter)
 		require.NoError(t, err)
 		checkTxs(t, mp, tt.numTxsToCreate, mempool.UnknownPeerID)
 		require.Equal(t, tt.expectedNumTxs, mp.Size(), "mempool had the incorrect size, on test case %d", tcIndex)
@@ -220,7 +224,7 @@ func TestMempoolUpdate(t *testing.T) {
 
 	// 1. Adds valid txs to the cache
 	{
-		err := mp.Update(1, []types.Tx{[]byte{0x01}}, abciResponses(1, abci.CodeTypeOK), nil, nil)
+		err := mp.Update(1, []types.Tx{[]byte{0x01}}, abciResponses(1, abci.CodeTypeOK), nil, nil, nil)
 		require.NoError(t, err)
 		err = mp.CheckTx([]byte{0x01}, nil, mempool.TxInfo{})
 		if assert.Error(t, err) {
@@ -232,7 +236,7 @@ func TestMempoolUpdate(t *testing.T) {
 	{
 		err := mp.CheckTx([]byte{0x02}, nil, mempool.TxInfo{})
 		require.NoError(t, err)
-		err = mp.Update(1, []types.Tx{[]byte{0x02}}, abciResponses(1, abci.CodeTypeOK), nil, nil)
+		err = mp.Update(1, []types.Tx{[]byte{0x02}}, abciResponses(1, abci.CodeTypeOK), nil, nil, nil)
 		require.NoError(t, err)
 		assert.Zero(t, mp.Size())
 	}
@@ -241,7 +245,7 @@ func TestMempoolUpdate(t *testing.T) {
 	{
 		err := mp.CheckTx([]byte{0x03}, nil, mempool.TxInfo{})
 		require.NoError(t, err)
-		err = mp.Update(1, []types.Tx{[]byte{0x03}}, abciResponses(1, 1), nil, nil)
+		err = mp.Update(1, []types.Tx{[]byte{0x03}}, abciResponses(1, 1), nil, nil, nil)
 		require.NoError(t, err)
 		assert.Zero(t, mp.Size())
 
@@ -282,7 +286,7 @@ func TestMempoolUpdateDoesNotPanicWhenApplicationMissedTx(t *testing.T) {
 
 	// Calling update to remove the first transaction from the mempool.
 	// This call also triggers the mempool to recheck its remaining transactions.
-	err = mp.Update(0, []types.Tx{txs[0]}, abciResponses(1, abci.CodeTypeOK), nil, nil)
+	err = mp.Update(0, []types.Tx{txs[0]}, abciResponses(1, abci.CodeTypeOK), nil, nil, nil)
 	require.Nil(t, err)
 
 	// The mempool has now sent its requests off to the client to be rechecked
@@ -300,6 +304,27 @@ func TestMempoolUpdateDoesNotPanicWhenApplicationMissedTx(t *testing.T) {
 	mockClient.AssertExpectations(t)
 }
 
+func TestMempoolWAL(t *testing.T) {
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	conf := config.ResetTestRoot("mempool_test")
+	conf.Mempool.WalPath = "data/mempool.wal"
+	mp, cleanup := newMempoolWithAppAndConfig(cc, conf)
+	defer cleanup()
+	require.NoError(t, mp.InitWAL())
+
+	txs := checkTxs(t, mp, 10, mempool.UnknownPeerID)
+	require.NoError(t, mp.Update(1, txs[:3], abciResponses(3, abci.CodeTypeOK), nil, nil, nil))
+	require.NoError(t, mp.RemoveTxByKey(txs[3].Key()))
+	mp.CloseWAL()
+
+	// the txs left are replayed by the restarted mempool
+	mp, _ = newMempoolWithAppAndConfig(cc, conf)
+	require.NoError(t, mp.InitWAL())
+	defer mp.CloseWAL()
+	require.Equal(t, txs[4:], mp.ReapMaxTxs(-1))
+}
+
 func TestMempool_KeepInvalidTxsInCache(t *testing.T) {
 	app := kvstore.NewApplication()
 	cc := proxy.NewLocalClientCreator(app)
@@ -323,7 +348,7 @@ func TestMempool_KeepInvalidTxsInCache(t *testing.T) {
 		_ = app.DeliverTx(abci.RequestDeliverTx{Tx: a})
 		_ = app.DeliverTx(abci.RequestDeliverTx{Tx: b})
 		err = mp.Update(1, []types.Tx{a, b},
-			[]*abci.ResponseDeliverTx{{Code: abci.CodeTypeOK}, {Code: 2}}, nil, nil)
+			[]*abci.ResponseDeliverTx{{Code: abci.CodeTypeOK}, {Code: 2}}, nil, nil, nil)
 		require.NoError(t, err)
 
 		// a must be added to the cache
@@ -373,7 +398,7 @@ func TestTxsAvailable(t *testing.T) {
 	// it should fire once now for the new height
 	// since there are still txs left
 	committedTxs, txs := txs[:50], txs[50:]
-	if err := mp.Update(1, committedTxs, abciResponses(len(committedTxs), abci.CodeTypeOK), nil, nil); err != nil {
+	if err := mp.Update(1, committedTxs, abciResponses(len(committedTxs), abci.CodeTypeOK), nil, nil, nil); err != nil {
 		t.Error(err)
 	}
 	ensureFire(t, mp.TxsAvailable(), timeoutMS)
@@ -385,7 +410,7 @@ func TestTxsAvailable(t *testing.T) {
 
 	// now call update with all the txs. it should not fire as there are no txs left
 	committedTxs = append(txs, moreTxs...)
-	if err := mp.Update(2, committedTxs, abciResponses(len(committedTxs), abci.CodeTypeOK), nil, nil); err != nil {
+	if err := mp.Update(2, committedTxs, abciResponses(len(committedTxs), abci.CodeTypeOK), nil, nil, nil); err != nil {
 		t.Error(err)
 	}
 	ensureNoFire(t, mp.TxsAvailable(), timeoutMS)
@@ -443,7 +468,7 @@ func TestSerialReap(t *testing.T) {
 			binary.BigEndian.PutUint64(txBytes, uint64(i))
 			txs = append(txs, txBytes)
 		}
-		if err := mp.Update(0, txs, abciResponses(len(txs), abci.CodeTypeOK), nil, nil); err != nil {
+		if err := mp.Update(0, txs, abciResponses(len(txs), abci.CodeTypeOK), nil, nil, nil); err != nil {
 			t.Error(err)
 		}
 	}
@@ -571,7 +596,7 @@ func TestMempoolTxsBytes(t *testing.T) {
 	assert.EqualValues(t, 1, mp.SizeBytes())
 
 	// 3. zero again after tx is removed by Update
-	err = mp.Update(1, []types.Tx{[]byte{0x01}}, abciResponses(1, abci.CodeTypeOK), nil, nil)
+	err = mp.Update(1, []types.Tx{[]byte{0x01}}, abciResponses(1, abci.CodeTypeOK), nil, nil, nil)
 	require.NoError(t, err)
 	assert.EqualValues(t, 0, mp.SizeBytes())
 
@@ -629,7 +654,7 @@ func TestMempoolTxsBytes(t *testing.T) {
 	require.NotEmpty(t, res2.Data)
 
 	// Pretend like we committed nothing so txBytes gets rechecked and removed.
-	err = mp.Update(1, []types.Tx{}, abciResponses(0, abci.CodeTypeOK), nil, nil)
+	err = mp.Update(1, []types.Tx{}, abciResponses(0, abci.CodeTypeOK), nil, nil, nil)
 	require.NoError(t, err)
 	assert.EqualValues(t, 8, mp.SizeBytes())
 
@@ -752,3 +777,413 @@ func abciResponses(n int, code uint32) []*abci.ResponseDeliverTx {
 	}
 	return responses
 }
+
+// nonceApp accepts txs of the form "sender/nonce[/data]", and rejects the txs
+// with the data "stale" on recheck.
+type nonceApp struct {
+	abci.BaseApplication
+}
+
+func (app *nonceApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	parts := strings.Split(string(req.Tx), "/")
+	nonce, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return abci.ResponseCheckTx{Code: 1}
+	}
+	if req.Type == abci.CheckTxType_Recheck && len(parts) > 2 && parts[2] == "stale" {
+		return abci.ResponseCheckTx{Code: 1}
+	}
+	return abci.ResponseCheckTx{Code: abci.CodeTypeOK, Sender: parts[0], Nonce: nonce}
+}
+
+func TestMempoolSenderNonceOrdering(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(&nonceApp{})
+	conf := config.ResetTestRoot("mempool_test")
+	conf.Mempool.SenderNonceOrdering = true
+	conf.Mempool.Recheck = false
+	mp, cleanup := newMempoolWithAppAndConfig(cc, conf)
+	defer cleanup()
+
+	checkTx := func(tx string) *abci.ResponseCheckTx {
+		var res *abci.ResponseCheckTx
+		err := mp.CheckTx(types.Tx(tx), func(r *abci.Response) {
+			res = r.GetCheckTx()
+		}, mempool.TxInfo{})
+		require.NoError(t, err)
+		return res
+	}
+	toTxs := func(txs ...string) types.Txs {
+		res := make(types.Txs, len(txs))
+		for i, tx := range txs {
+			res[i] = types.Tx(tx)
+		}
+		return res
+	}
+
+	// future nonces are held until the gap is filled
+	for _, tx := range []string{"a/0", "a/2", "b/5", "a/3"} {
+		checkTx(tx)
+	}
+	require.Equal(t, toTxs("a/0", "b/5"), mp.ReapMaxTxs(-1))
+	heldTxs, _ := mp.heldSize()
+	require.Equal(t, 2, heldTxs)
+
+	checkTx("a/1")
+	require.Equal(t, toTxs("a/0", "b/5", "a/1", "a/2", "a/3"), mp.ReapMaxTxs(-1))
+	heldTxs, _ = mp.heldSize()
+	require.Equal(t, 0, heldTxs)
+
+	// a nonce can't be taken twice
+	res := checkTx("a/2/dup")
+	require.Equal(t, mempool.ErrNonceTaken{Sender: "a", Nonce: 2}.Error(), res.MempoolError)
+	require.Equal(t, 5, mp.Size())
+
+	// a tx arriving after the following one is moved before it
+	checkTx("c/7")
+	checkTx("b/4")
+	require.Equal(t, toTxs("a/0", "a/1", "a/2", "a/3", "c/7", "b/4", "b/5"), mp.ReapMaxTxs(-1))
+	require.Equal(t, toTxs("b/4", "b/5"), senderListTxs(mp, "b"))
+
+	// once the txs of a sender are committed, its held txs restart from the
+	// lowest nonce, as the missing ones may have been committed elsewhere
+	checkTx("c/9")
+	mp.Lock()
+	require.NoError(t, mp.Update(1, toTxs("c/7", "c/8"), abciResponses(2, abci.CodeTypeOK), nil, nil, nil))
+	mp.Unlock()
+	require.Equal(t, toTxs("a/0", "a/1", "a/2", "a/3", "b/4", "b/5", "c/9"), mp.ReapMaxTxs(-1))
+
+	// a committed held tx moves the nonces of its sender past it
+	checkTx("d/0")
+	checkTx("d/2")
+	checkTx("d/3")
+	mp.Lock()
+	require.NoError(t, mp.Update(2, toTxs("d/0", "d/1", "d/2"), abciResponses(3, abci.CodeTypeOK), nil, nil, nil))
+	mp.Unlock()
+	require.Equal(t, toTxs("a/0", "a/1", "a/2", "a/3", "b/4", "b/5", "c/9", "d/3"), mp.ReapMaxTxs(-1))
+	heldTxs, _ = mp.heldSize()
+	require.Equal(t, 0, heldTxs)
+}
+
+func TestMempoolSenderNonceRejectedEvent(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(&nonceApp{})
+	conf := config.ResetTestRoot("mempool_test")
+	conf.Mempool.SenderNonceOrdering = true
+	mp, cleanup := newMempoolWithAppAndConfig(cc, conf)
+	defer cleanup()
+
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	mp.eventBus = eventBus
+	sub, err := eventBus.Subscribe(context.Background(), "test", types.EventQueryMempoolTx, 10)
+	require.NoError(t, err)
+
+	require.NoError(t, mp.CheckTx(types.Tx("a/0"), nil, mempool.TxInfo{}))
+	require.NoError(t, mp.CheckTx(types.Tx("a/0/dup"), nil, mempool.TxInfo{}))
+
+	// the tx taking a nonce already taken is evicted
+	for _, expected := range []types.EventDataMempoolTx{
+		{Hash: types.Tx("a/0").Hash(), Status: types.MempoolTxAdded},
+		{
+			Hash:   types.Tx("a/0/dup").Hash(),
+			Status: types.MempoolTxEvicted,
+			Reason: mempool.ErrNonceTaken{Sender: "a", Nonce: 0}.Error(),
+		},
+	} {
+		select {
+		case msg := <-sub.Out():
+			require.Equal(t, expected, msg.Data())
+		case <-time.After(time.Second):
+			t.Fatalf("did not receive a %s event", expected.Status)
+		}
+	}
+	require.Equal(t, mempool.TxStatusUnknown, mp.TxStatus(types.Tx("a/0/dup").Key()))
+}
+
+func TestMempoolSenderNonceGap(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(&nonceApp{})
+	conf := config.ResetTestRoot("mempool_test")
+	conf.Mempool.SenderNonceOrdering = true
+	mp, cleanup := newMempoolWithAppAndConfig(cc, conf)
+	defer cleanup()
+
+	for _, tx := range []string{"a/0", "a/1/stale", "a/2", "a/3/stale", "b/0"} {
+		require.NoError(t, mp.CheckTx(types.Tx(tx), nil, mempool.TxInfo{}))
+	}
+
+	// the txs following a tx invalidated by the recheck are held again, unless
+	// invalidated as well
+	mp.Lock()
+	require.NoError(t, mp.Update(1, nil, nil, nil, nil, nil))
+	mp.Unlock()
+	require.Equal(t, types.Txs{types.Tx("a/0"), types.Tx("b/0")}, mp.ReapMaxTxs(-1))
+	heldTxs, _ := mp.heldSize()
+	require.Equal(t, 1, heldTxs)
+	require.Equal(t, mempool.TxStatusHeld, mp.TxStatus(types.Tx("a/2").Key()))
+	require.Equal(t, types.Txs{types.Tx("a/0")}, senderListTxs(mp, "a"))
+
+	require.NoError(t, mp.CheckTx(types.Tx("a/1"), nil, mempool.TxInfo{}))
+	require.Equal(t, types.Txs{types.Tx("a/0"), types.Tx("b/0"), types.Tx("a/1"), types.Tx("a/2")}, mp.ReapMaxTxs(-1))
+	require.Equal(t, types.Txs{types.Tx("a/0"), types.Tx("a/1"), types.Tx("a/2")}, senderListTxs(mp, "a"))
+	heldTxs, _ = mp.heldSize()
+	require.Equal(t, 0, heldTxs)
+}
+
+// senderListTxs returns the txs of a sender in the list, as tracked by the
+// mempool.
+func senderListTxs(mp *CListMempool, sender string) types.Txs {
+	mp.sendersMtx.Lock()
+	defer mp.sendersMtx.Unlock()
+
+	var txs types.Txs
+	if st, ok := mp.senders[sender]; ok {
+		for _, e := range st.elems {
+			txs = append(txs, e.Value.(*mempoolTx).tx)
+		}
+	}
+	return txs
+}
+
+func TestMempoolHeldTxsLimits(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(&nonceApp{})
+	conf := config.ResetTestRoot("mempool_test")
+	conf.Mempool.SenderNonceOrdering = true
+	conf.Mempool.Recheck = false
+	conf.Mempool.Size = 3
+	conf.Mempool.MaxHeldTxs = 3
+	conf.Mempool.SenderMaxHeldTxs = 2
+	conf.Mempool.HeldTxTTLNumBlocks = 2
+	mp, cleanup := newMempoolWithAppAndConfig(cc, conf)
+	defer cleanup()
+
+	checkTx := func(tx string) string {
+		var res *abci.ResponseCheckTx
+		err := mp.CheckTx(types.Tx(tx), func(r *abci.Response) {
+			res = r.GetCheckTx()
+		}, mempool.TxInfo{})
+		require.NoError(t, err)
+		return res.MempoolError
+	}
+
+	require.Empty(t, checkTx("a/0"))
+	require.Empty(t, checkTx("a/2"))
+	require.Empty(t, checkTx("a/3"))
+	require.Equal(t, mempool.ErrTooManyHeldTxs{Sender: "a", NumTxs: 2, MaxTxs: 2}.Error(), checkTx("a/4"))
+	require.Empty(t, checkTx("b/0"))
+	require.Empty(t, checkTx("b/2"))
+	require.Equal(t, mempool.ErrTooManyHeldTxs{NumTxs: 3, MaxTxs: 3}.Error(), checkTx("b/3"))
+
+	// the held txs don't count against the size of the mempool
+	require.Empty(t, checkTx("c/0"))
+	require.Equal(t, 3, mp.Size())
+
+	// the held txs are dropped after the TTL
+	mp.Lock()
+	require.NoError(t, mp.Update(1, nil, nil, nil, nil, nil))
+	mp.Unlock()
+	heldTxs, _ := mp.heldSize()
+	require.Equal(t, 3, heldTxs)
+	mp.Lock()
+	require.NoError(t, mp.Update(2, nil, nil, nil, nil, nil))
+	mp.Unlock()
+	heldTxs, _ = mp.heldSize()
+	require.Equal(t, 0, heldTxs)
+	require.Equal(t, mempool.TxStatusUnknown, mp.TxStatus(types.Tx("a/2").Key()))
+}
+
+func TestMempoolSnapshotTxs(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(&nonceApp{})
+	conf := config.ResetTestRoot("mempool_test")
+	conf.Mempool.SenderNonceOrdering = true
+	mp, cleanup := newMempoolWithAppAndConfig(cc, conf)
+	defer cleanup()
+
+	for _, tx := range []string{"a/1", "b/0", "a/3", "a/0", "b/2"} {
+		require.NoError(t, mp.CheckTx(types.Tx(tx), nil, mempool.TxInfo{}))
+	}
+
+	// the txs are in reaping order, followed by the held ones
+	snapshot := mp.SnapshotTxs()
+	txs := make([]string, len(snapshot))
+	for i, stx := range snapshot {
+		txs[i] = string(stx.Tx)
+		require.Equal(t, int64(0), stx.Height)
+		require.Equal(t, strings.Split(txs[i], "/")[0], stx.Sender)
+	}
+	require.Equal(t, []string{"b/0", "a/0", "a/1", "a/3", "b/2"}, txs)
+}
+
+func TestMempoolQuotas(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(&nonceApp{})
+	conf := config.ResetTestRoot("mempool_test")
+	conf.Mempool.SenderNonceOrdering = true
+	conf.Mempool.Recheck = false
+	conf.Mempool.PeerMaxTxs = 2
+	conf.Mempool.SenderMaxTxs = 3
+	mp, cleanup := newMempoolWithAppAndConfig(cc, conf)
+	defer cleanup()
+
+	checkTxFrom := func(tx string, peerID uint16, peerP2PID p2p.ID) *abci.ResponseCheckTx {
+		var res *abci.ResponseCheckTx
+		err := mp.CheckTx(types.Tx(tx), func(r *abci.Response) {
+			res = r.GetCheckTx()
+		}, mempool.TxInfo{SenderID: peerID, SenderP2PID: peerP2PID})
+		require.NoError(t, err)
+		return res
+	}
+	checkTx := func(tx string, peerID uint16) *abci.ResponseCheckTx {
+		if peerID == mempool.UnknownPeerID {
+			return checkTxFrom(tx, peerID, "")
+		}
+		return checkTxFrom(tx, peerID, p2p.ID(fmt.Sprintf("peer%d", peerID)))
+	}
+
+	// the held txs count against the quota of their sender
+	require.Empty(t, checkTx("a/0", 0).MempoolError)
+	require.Empty(t, checkTx("a/2", 0).MempoolError)
+	require.Empty(t, checkTx("a/3", 0).MempoolError)
+	res := checkTx("a/4", 0)
+	require.Equal(t, mempool.ErrSenderQuotaExceeded{Sender: "a", NumTxs: 3, MaxTxs: 3}.Error(), res.MempoolError)
+
+	// the txs received from the RPC don't count against the peer quotas
+	require.Empty(t, checkTx("b/0", 1).MempoolError)
+	require.Empty(t, checkTx("c/0", 1).MempoolError)
+	res = checkTx("d/0", 1)
+	require.Equal(t, mempool.ErrPeerQuotaExceeded{Peer: "peer1", NumTxs: 2, MaxTxs: 2}.Error(), res.MempoolError)
+	require.Empty(t, checkTx("d/0", 2).MempoolError)
+	require.Equal(t, 4, mp.Size())
+
+	// the txs of a peer are counted by node ID, whatever the mempool ID of the
+	// peer, which is reused once the peer is removed
+	res = checkTxFrom("f/0", 3, "peer1")
+	require.Equal(t, mempool.ErrPeerQuotaExceeded{Peer: "peer1", NumTxs: 2, MaxTxs: 2}.Error(), res.MempoolError)
+	require.Empty(t, checkTxFrom("f/0", 1, "peer3").MempoolError)
+	require.Equal(t, 5, mp.Size())
+
+	// the quotas are released once the txs leave the mempool
+	mp.Lock()
+	require.NoError(t, mp.Update(1, types.Txs{types.Tx("a/0"), types.Tx("b/0")}, abciResponses(2, abci.CodeTypeOK), nil, nil, nil))
+	mp.Unlock()
+	require.Empty(t, checkTx("a/4", 0).MempoolError)
+	require.Empty(t, checkTx("e/0", 1).MempoolError)
+	res = checkTx("a/5", 0)
+	require.Equal(t, mempool.ErrSenderQuotaExceeded{Sender: "a", NumTxs: 3, MaxTxs: 3}.Error(), res.MempoolError)
+}
+
+func TestMempoolTxEvents(t *testing.T) {
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	mp.eventBus = eventBus
+
+	sub, err := eventBus.Subscribe(context.Background(), "test", types.EventQueryMempoolTx, 10)
+	require.NoError(t, err)
+	ensureTxEvent := func(tx types.Tx, status string) {
+		t.Helper()
+		select {
+		case msg := <-sub.Out():
+			edt := msg.Data().(types.EventDataMempoolTx)
+			require.Equal(t, tx.Hash(), []byte(edt.Hash))
+			require.Equal(t, status, edt.Status)
+		case <-time.After(time.Second):
+			t.Fatalf("did not receive a %s event", status)
+		}
+	}
+
+	tx := types.Tx("foo=bar")
+	require.Equal(t, mempool.TxStatusUnknown, mp.TxStatus(tx.Key()))
+
+	require.NoError(t, mp.CheckTx(tx, nil, mempool.TxInfo{}))
+	ensureTxEvent(tx, types.MempoolTxAdded)
+	require.Equal(t, mempool.TxStatusPending, mp.TxStatus(tx.Key()))
+
+	txs := mp.ReapMaxTxsMaxBytesMaxGas(-1, -1, -1)
+	require.Equal(t, types.Txs{tx}, txs)
+	ensureTxEvent(tx, types.MempoolTxReaped)
+
+	require.NoError(t, mp.Update(1, txs, abciResponses(1, abci.CodeTypeOK), nil, nil, nil))
+	ensureTxEvent(tx, types.MempoolTxCommitted)
+	require.Equal(t, mempool.TxStatusInCache, mp.TxStatus(tx.Key()))
+}
+
+// recheckApp accepts txs of the form "sender/data", or without a sender, and
+// rejects the txs of sender "b" and without a sender on recheck.
+type recheckApp struct {
+	abci.BaseApplication
+
+	mtx       sync.Mutex
+	rechecked []string
+}
+
+func (app *recheckApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	var sender string
+	if parts := strings.Split(string(req.Tx), "/"); len(parts) > 1 {
+		sender = parts[0]
+	}
+	if req.Type == abci.CheckTxType_Recheck {
+		app.mtx.Lock()
+		app.rechecked = append(app.rechecked, string(req.Tx))
+		app.mtx.Unlock()
+		if sender == "b" || sender == "" {
+			return abci.ResponseCheckTx{Code: 1}
+		}
+	}
+	return abci.ResponseCheckTx{Code: abci.CodeTypeOK, Sender: sender}
+}
+
+func (app *recheckApp) takeRechecked() []string {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	rechecked := app.rechecked
+	app.rechecked = nil
+	return rechecked
+}
+
+func TestMempoolRecheckFilter(t *testing.T) {
+	app := &recheckApp{}
+	cc := proxy.NewLocalClientCreator(app)
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+	mp.EnableTxsAvailable()
+
+	for _, tx := range []string{"a/0", "b/0", "c/0", "x"} {
+		require.NoError(t, mp.CheckTx(types.Tx(tx), nil, mempool.TxInfo{}))
+	}
+	ensureFire(t, mp.TxsAvailable(), 1000)
+
+	// only the txs of the senders in the filter, and without a sender, are
+	// rechecked
+	mp.Lock()
+	require.NoError(t, mp.Update(1, nil, nil, &abci.RecheckFilter{Senders: []string{"b"}}, nil, nil))
+	mp.Unlock()
+	require.Equal(t, []string{"b/0", "x"}, app.takeRechecked())
+	require.Equal(t, 2, mp.Size())
+	ensureFire(t, mp.TxsAvailable(), 1000)
+
+	// the txs are available right away if none is selected
+	mp.Lock()
+	require.NoError(t, mp.Update(2, nil, nil, &abci.RecheckFilter{}, nil, nil))
+	mp.Unlock()
+	require.Empty(t, app.takeRechecked())
+	ensureFire(t, mp.TxsAvailable(), 1000)
+
+	// all the txs are rechecked without a filter
+	mp.Lock()
+	require.NoError(t, mp.Update(3, nil, nil, nil, nil, nil))
+	mp.Unlock()
+	require.Equal(t, []string{"a/0", "c/0"}, app.takeRechecked())
+	require.Equal(t, 2, mp.Size())
+}
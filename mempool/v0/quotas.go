@@ -0,0 +1,111 @@
+package v0
+
+import (
+	"math"
+	"time"
+
+	"github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+// Reasons of the txs dropped or rejected by the quotas, as labeled in the
+// QuotaRejectedTxs metric.
+const (
+	quotaPeerBytesRate = "peer_bytes_rate"
+	quotaPeerTxs       = "peer_txs"
+	quotaSenderTxs     = "sender_txs"
+)
+
+// checkQuotas returns an error if the peer the tx was first received from, or
+// the sender of the tx, has as many txs in the mempool as its quota allows.
+// The txs received from the RPC are not subject to the peer quota. The txs of
+// a peer are counted by node ID, so that they still count once it reconnects.
+func (mem *CListMempool) checkQuotas(memTx *mempoolTx) error {
+	mem.quotasMtx.Lock()
+	defer mem.quotasMtx.Unlock()
+
+	if maxTxs := mem.config.PeerMaxTxs; maxTxs > 0 && memTx.peer != "" {
+		if numTxs := mem.peerNumTxs[memTx.peer]; numTxs >= maxTxs {
+			mem.metrics.QuotaRejectedTxs.With("reason", quotaPeerTxs).Add(1)
+			return mempool.ErrPeerQuotaExceeded{Peer: memTx.peer, NumTxs: numTxs, MaxTxs: maxTxs}
+		}
+	}
+	if maxTxs := mem.config.SenderMaxTxs; maxTxs > 0 && memTx.sender != "" {
+		if numTxs := mem.senderNumTxs[memTx.sender]; numTxs >= maxTxs {
+			mem.metrics.QuotaRejectedTxs.With("reason", quotaSenderTxs).Add(1)
+			return mempool.ErrSenderQuotaExceeded{Sender: memTx.sender, NumTxs: numTxs, MaxTxs: maxTxs}
+		}
+	}
+	return nil
+}
+
+// acquireQuotas counts a tx added to the list, or held, against the quotas of
+// its peer and sender.
+func (mem *CListMempool) acquireQuotas(memTx *mempoolTx) {
+	mem.quotasMtx.Lock()
+	defer mem.quotasMtx.Unlock()
+
+	if memTx.peer != "" {
+		mem.peerNumTxs[memTx.peer]++
+	}
+	if memTx.sender != "" {
+		mem.senderNumTxs[memTx.sender]++
+	}
+}
+
+// releaseQuotas is called once a tx is removed from the list, or no longer
+// held.
+func (mem *CListMempool) releaseQuotas(memTx *mempoolTx) {
+	mem.quotasMtx.Lock()
+	defer mem.quotasMtx.Unlock()
+
+	if memTx.peer != "" {
+		if mem.peerNumTxs[memTx.peer]--; mem.peerNumTxs[memTx.peer] <= 0 {
+			delete(mem.peerNumTxs, memTx.peer)
+		}
+	}
+	if memTx.sender != "" {
+		if mem.senderNumTxs[memTx.sender]--; mem.senderNumTxs[memTx.sender] <= 0 {
+			delete(mem.senderNumTxs, memTx.sender)
+		}
+	}
+}
+
+// resetQuotas drops the number of txs of each peer and sender.
+func (mem *CListMempool) resetQuotas() {
+	mem.quotasMtx.Lock()
+	defer mem.quotasMtx.Unlock()
+
+	mem.peerNumTxs = make(map[p2p.ID]int)
+	mem.senderNumTxs = make(map[string]int)
+}
+
+// rateLimiter is a token bucket limiting the bytes of the txs received from a
+// peer per second, with bursts of up to a second of traffic.
+type rateLimiter struct {
+	rate   float64 // bytes per second
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(bytesPerSecond int64, now time.Time) *rateLimiter {
+	return &rateLimiter{
+		rate:   float64(bytesPerSecond),
+		tokens: float64(bytesPerSecond),
+		last:   now,
+	}
+}
+
+// allow consumes the tokens of a tx of the given size, and returns false if
+// the bucket is empty. A tx bigger than the tokens left is allowed, so that a
+// tx bigger than the burst isn't always dropped, and the next txs are dropped
+// until the bucket has been refilled.
+func (l *rateLimiter) allow(txSize int, now time.Time) bool {
+	l.tokens = math.Min(l.rate, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	if l.tokens <= 0 {
+		return false
+	}
+	l.tokens -= float64(txSize)
+	return true
+}
@@ -3,8 +3,13 @@ package v0
 import (
 	"errors"
 	"fmt"
+	"hash/fnv"
+	"strconv"
 	"time"
 
+	"github.com/cosmos/gogoproto/proto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/libs/clist"
 	"github.com/cometbft/cometbft/libs/log"
@@ -26,8 +31,48 @@ type Reactor struct {
 	mempool *CListMempool
 	ids     *mempoolIDs
 
-	recvCh    chan p2p.Envelope
-	checkTxCh chan mempool.CheckTxRequest
+	recvCh chan p2p.Envelope
+
+	// CheckTx requests are dispatched to the queues of the CheckTx workers by
+	// sender, so that the txs of a sender are checked in order.
+	checkTxQueues []chan checkTxTask
+	txSender      TxSenderFunc
+
+	// Number of txs of each peer waiting in the CheckTx queues, recorded in
+	// the per-peer metrics.
+	checkTxPeersMtx cmtsync.Mutex
+	checkTxPeers    map[p2p.ID]int
+	peerMetrics     *mempool.PeerMetrics
+
+	// Limiters of the bytes of txs received from each peer per second, if
+	// PeerMaxBytesPerSecond is set.
+	peerLimitersMtx cmtsync.Mutex
+	peerLimiters    map[p2p.ID]*rateLimiter
+}
+
+// checkTxTask is a CheckTx request waiting in the queue of a CheckTx worker.
+type checkTxTask struct {
+	mempool.CheckTxRequest
+	queuedAt time.Time
+}
+
+// TxSenderFunc returns the sender of a tx, or an empty string if unknown, so
+// that the txs of a sender are checked in order by the same CheckTx worker.
+type TxSenderFunc func(tx types.Tx) string
+
+// ReactorOption sets an optional parameter on the Reactor.
+type ReactorOption func(*Reactor)
+
+// WithTxSender sets the function returning the sender of a tx. Without it,
+// the txs received from a peer are checked in order by the same CheckTx
+// worker.
+func WithTxSender(f TxSenderFunc) ReactorOption {
+	return func(memR *Reactor) { memR.txSender = f }
+}
+
+// WithPeerMetrics sets the metrics of the CheckTx queue of each peer.
+func WithPeerMetrics(metrics *mempool.PeerMetrics) ReactorOption {
+	return func(memR *Reactor) { memR.peerMetrics = metrics }
 }
 
 type mempoolIDs struct {
@@ -94,13 +139,26 @@ func newMempoolIDs() *mempoolIDs {
 }
 
 // NewReactor returns a new Reactor with the given config and mempool.
-func NewReactor(config *cfg.MempoolConfig, cMempool *CListMempool) *Reactor {
+func NewReactor(config *cfg.MempoolConfig, cMempool *CListMempool, options ...ReactorOption) *Reactor {
+	workers := config.CheckTxWorkers
+	if workers < 1 {
+		workers = 1
+	}
 	memR := &Reactor{
-		config:    config,
-		mempool:   cMempool,
-		ids:       newMempoolIDs(),
-		recvCh:    make(chan p2p.Envelope, MempoolPacketChannelSize),
-		checkTxCh: make(chan mempool.CheckTxRequest, MempoolPacketChannelSize),
+		config:        config,
+		mempool:       cMempool,
+		ids:           newMempoolIDs(),
+		recvCh:        make(chan p2p.Envelope, MempoolPacketChannelSize),
+		checkTxQueues: make([]chan checkTxTask, workers),
+		peerLimiters:  make(map[p2p.ID]*rateLimiter),
+		checkTxPeers:  make(map[p2p.ID]int),
+		peerMetrics:   mempool.NopPeerMetrics(),
+	}
+	for i := range memR.checkTxQueues {
+		memR.checkTxQueues[i] = make(chan checkTxTask, MempoolPacketChannelSize/workers)
+	}
+	for _, option := range options {
+		option(memR)
 	}
 	memR.BaseReactor = *p2p.NewBaseReactor("Mempool", memR)
 	return memR
@@ -109,6 +167,14 @@ func NewReactor(config *cfg.MempoolConfig, cMempool *CListMempool) *Reactor {
 // InitPeer implements Reactor by creating a state for the peer.
 func (memR *Reactor) InitPeer(peer p2p.Peer) p2p.Peer {
 	memR.ids.ReserveForPeer(peer)
+	if memR.config.PeerMaxBytesPerSecond > 0 {
+		memR.peerLimitersMtx.Lock()
+		memR.peerLimiters[peer.ID()] = newRateLimiter(memR.config.PeerMaxBytesPerSecond, time.Now())
+		memR.peerLimitersMtx.Unlock()
+	}
+	memR.checkTxPeersMtx.Lock()
+	memR.checkTxPeers[peer.ID()] = 0
+	memR.checkTxPeersMtx.Unlock()
 	return peer
 }
 
@@ -124,7 +190,9 @@ func (memR *Reactor) OnStart() error {
 		memR.Logger.Info("Tx broadcasting is disabled")
 	}
 	go memR.receiveRoutine()
-	go memR.checkTxRoutine()
+	for i := range memR.checkTxQueues {
+		go memR.checkTxRoutine(i)
+	}
 	return nil
 }
 
@@ -144,6 +212,7 @@ func (memR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			Priority:            1,
 			RecvMessageCapacity: batchMsg.Size(),
 			MessageType:         &protomem.Message{},
+			Compress:            true,
 		},
 	}
 }
@@ -159,6 +228,13 @@ func (memR *Reactor) AddPeer(peer p2p.Peer) {
 // RemovePeer implements Reactor.
 func (memR *Reactor) RemovePeer(peer p2p.Peer, reason interface{}) {
 	memR.ids.Reclaim(peer)
+	memR.peerLimitersMtx.Lock()
+	delete(memR.peerLimiters, peer.ID())
+	memR.peerLimitersMtx.Unlock()
+	memR.checkTxPeersMtx.Lock()
+	delete(memR.checkTxPeers, peer.ID())
+	memR.peerMetrics.RemovePeer(string(peer.ID()))
+	memR.checkTxPeersMtx.Unlock()
 	// broadcast routine checks if peer is gone and returns
 }
 
@@ -172,8 +248,6 @@ func (memR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 }
 
 func (memR *Reactor) receiveRoutine() {
-	errChan := make(chan error, 1)
-	defer close(errChan)
 	for e := range memR.recvCh {
 		switch msg := e.Message.(type) {
 		case *protomem.Txs:
@@ -187,39 +261,156 @@ func (memR *Reactor) receiveRoutine() {
 				txInfo.SenderP2PID = e.Src.ID()
 			}
 
+			cb := memR.markPeerGoodTx(e.Src)
 			for _, tx := range protoTxs {
-				ntx := types.Tx(tx)
-
-				memR.checkTxCh <- mempool.CheckTxRequest{Tx: ntx, TxInfo: txInfo, Err: errChan}
-				err := <-errChan
-				if errors.Is(err, mempool.ErrTxInCache) {
-					memR.Logger.Debug("Tx already exists in cache", "tx", ntx.String())
-				} else if err != nil {
-					memR.Logger.Info("Could not check tx", "tx", ntx.String(), "err", err)
+				if !memR.allowTx(e.Src, len(tx)) {
+					memR.Logger.Debug("Dropped tx exceeding the rate limit of the peer", "src", e.Src, "tx", types.Tx(tx).Hash())
+					memR.mempool.metrics.QuotaRejectedTxs.With("reason", quotaPeerBytesRate).Add(1)
+					continue
 				}
+				memR.CheckTx(mempool.CheckTxRequest{Tx: types.Tx(tx), CB: cb, TxInfo: txInfo})
 			}
 
 		default:
 			memR.Logger.Error("unknown message type", "src", e.Src, "chId", e.ChannelID, "msg", e.Message)
-			memR.Switch.StopPeerForError(e.Src, fmt.Errorf("mempool cannot handle message of type: %T", e.Message))
+			err := fmt.Errorf("mempool cannot handle message of type: %T", e.Message)
+			memR.Switch.MarkPeerBadEvent(e.Src, err)
+			memR.Switch.StopPeerForError(e.Src, err)
 			continue
 		}
 	}
 }
 
-// checkTxRoutine checks the validity of a tx and sends the result to the given callback.
-func (memR *Reactor) checkTxRoutine() {
-	for checkTxReq := range memR.checkTxCh {
-		err := memR.mempool.CheckTx(checkTxReq.Tx, checkTxReq.CB, checkTxReq.TxInfo)
-		if checkTxReq.Err != nil {
-			checkTxReq.Err <- err
+// markPeerGoodTx returns a CheckTx callback recording a good event of the peer
+// if the tx it sent is added to the mempool.
+func (memR *Reactor) markPeerGoodTx(peer p2p.Peer) func(*abci.Response) {
+	if peer == nil {
+		return nil
+	}
+	return func(res *abci.Response) {
+		if r := res.GetCheckTx(); r != nil && r.Code == abci.CodeTypeOK && r.MempoolError == "" {
+			memR.Switch.MarkPeerGoodEvent(peer)
+		}
+	}
+}
+
+// allowTx returns false if a tx of the given size received from a peer exceeds
+// the bytes the peer may send per second.
+func (memR *Reactor) allowTx(peer p2p.Peer, txSize int) bool {
+	if peer == nil {
+		return true
+	}
+	memR.peerLimitersMtx.Lock()
+	defer memR.peerLimitersMtx.Unlock()
+
+	limiter, ok := memR.peerLimiters[peer.ID()]
+	if !ok {
+		return true
+	}
+	return limiter.allow(txSize, time.Now())
+}
+
+// checkTxRoutine is a CheckTx worker, which checks the validity of the txs in
+// its queue, and sends the results to the given callbacks. Errors are logged
+// if no error channel is given.
+func (memR *Reactor) checkTxRoutine(worker int) {
+	queue := memR.checkTxQueues[worker]
+	label := strconv.Itoa(worker)
+	for task := range queue {
+		memR.mempool.metrics.CheckTxQueueSize.With("worker", label).Set(float64(len(queue)))
+		memR.mempool.metrics.CheckTxWaitSeconds.With("worker", label).Observe(time.Since(task.queuedAt).Seconds())
+		memR.dequeuePeerTx(task)
+
+		err := memR.mempool.CheckTx(task.Tx, task.CB, task.TxInfo)
+		switch {
+		case task.Err != nil:
+			task.Err <- err
+		case errors.Is(err, mempool.ErrTxInCache):
+			memR.Logger.Debug("Tx already exists in cache", "tx", task.Tx.String())
+		case err != nil:
+			memR.Logger.Info("Could not check tx", "tx", task.Tx.String(), "err", err)
 		}
 	}
 }
 
-// CheckTx implements mempool.MempoolTxChecker by sending the tx to the checkTxRoutine.
+// CheckTx implements mempool.MempoolTxChecker by sending the tx to the queue
+// of the CheckTx worker of its sender.
+//
+// A tx received from a peer is dropped if the queue is full, so that a peer
+// flooding the mempool doesn't block the txs of the other peers. The RPC
+// waits for room in the queue instead.
 func (memR *Reactor) CheckTx(req mempool.CheckTxRequest) {
-	memR.checkTxCh <- req
+	worker := memR.checkTxWorker(req)
+	queue := memR.checkTxQueues[worker]
+	task := checkTxTask{CheckTxRequest: req, queuedAt: time.Now()}
+	if req.Err != nil {
+		queue <- task
+	} else {
+		select {
+		case queue <- task:
+		default:
+			memR.Logger.Debug("Dropped tx, the CheckTx queue is full", "src", req.TxInfo.SenderP2PID, "tx", req.Tx.Hash())
+			memR.mempool.metrics.CheckTxDroppedTxs.Add(1)
+			return
+		}
+	}
+	memR.mempool.metrics.CheckTxQueueSize.With("worker", strconv.Itoa(worker)).Set(float64(len(queue)))
+	memR.updatePeerQueueSize(req.TxInfo.SenderP2PID, 1)
+}
+
+// dequeuePeerTx records that a tx received from a peer is no longer waiting
+// to be checked.
+func (memR *Reactor) dequeuePeerTx(task checkTxTask) {
+	if memR.updatePeerQueueSize(task.TxInfo.SenderP2PID, -1) {
+		memR.peerMetrics.ObserveCheckTxWait(string(task.TxInfo.SenderP2PID), time.Since(task.queuedAt))
+	}
+}
+
+// updatePeerQueueSize adds delta to the number of txs of a peer waiting to be
+// checked. It returns false if the peer has been removed, so that the series of
+// a removed peer aren't recreated by its txs still in the queues.
+func (memR *Reactor) updatePeerQueueSize(peerID p2p.ID, delta int) bool {
+	if peerID == "" {
+		return false
+	}
+	memR.checkTxPeersMtx.Lock()
+	defer memR.checkTxPeersMtx.Unlock()
+
+	size, ok := memR.checkTxPeers[peerID]
+	if !ok {
+		return false
+	}
+	size += delta
+	memR.checkTxPeers[peerID] = size
+	memR.peerMetrics.SetCheckTxQueueSize(string(peerID), size)
+	return true
+}
+
+// checkTxWorker returns the index of the CheckTx worker of a tx.
+//
+// The txs of a sender go to the same worker. Without TxSenderFunc, the txs of
+// a peer go to the same worker, while the txs received from the RPC are spread
+// over all the workers: a call only returns once its tx is sent to the app, so
+// the txs sent one after the other by a client are checked in order anyway.
+func (memR *Reactor) checkTxWorker(req mempool.CheckTxRequest) int {
+	numWorkers := len(memR.checkTxQueues)
+	if numWorkers == 1 {
+		return 0
+	}
+	var sender string
+	if memR.txSender != nil {
+		sender = memR.txSender(req.Tx)
+	}
+	if sender == "" {
+		if req.TxInfo.SenderID != mempool.UnknownPeerID {
+			return int(req.TxInfo.SenderID) % numWorkers
+		}
+		key := req.Tx.Key()
+		sender = string(key[:])
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(sender))
+	return int(h.Sum32() % uint32(numWorkers))
 }
 
 // PeerState describes the state of a peer.
@@ -272,19 +463,19 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 			continue
 		}
 
-		// NOTE: Transaction batching was disabled due to
-		// https://github.com/tendermint/tendermint/issues/5796
-
-		if _, ok := memTx.senders.Load(peerID); !ok {
+		txs, last := memR.txsBatch(next, peerID, peerState.GetHeight())
+		if len(txs) > 0 {
 			success := peer.SendEnvelope(p2p.Envelope{
 				ChannelID: mempool.MempoolChannel,
-				Message:   &protomem.Txs{Txs: [][]byte{memTx.tx}},
+				Message:   &protomem.Txs{Txs: txs},
 			})
 			if !success {
 				time.Sleep(mempool.PeerCatchupSleepIntervalMS * time.Millisecond)
 				continue
 			}
 		}
+		// Only move past the txs once they are sent, so that a failed send is retried.
+		next = last
 
 		select {
 		case <-next.NextWaitChan():
@@ -298,6 +489,61 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 	}
 }
 
+// txsBatch returns the txs to send to the peer in a single message, starting
+// with the tx of next, and the element of the last tx considered.
+//
+// The txs received from the peer are skipped. The batch ends before a tx the
+// peer is not ready for yet (see the lag check in broadcastTxRoutine), or which
+// would make the message bigger than the max batch size, but the batch always
+// has a tx if any is left to send.
+func (memR *Reactor) txsBatch(next *clist.CElement, peerID uint16, peerHeight int64) ([][]byte, *clist.CElement) {
+	var (
+		txs      [][]byte
+		txsSize  int
+		maxBytes = memR.maxBatchBytes()
+		last     = next
+	)
+	for e := next; e != nil; e = e.Next() {
+		memTx := e.Value.(*mempoolTx)
+		if e != next && peerHeight < memTx.Height()-1 {
+			break
+		}
+		if _, ok := memTx.senders.Load(peerID); !ok {
+			txSize := txProtoSize(len(memTx.tx))
+			if len(txs) > 0 && txsMsgSize(txsSize+txSize) > maxBytes {
+				break
+			}
+			txs = append(txs, memTx.tx)
+			txsSize += txSize
+		}
+		last = e
+	}
+	return txs, last
+}
+
+// maxBatchBytes returns the max size of a message with a batch of txs, which
+// is at most the size of a message with a single tx of MaxTxBytes, so that the
+// message does not exceed the capacity of the channel of peers.
+func (memR *Reactor) maxBatchBytes() int {
+	maxBytes := txsMsgSize(txProtoSize(memR.config.MaxTxBytes))
+	if memR.config.MaxBatchBytes < maxBytes {
+		return memR.config.MaxBatchBytes
+	}
+	return maxBytes
+}
+
+// txProtoSize returns the size of a tx of the given length encoded in a Txs
+// message.
+func txProtoSize(txLen int) int {
+	return 1 + proto.SizeVarint(uint64(txLen)) + txLen
+}
+
+// txsMsgSize returns the size of a Txs message wrapped in a Message, given the
+// total encoded size of its txs.
+func txsMsgSize(txsSize int) int {
+	return 1 + proto.SizeVarint(uint64(txsSize)) + txsSize
+}
+
 // TxsMessage is a Message containing transactions.
 type TxsMessage struct {
 	Txs []types.Tx
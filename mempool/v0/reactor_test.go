@@ -3,7 +3,9 @@ package v0
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"net"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -103,7 +105,7 @@ func TestReactorConcurrency(t *testing.T) {
 			for i := range txs {
 				deliverTxResponses[i] = &abci.ResponseDeliverTx{Code: 0}
 			}
-			err := reactors[0].mempool.Update(1, txs, deliverTxResponses, nil, nil)
+			err := reactors[0].mempool.Update(1, txs, deliverTxResponses, nil, nil, nil)
 			assert.NoError(t, err)
 		}()
 
@@ -115,7 +117,7 @@ func TestReactorConcurrency(t *testing.T) {
 
 			reactors[1].mempool.Lock()
 			defer reactors[1].mempool.Unlock()
-			err := reactors[1].mempool.Update(1, []types.Tx{}, make([]*abci.ResponseDeliverTx, 0), nil, nil)
+			err := reactors[1].mempool.Update(1, []types.Tx{}, make([]*abci.ResponseDeliverTx, 0), nil, nil, nil)
 			assert.NoError(t, err)
 		}()
 
@@ -186,6 +188,206 @@ func TestReactor_MaxTxBytes(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestReactorCheckTxWorkers(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.CheckTxWorkers = 4
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+	memR := NewReactor(config.Mempool, mp)
+	memR.SetLogger(log.TestingLogger())
+	require.NoError(t, memR.Start())
+	defer func() { require.NoError(t, memR.Stop()) }()
+
+	// the txs of different senders are interleaved
+	const numSenders, txsPerSender = 5, 100
+	txsBySender := make(map[uint16]types.Txs)
+	for i := 0; i < txsPerSender; i++ {
+		for sender := uint16(1); sender <= numSenders; sender++ {
+			tx := []byte(fmt.Sprintf("sender-%d-tx-%d", sender, i))
+			txsBySender[sender] = append(txsBySender[sender], tx)
+			memR.CheckTx(mempool.CheckTxRequest{
+				Tx:     tx,
+				TxInfo: mempool.TxInfo{SenderID: sender, SenderP2PID: p2p.ID(fmt.Sprintf("peer%d", sender))},
+			})
+		}
+	}
+	require.Eventually(t, func() bool { return mp.Size() == numSenders*txsPerSender }, timeout, 10*time.Millisecond)
+
+	// the txs of each sender are checked in order
+	got := make(map[uint16]types.Txs)
+	for _, tx := range mp.ReapMaxTxs(-1) {
+		var sender uint16
+		_, err := fmt.Sscanf(string(tx), "sender-%d-", &sender)
+		require.NoError(t, err)
+		got[sender] = append(got[sender], tx)
+	}
+	require.Equal(t, txsBySender, got)
+}
+
+func TestReactorCheckTxWorker(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.CheckTxWorkers = 4
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+
+	// the txs of a peer go to the same worker, and the txs from the RPC are
+	// spread over the workers
+	memR := NewReactor(config.Mempool, mp)
+	workers := make(map[int]struct{})
+	for i := 0; i < 20; i++ {
+		tx := types.Tx(fmt.Sprintf("tx-%d", i))
+		require.Equal(t, 1, memR.checkTxWorker(mempool.CheckTxRequest{Tx: tx, TxInfo: mempool.TxInfo{SenderID: 5}}))
+		workers[memR.checkTxWorker(mempool.CheckTxRequest{Tx: tx})] = struct{}{}
+	}
+	require.Greater(t, len(workers), 1)
+
+	// the txs of a sender go to the same worker, no matter where they are from
+	memR = NewReactor(config.Mempool, mp, WithTxSender(func(tx types.Tx) string {
+		return strings.Split(string(tx), "/")[0]
+	}))
+	worker := memR.checkTxWorker(mempool.CheckTxRequest{Tx: types.Tx("a/0")})
+	for i := 1; i < 20; i++ {
+		tx := types.Tx(fmt.Sprintf("a/%d", i))
+		txInfo := mempool.TxInfo{SenderID: uint16(i)}
+		require.Equal(t, worker, memR.checkTxWorker(mempool.CheckTxRequest{Tx: tx, TxInfo: txInfo}))
+	}
+}
+
+func TestReactorCheckTxQueueFull(t *testing.T) {
+	defer func(size int) { MempoolPacketChannelSize = size }(MempoolPacketChannelSize)
+	MempoolPacketChannelSize = 2
+
+	config := cfg.TestConfig()
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+	memR := NewReactor(config.Mempool, mp)
+	memR.SetLogger(log.TestingLogger())
+
+	// the txs received from peers are dropped once the queue is full, instead
+	// of blocking
+	for i := 0; i < 3; i++ {
+		memR.CheckTx(mempool.CheckTxRequest{
+			Tx:     types.Tx(fmt.Sprintf("tx-%d", i)),
+			TxInfo: mempool.TxInfo{SenderID: 1, SenderP2PID: "peer1"},
+		})
+	}
+	require.Len(t, memR.checkTxQueues[0], 2)
+
+	require.NoError(t, memR.Start())
+	defer func() { require.NoError(t, memR.Stop()) }()
+	require.Eventually(t, func() bool { return mp.Size() == 2 }, timeout, 10*time.Millisecond)
+}
+
+func TestReactorCheckTxPeerQueueSize(t *testing.T) {
+	config := cfg.TestConfig()
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+	memR := NewReactor(config.Mempool, mp)
+	memR.SetLogger(log.TestingLogger())
+
+	peer := mock.NewPeer(nil)
+	memR.InitPeer(peer)
+	txInfo := mempool.TxInfo{SenderID: memR.ids.GetForPeer(peer), SenderP2PID: peer.ID()}
+	for i := 0; i < 2; i++ {
+		memR.CheckTx(mempool.CheckTxRequest{Tx: types.Tx(fmt.Sprintf("tx-%d", i)), TxInfo: txInfo})
+	}
+	memR.checkTxPeersMtx.Lock()
+	require.Equal(t, 2, memR.checkTxPeers[peer.ID()])
+	memR.checkTxPeersMtx.Unlock()
+
+	// the txs of a removed peer still in the queues don't add it back
+	memR.RemovePeer(peer, nil)
+	require.NoError(t, memR.Start())
+	defer func() { require.NoError(t, memR.Stop()) }()
+	require.Eventually(t, func() bool { return mp.Size() == 2 }, timeout, 10*time.Millisecond)
+	memR.checkTxPeersMtx.Lock()
+	require.NotContains(t, memR.checkTxPeers, peer.ID())
+	memR.checkTxPeersMtx.Unlock()
+}
+
+func TestReactor_TxsBatch(t *testing.T) {
+	config := cfg.TestConfig()
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+	memR := NewReactor(config.Mempool, mp)
+
+	// a batch never exceeds the capacity of the channel
+	config.Mempool.MaxBatchBytes = 10 * config.Mempool.MaxTxBytes
+	require.Equal(t, memR.GetChannels()[0].RecvMessageCapacity, memR.maxBatchBytes())
+
+	const peerID = 1
+	txs := checkTxs(t, mp, 10, mempool.UnknownPeerID)
+	txs = append(txs, checkTxs(t, mp, 2, peerID)...)
+	txs = append(txs, checkTxs(t, mp, 10, mempool.UnknownPeerID)...)
+	// the peer is not ready for the last tx
+	mp.txs.Back().Value.(*mempoolTx).height = 3
+
+	batch, last := memR.txsBatch(mp.TxsFront(), peerID, 1)
+	require.Equal(t, 19, len(batch))
+	require.Equal(t, txs[20], types.Tx(last.Value.(*mempoolTx).tx))
+	for i, tx := range append(txs[:10:10], txs[12:21]...) {
+		require.Equal(t, tx, types.Tx(batch[i]))
+	}
+
+	// the batch is limited to MaxBatchBytes
+	msgSize := func(txs [][]byte) int {
+		msg := memproto.Message{Sum: &memproto.Message_Txs{Txs: &memproto.Txs{Txs: txs}}}
+		return msg.Size()
+	}
+	config.Mempool.MaxBatchBytes = msgSize(batch[:5])
+	batch, last = memR.txsBatch(mp.TxsFront(), peerID, 1)
+	require.Equal(t, 5, len(batch))
+	require.Equal(t, txs[4], types.Tx(last.Value.(*mempoolTx).tx))
+
+	config.Mempool.MaxBatchBytes = msgSize(batch[:5]) - 1
+	batch, _ = memR.txsBatch(mp.TxsFront(), peerID, 1)
+	require.Equal(t, 4, len(batch))
+
+	// a single tx is sent if batching is disabled, skipping the txs of the peer
+	config.Mempool.MaxBatchBytes = 0
+	next := mp.TxsFront()
+	for i := 0; i < 10; i++ {
+		next = next.Next()
+	}
+	batch, last = memR.txsBatch(next, peerID, 1)
+	require.Equal(t, [][]byte{txs[12]}, batch)
+	require.Equal(t, txs[12], types.Tx(last.Value.(*mempoolTx).tx))
+}
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Now()
+	limiter := newRateLimiter(100, now)
+
+	// a burst of a second of traffic is allowed
+	assert.True(t, limiter.allow(60, now))
+	assert.True(t, limiter.allow(60, now))
+	assert.False(t, limiter.allow(1, now))
+
+	// the tokens are refilled at the rate
+	now = now.Add(100 * time.Millisecond)
+	assert.False(t, limiter.allow(1, now))
+	now = now.Add(200 * time.Millisecond)
+	assert.True(t, limiter.allow(1, now))
+
+	// a tx bigger than the burst is allowed once the bucket is full
+	now = now.Add(time.Hour)
+	assert.True(t, limiter.allow(250, now))
+	now = now.Add(time.Second)
+	assert.False(t, limiter.allow(1, now))
+	now = now.Add(time.Second)
+	assert.True(t, limiter.allow(1, now))
+}
+
 func TestBroadcastTxForPeerStopsWhenPeerStops(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping test in short mode.")
@@ -0,0 +1,293 @@
+package v0
+
+import (
+	"sync/atomic"
+
+	"github.com/cometbft/cometbft/libs/clist"
+	"github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/types"
+)
+
+// senderTxs tracks the txs of a sender, if txs are ordered by sender nonce.
+//
+// The txs in the list have the nonces [firstNonce, nextNonce), while the txs
+// with a higher nonce are held until the missing ones are received.
+type senderTxs struct {
+	firstNonce uint64
+	nextNonce  uint64
+	elems      []*clist.CElement     // elements of the txs of the sender in the list, in nonce order
+	held       map[uint64]*mempoolTx // nonce -> held tx
+}
+
+// addSenderTx adds a tx to the list if its nonce is the next one of its
+// sender, or right before the first one, and holds it if its nonce is ahead.
+// The held txs following the added one are added to the list as well.
+//
+// Returns true if the tx is held.
+func (mem *CListMempool) addSenderTx(memTx *mempoolTx) (bool, error) {
+	mem.sendersMtx.Lock()
+	defer mem.sendersMtx.Unlock()
+
+	st, ok := mem.senders[memTx.sender]
+	if !ok {
+		// The first tx of a sender sets the nonces expected next, as the app
+		// checked it against the sender's state.
+		st = &senderTxs{
+			firstNonce: memTx.nonce,
+			nextNonce:  memTx.nonce,
+			held:       make(map[uint64]*mempoolTx),
+		}
+		mem.senders[memTx.sender] = st
+	}
+
+	switch nonce := memTx.nonce; {
+	case nonce == st.nextNonce:
+		st.elems = append(st.elems, mem.addTx(memTx))
+		st.nextNonce++
+		mem.promoteHeldTxs(st)
+
+	case nonce > st.nextNonce:
+		if _, ok := st.held[nonce]; ok {
+			return false, mempool.ErrNonceTaken{Sender: memTx.sender, Nonce: nonce}
+		}
+		if maxTxs := mem.config.SenderMaxHeldTxs; maxTxs > 0 && len(st.held) >= maxTxs {
+			return false, mempool.ErrTooManyHeldTxs{Sender: memTx.sender, NumTxs: len(st.held), MaxTxs: maxTxs}
+		}
+		if maxTxs := mem.config.MaxHeldTxs; maxTxs > 0 && len(mem.heldTxs) >= maxTxs {
+			return false, mempool.ErrTooManyHeldTxs{NumTxs: len(mem.heldTxs), MaxTxs: maxTxs}
+		}
+		mem.holdTx(st, memTx)
+		mem.acquireQuotas(memTx)
+		return true, nil
+
+	case len(st.elems) > 0 && st.firstNonce > 0 && nonce == st.firstNonce-1:
+		// The tx arrived after the following ones, which are moved behind it
+		// so that the list keeps the txs of the sender in nonce order.
+		elems := make([]*clist.CElement, 0, len(st.elems)+1)
+		elems = append(elems, mem.addTx(memTx))
+		for _, e := range st.elems {
+			elems = append(elems, mem.moveToBack(e))
+		}
+		st.elems = elems
+		st.firstNonce--
+
+	default:
+		return false, mempool.ErrNonceTaken{Sender: memTx.sender, Nonce: nonce}
+	}
+	return false, nil
+}
+
+// promoteHeldTxs adds the held txs following the last tx of a sender to the
+// list.
+// CONTRACT: sendersMtx must be held.
+func (mem *CListMempool) promoteHeldTxs(st *senderTxs) {
+	for {
+		memTx, ok := st.held[st.nextNonce]
+		if !ok {
+			return
+		}
+		mem.unholdTx(st, memTx)
+		st.elems = append(st.elems, mem.addTx(memTx))
+		st.nextNonce++
+	}
+}
+
+// holdTx adds a tx to the held txs.
+// CONTRACT: sendersMtx must be held.
+func (mem *CListMempool) holdTx(st *senderTxs, memTx *mempoolTx) {
+	st.held[memTx.nonce] = memTx
+	mem.heldTxs[memTx.tx.Key()] = memTx
+	mem.heldTxsBytes += int64(len(memTx.tx))
+	mem.metrics.HeldTxs.Set(float64(len(mem.heldTxs)))
+}
+
+// unholdTx removes a tx from the held txs.
+// CONTRACT: sendersMtx must be held.
+func (mem *CListMempool) unholdTx(st *senderTxs, memTx *mempoolTx) {
+	delete(st.held, memTx.nonce)
+	delete(mem.heldTxs, memTx.tx.Key())
+	mem.heldTxsBytes -= int64(len(memTx.tx))
+	mem.metrics.HeldTxs.Set(float64(len(mem.heldTxs)))
+	mem.releaseQuotas(memTx)
+}
+
+// moveToBack moves a tx to the back of the list, and returns its new element.
+// The peers it was already sent to dedup it when it's gossiped again.
+func (mem *CListMempool) moveToBack(e *clist.CElement) *clist.CElement {
+	memTx := e.Value.(*mempoolTx)
+	mem.txs.Remove(e)
+	e.DetachPrev()
+	back := mem.txs.PushBack(memTx)
+	mem.txsMap.Store(memTx.tx.Key(), back)
+	return back
+}
+
+// removeSenderTx is called once a tx of a sender is removed from the list.
+//
+// If a tx in the middle of the txs of its sender is removed without being
+// committed, the txs with a higher nonce are held again until the missing
+// nonce is received, since they can't be executed before.
+func (mem *CListMempool) removeSenderTx(memTx *mempoolTx, committed bool) {
+	if memTx.sender == "" {
+		return
+	}
+
+	mem.sendersMtx.Lock()
+	defer mem.sendersMtx.Unlock()
+
+	st, ok := mem.senders[memTx.sender]
+	if !ok {
+		return
+	}
+	i := 0
+	for i < len(st.elems) && st.elems[i].Value.(*mempoolTx) != memTx {
+		i++
+	}
+	if i == len(st.elems) {
+		return
+	}
+	switch {
+	case memTx.nonce == st.firstNonce:
+		st.elems[0] = nil
+		st.elems = st.elems[1:]
+		st.firstNonce++
+	case !committed && memTx.nonce > st.firstNonce && memTx.nonce < st.nextNonce:
+		for _, e := range st.elems[i+1:] {
+			mem.rehold(st, e)
+		}
+		st.elems = st.elems[:i]
+		st.nextNonce = memTx.nonce
+	default:
+		st.elems = append(st.elems[:i], st.elems[i+1:]...)
+	}
+	if len(st.elems) == 0 {
+		if len(st.held) == 0 {
+			// The next tx of the sender sets the nonces expected next.
+			delete(mem.senders, memTx.sender)
+		} else {
+			st.firstNonce = st.nextNonce
+		}
+	}
+}
+
+// rehold removes a tx from the list, and holds it again. Its quotas are kept.
+// The caller removes its element from the ones of the sender.
+// CONTRACT: sendersMtx must be held.
+func (mem *CListMempool) rehold(st *senderTxs, e *clist.CElement) {
+	memTx := e.Value.(*mempoolTx)
+	mem.txs.Remove(e)
+	e.DetachPrev()
+	mem.txsMap.Delete(memTx.tx.Key())
+	atomic.AddInt64(&mem.txsBytes, int64(-len(memTx.tx)))
+	mem.removeTxFromWAL(memTx.tx)
+	mem.holdTx(st, memTx)
+}
+
+// removeRecheckedTx removes a tx invalidated by the ongoing recheck, once it
+// was held again, and possibly added back to the list since.
+func (mem *CListMempool) removeRecheckedTx(tx types.Tx) {
+	if e, ok := mem.txsMap.Load(tx.Key()); ok {
+		mem.removeTx(tx, e.(*clist.CElement), !mem.config.KeepInvalidTxsInCache, false)
+		return
+	}
+	if mem.removeHeldTx(tx.Key(), false) && !mem.config.KeepInvalidTxsInCache {
+		mem.cache.Remove(tx)
+	}
+}
+
+// removeHeldTx removes a held tx, and returns false if there is none with the
+// given key. If the tx was committed, the held txs of its sender with a lower
+// nonce are removed as well, and the nonces of the sender move past it.
+func (mem *CListMempool) removeHeldTx(txKey types.TxKey, committed bool) bool {
+	mem.sendersMtx.Lock()
+	defer mem.sendersMtx.Unlock()
+
+	memTx, ok := mem.heldTxs[txKey]
+	if !ok {
+		return false
+	}
+	st := mem.senders[memTx.sender]
+	mem.unholdTx(st, memTx)
+
+	if committed {
+		for nonce, heldTx := range st.held {
+			if nonce < memTx.nonce {
+				mem.unholdTx(st, heldTx)
+				if !mem.config.KeepInvalidTxsInCache {
+					mem.cache.Remove(heldTx.tx)
+				}
+			}
+		}
+		if st.nextNonce <= memTx.nonce {
+			st.nextNonce = memTx.nonce + 1
+			if len(st.elems) == 0 {
+				st.firstNonce = st.nextNonce
+			}
+		}
+	}
+
+	if len(st.elems) == 0 && len(st.held) == 0 {
+		delete(mem.senders, memTx.sender)
+	}
+	return true
+}
+
+// updateSenders is called once the committed txs are removed from the
+// mempool. It drops the held txs older than HeldTxTTLNumBlocks, and adds the
+// held txs following the last tx of each sender to the list.
+//
+// A sender without txs left in the list restarts from its held tx with the
+// lowest nonce, since the missing txs may have been committed without going
+// through this mempool.
+func (mem *CListMempool) updateSenders() {
+	mem.sendersMtx.Lock()
+	defer mem.sendersMtx.Unlock()
+
+	for sender, st := range mem.senders {
+		if ttl := mem.config.HeldTxTTLNumBlocks; ttl > 0 {
+			for _, memTx := range st.held {
+				if mem.height-memTx.Height() >= ttl {
+					mem.unholdTx(st, memTx)
+					mem.cache.Remove(memTx.tx)
+					mem.publishTxEvent(memTx.tx, types.MempoolTxExpired, "")
+				}
+			}
+		}
+		if len(st.held) == 0 {
+			if len(st.elems) == 0 {
+				delete(mem.senders, sender)
+			}
+			continue
+		}
+		if len(st.elems) == 0 {
+			first := true
+			for nonce := range st.held {
+				if first || nonce < st.nextNonce {
+					st.nextNonce = nonce
+					first = false
+				}
+			}
+			st.firstNonce = st.nextNonce
+		}
+		mem.promoteHeldTxs(st)
+	}
+}
+
+// heldSize returns the number and total size of the held txs.
+func (mem *CListMempool) heldSize() (int, int64) {
+	mem.sendersMtx.Lock()
+	defer mem.sendersMtx.Unlock()
+
+	return len(mem.heldTxs), mem.heldTxsBytes
+}
+
+// resetSenders drops the held txs, and the nonces expected for each sender.
+func (mem *CListMempool) resetSenders() {
+	mem.sendersMtx.Lock()
+	defer mem.sendersMtx.Unlock()
+
+	mem.senders = make(map[string]*senderTxs)
+	mem.heldTxs = make(map[types.TxKey]*mempoolTx)
+	mem.heldTxsBytes = 0
+	mem.metrics.HeldTxs.Set(0)
+}
@@ -40,7 +40,9 @@ type TxMempool struct {
 	config       *config.MempoolConfig
 	proxyAppConn proxy.AppConnMempool
 	metrics      *mempool.Metrics
-	cache        mempool.TxCache // seen transactions
+	eventBus     types.MempoolEventPublisher
+	txEvents     *mempool.TxEventQueue // lifecycle events of the transactions to publish
+	cache        mempool.TxCache       // seen transactions
 
 	// Atomically-updated fields
 	txsBytes int64 // atomic: the total size of all transactions in the mempool, in bytes
@@ -56,6 +58,8 @@ type TxMempool struct {
 	txs        *clist.CList // valid transactions (passed CheckTx)
 	txByKey    map[types.TxKey]*clist.CElement
 	txBySender map[string]*clist.CElement // for sender != ""
+
+	wal *mempool.WAL // write-ahead log of the valid transactions, nil if disabled
 }
 
 // NewTxMempool constructs a new, empty priority mempool at the specified
@@ -72,6 +76,7 @@ func NewTxMempool(
 		config:       cfg,
 		proxyAppConn: proxyAppConn,
 		metrics:      mempool.NopMetrics(),
+		eventBus:     types.NopEventBus{},
 		cache:        mempool.NopTxCache{},
 		txs:          clist.New(),
 		mtx:          new(sync.RWMutex),
@@ -79,6 +84,7 @@ func NewTxMempool(
 		txByKey:      make(map[types.TxKey]*clist.CElement),
 		txBySender:   make(map[string]*clist.CElement),
 	}
+	txmp.txEvents = mempool.NewTxEventQueue(txmp.publishTxEventData)
 	if cfg.CacheSize > 0 {
 		txmp.cache = mempool.NewLRUTxCache(cfg.CacheSize)
 	}
@@ -109,6 +115,41 @@ func WithMetrics(metrics *mempool.Metrics) TxMempoolOption {
 	return func(txmp *TxMempool) { txmp.metrics = metrics }
 }
 
+// WithEventBus sets the event bus the lifecycle events of the transactions are
+// published to.
+func WithEventBus(eventBus types.MempoolEventPublisher) TxMempoolOption {
+	return func(txmp *TxMempool) { txmp.eventBus = eventBus }
+}
+
+// InitWAL opens the write-ahead log in the configured directory, and replays
+// the transactions in it through CheckTx. It must be called once, on startup,
+// before any transaction is checked.
+func (txmp *TxMempool) InitWAL() error {
+	wal, err := mempool.NewWAL(txmp.config.WalDir())
+	if err != nil {
+		return err
+	}
+	wal.SetLogger(txmp.logger.With("wal", txmp.config.WalDir()))
+	if err := wal.Start(); err != nil {
+		return err
+	}
+	txmp.wal = wal
+
+	return wal.Replay(func(tx types.Tx) error {
+		return txmp.CheckTx(tx, nil, mempool.TxInfo{SenderID: mempool.UnknownPeerID})
+	}, txmp.proxyAppConn.FlushSync)
+}
+
+// CloseWAL flushes and closes the write-ahead log.
+func (txmp *TxMempool) CloseWAL() {
+	if txmp.wal == nil {
+		return
+	}
+	if err := txmp.wal.Stop(); err != nil {
+		txmp.logger.Error("Error closing WAL", "err", err)
+	}
+}
+
 // Lock obtains a write-lock on the mempool. A caller must be sure to explicitly
 // release the lock when finished.
 func (txmp *TxMempool) Lock() { txmp.mtx.Lock() }
@@ -124,6 +165,22 @@ func (txmp *TxMempool) Size() int { return txmp.txs.Len() }
 // mempool. It is thread-safe.
 func (txmp *TxMempool) SizeBytes() int64 { return atomic.LoadInt64(&txmp.txsBytes) }
 
+// TxStatus returns the status of a transaction in the mempool and its cache.
+func (txmp *TxMempool) TxStatus(txKey types.TxKey) mempool.TxStatus {
+	txmp.mtx.RLock()
+	_, ok := txmp.txByKey[txKey]
+	txmp.mtx.RUnlock()
+
+	switch {
+	case ok:
+		return mempool.TxStatusPending
+	case txmp.cache.HasKey(txKey):
+		return mempool.TxStatusInCache
+	default:
+		return mempool.TxStatusUnknown
+	}
+}
+
 // FlushAppConn executes FlushSync on the mempool's proxyAppConn.
 //
 // The caller must hold an exclusive mempool lock (by calling txmp.Lock) before
@@ -256,6 +313,7 @@ func (txmp *TxMempool) removeTxByKey(key types.TxKey) error {
 		elt.DetachPrev()
 		elt.DetachNext()
 		atomic.AddInt64(&txmp.txsBytes, -w.Size())
+		txmp.removeTxFromWAL(w.tx)
 		return nil
 	}
 	return fmt.Errorf("transaction %x not found", key)
@@ -271,6 +329,15 @@ func (txmp *TxMempool) removeTxByElement(elt *clist.CElement) {
 	elt.DetachPrev()
 	elt.DetachNext()
 	atomic.AddInt64(&txmp.txsBytes, -w.Size())
+	txmp.removeTxFromWAL(w.tx)
+}
+
+func (txmp *TxMempool) removeTxFromWAL(tx types.Tx) {
+	if txmp.wal != nil {
+		if err := txmp.wal.RemoveTx(tx); err != nil {
+			txmp.logger.Error("Error writing removed tx to WAL", "tx", fmt.Sprintf("%X", tx.Hash()), "err", err)
+		}
+	}
 }
 
 // Flush purges the contents of the mempool and the cache, leaving both empty.
@@ -333,6 +400,11 @@ func (txmp *TxMempool) ReapMaxTxsMaxBytesMaxGas(maxTxs int, maxBytes, maxGas int
 		}
 		keep = append(keep, w.tx)
 	}
+
+	txmp.mtx.RLock()
+	height := txmp.height
+	txmp.mtx.RUnlock()
+	txmp.publishTxEventsAt(keep, types.MempoolTxReaped, "", height)
 	return keep
 }
 
@@ -391,14 +463,32 @@ func (txmp *TxMempool) ReapMaxTxs(max int) types.Txs {
 	return keep
 }
 
+// SnapshotTxs returns all the transactions in the mempool with their metadata,
+// in the same order as ReapMaxTxs.
+func (txmp *TxMempool) SnapshotTxs() []mempool.SnapshotTx {
+	all := txmp.allEntriesSorted()
+	txs := make([]mempool.SnapshotTx, len(all))
+	for i, w := range all {
+		txs[i] = mempool.SnapshotTx{
+			Tx:        w.tx,
+			Height:    w.height,
+			GasWanted: w.gasWanted,
+			Sender:    w.sender,
+			Priority:  w.priority,
+		}
+	}
+	return txs
+}
+
 // Update removes all the given transactions from the mempool and the cache,
 // and updates the current block height. The blockTxs and deliverTxResponses
 // must have the same length with each response corresponding to the tx at the
 // same offset.
 //
 // If the configuration enables recheck, Update sends each remaining
-// transaction after removing blockTxs to the ABCI CheckTx method.  Any
-// transactions marked as invalid during recheck are also removed.
+// transaction after removing blockTxs to the ABCI CheckTx method, or only
+// those selected by recheckFilter if it is not nil.  Any transactions marked as
+// invalid during recheck are also removed.
 //
 // The caller must hold an exclusive mempool lock (by calling txmp.Lock) before
 // calling Update.
@@ -406,6 +496,7 @@ func (txmp *TxMempool) Update(
 	blockHeight int64,
 	blockTxs types.Txs,
 	deliverTxResponses []*abci.ResponseDeliverTx,
+	recheckFilter *abci.RecheckFilter,
 	newPreFn mempool.PreCheckFunc,
 	newPostFn mempool.PostCheckFunc,
 ) error {
@@ -425,6 +516,7 @@ func (txmp *TxMempool) Update(
 		txmp.postCheck = newPostFn
 	}
 
+	committed := make(types.Txs, 0, len(blockTxs))
 	for i, tx := range blockTxs {
 		// Add successful committed transactions to the cache (if they are not
 		// already present).  Transactions that failed to commit are removed from
@@ -436,11 +528,22 @@ func (txmp *TxMempool) Update(
 		}
 
 		// Regardless of success, remove the transaction from the mempool.
-		_ = txmp.removeTxByKey(tx.Key())
+		if err := txmp.removeTxByKey(tx.Key()); err == nil {
+			committed = append(committed, tx)
+		}
 	}
+	txmp.publishTxEventsAt(committed, types.MempoolTxCommitted, "", blockHeight)
 
 	txmp.purgeExpiredTxs(blockHeight)
 
+	// Sync the removal of the committed and expired transactions to the WAL,
+	// which is compacted once most of it is made of removed transactions.
+	if txmp.wal != nil {
+		if err := txmp.wal.Trim(txmp.allTxs); err != nil {
+			txmp.logger.Error("Error trimming WAL", "err", err)
+		}
+	}
+
 	// If there any uncommitted transactions left in the mempool, we either
 	// initiate re-CheckTx per remaining transaction or notify that remaining
 	// transactions are left.
@@ -448,7 +551,7 @@ func (txmp *TxMempool) Update(
 	txmp.metrics.Size.Set(float64(size))
 	if size > 0 {
 		if txmp.config.Recheck {
-			txmp.recheckTransactions()
+			txmp.recheckTransactions(mempool.NewRecheckSelector(recheckFilter))
 		} else {
 			txmp.notifyTxsAvailable()
 		}
@@ -522,6 +625,7 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.Respon
 			checkTxRes.MempoolError = fmt.Sprintf("rejected valid incoming transaction; tx already exists for sender %q (%X)",
 				sender, w.tx.Hash())
 			txmp.metrics.RejectedTxs.Add(1)
+			txmp.publishTxEvent(wtx.tx, types.MempoolTxEvicted, checkTxRes.MempoolError)
 			return
 		}
 	}
@@ -556,6 +660,7 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.Respon
 			checkTxRes.MempoolError = fmt.Sprintf("rejected valid incoming transaction; mempool is full (%X)",
 				wtx.tx.Hash())
 			txmp.metrics.RejectedTxs.Add(1)
+			txmp.publishTxEvent(wtx.tx, types.MempoolTxEvicted, err.Error())
 			return
 		}
 
@@ -588,6 +693,7 @@ func (txmp *TxMempool) addNewTransaction(wtx *WrappedTx, checkTxRes *abci.Respon
 			txmp.removeTxByElement(vic)
 			txmp.cache.Remove(w.tx)
 			txmp.metrics.EvictedTxs.Add(1)
+			txmp.publishTxEvent(w.tx, types.MempoolTxEvicted, "evicted by a higher-priority transaction")
 
 			// We may not need to evict all the eligible transactions.  Bail out
 			// early if we have made enough room.
@@ -623,6 +729,23 @@ func (txmp *TxMempool) insertTx(wtx *WrappedTx) {
 	}
 
 	atomic.AddInt64(&txmp.txsBytes, wtx.Size())
+	txmp.publishTxEvent(wtx.tx, types.MempoolTxAdded, "")
+
+	if txmp.wal != nil {
+		if err := txmp.wal.AddTx(wtx.tx); err != nil {
+			txmp.logger.Error("Error writing tx to WAL", "tx", fmt.Sprintf("%X", wtx.tx.Hash()), "err", err)
+		}
+	}
+}
+
+// allTxs returns the transactions in the mempool in order of arrival.
+// The caller must hold txmp.mtx.
+func (txmp *TxMempool) allTxs() types.Txs {
+	txs := make(types.Txs, 0, txmp.txs.Len())
+	for e := txmp.txs.Front(); e != nil; e = e.Next() {
+		txs = append(txs, e.Value.(*WrappedTx).tx)
+	}
+	return txs
 }
 
 // handleRecheckResult handles the responses from ABCI CheckTx calls issued
@@ -668,6 +791,11 @@ func (txmp *TxMempool) handleRecheckResult(tx types.Tx, checkTxRes *abci.Respons
 	if !txmp.config.KeepInvalidTxsInCache {
 		txmp.cache.Remove(wtx.tx)
 	}
+	reason := checkTxRes.Log
+	if err != nil {
+		reason = err.Error()
+	}
+	txmp.publishTxEvent(wtx.tx, types.MempoolTxRecheckInvalid, reason)
 	txmp.metrics.Size.Set(float64(txmp.Size()))
 }
 
@@ -677,20 +805,28 @@ func (txmp *TxMempool) handleRecheckResult(tx types.Tx, checkTxRes *abci.Respons
 //
 // Precondition: The mempool is not empty.
 // The caller must hold txmp.mtx exclusively.
-func (txmp *TxMempool) recheckTransactions() {
+func (txmp *TxMempool) recheckTransactions(selector mempool.RecheckSelector) {
 	if txmp.Size() == 0 {
 		panic("mempool: cannot run recheck on an empty mempool")
 	}
-	txmp.logger.Debug(
-		"executing re-CheckTx for all remaining transactions",
-		"num_txs", txmp.Size(),
-		"height", txmp.height,
-	)
 
 	// Collect transactions currently in the mempool requiring recheck.
 	wtxs := make([]*WrappedTx, 0, txmp.txs.Len())
 	for e := txmp.txs.Front(); e != nil; e = e.Next() {
-		wtxs = append(wtxs, e.Value.(*WrappedTx))
+		wtx := e.Value.(*WrappedTx)
+		if selector(wtx.sender) {
+			wtxs = append(wtxs, wtx)
+		}
+	}
+	txmp.logger.Debug(
+		"executing re-CheckTx for the selected transactions",
+		"num_txs", len(wtxs),
+		"num_skipped", txmp.Size()-len(wtxs),
+		"height", txmp.height,
+	)
+	if len(wtxs) == 0 {
+		txmp.notifyTxsAvailable()
+		return
 	}
 
 	// Issue CheckTx calls for each remaining transaction, and when all the
@@ -766,15 +902,48 @@ func (txmp *TxMempool) purgeExpiredTxs(blockHeight int64) {
 			txmp.removeTxByElement(cur)
 			txmp.cache.Remove(w.tx)
 			txmp.metrics.EvictedTxs.Add(1)
+			txmp.publishTxEvent(w.tx, types.MempoolTxExpired, "exceeded ttl-num-blocks")
 		} else if txmp.config.TTLDuration > 0 && now.Sub(w.timestamp) > txmp.config.TTLDuration { //nolint:staticcheck // SA1019 Priority mempool deprecated but still supported in this release.
 			txmp.removeTxByElement(cur)
 			txmp.cache.Remove(w.tx)
 			txmp.metrics.EvictedTxs.Add(1)
+			txmp.publishTxEvent(w.tx, types.MempoolTxExpired, "exceeded ttl-duration")
 		}
 		cur = next
 	}
 }
 
+// publishTxEvent queues a lifecycle event of a transaction, see
+// publishTxEventsAt.
+func (txmp *TxMempool) publishTxEvent(tx types.Tx, status, reason string) {
+	txmp.publishTxEventsAt(types.Txs{tx}, status, reason, txmp.height)
+}
+
+// publishTxEventsAt queues the same lifecycle event of several transactions at
+// the given height. The events are published in the background, in order, so
+// that the mempool isn't blocked by slow subscribers.
+func (txmp *TxMempool) publishTxEventsAt(txs types.Txs, status, reason string, height int64) {
+	if _, ok := txmp.eventBus.(types.NopEventBus); ok || len(txs) == 0 {
+		return
+	}
+	events := make([]types.EventDataMempoolTx, len(txs))
+	for i, tx := range txs {
+		events[i] = types.EventDataMempoolTx{
+			Hash:   tx.Hash(),
+			Status: status,
+			Height: height,
+			Reason: reason,
+		}
+	}
+	txmp.txEvents.Push(events...)
+}
+
+func (txmp *TxMempool) publishTxEventData(data types.EventDataMempoolTx) {
+	if err := txmp.eventBus.PublishEventMempoolTx(data); err != nil {
+		txmp.logger.Error("Error publishing mempool tx event", "tx", data.Hash, "status", data.Status, "err", err)
+	}
+}
+
 func (txmp *TxMempool) notifyTxsAvailable() {
 	if txmp.Size() == 0 {
 		return // nothing to do
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -171,7 +172,7 @@ func TestTxMempool_TxsAvailable(t *testing.T) {
 
 	// commit half the transactions and ensure we fire an event
 	txmp.Lock()
-	require.NoError(t, txmp.Update(1, rawTxs[:50], responses, nil, nil))
+	require.NoError(t, txmp.Update(1, rawTxs[:50], responses, nil, nil, nil))
 	txmp.Unlock()
 	ensureTxFire()
 	ensureNoTxFire()
@@ -199,7 +200,7 @@ func TestTxMempool_Size(t *testing.T) {
 	}
 
 	txmp.Lock()
-	require.NoError(t, txmp.Update(1, rawTxs[:50], responses, nil, nil))
+	require.NoError(t, txmp.Update(1, rawTxs[:50], responses, nil, nil, nil))
 	txmp.Unlock()
 
 	require.Equal(t, len(rawTxs)/2, txmp.Size())
@@ -294,7 +295,7 @@ func TestTxMempool_Flush(t *testing.T) {
 	}
 
 	txmp.Lock()
-	require.NoError(t, txmp.Update(1, rawTxs[:50], responses, nil, nil))
+	require.NoError(t, txmp.Update(1, rawTxs[:50], responses, nil, nil, nil))
 	txmp.Unlock()
 
 	txmp.Flush()
@@ -302,6 +303,46 @@ func TestTxMempool_Flush(t *testing.T) {
 	require.Equal(t, int64(0), txmp.SizeBytes())
 }
 
+func TestTxMempool_WAL(t *testing.T) {
+	app := &application{kvstore.NewApplication()}
+	cc := proxy.NewLocalClientCreator(app)
+	cfg := config.ResetTestRoot(t.Name())
+	cfg.Mempool.WalPath = "data/mempool.wal"
+	t.Cleanup(func() { os.RemoveAll(cfg.RootDir) })
+
+	newMempool := func() *TxMempool {
+		appConnMem, err := cc.NewABCIClient()
+		require.NoError(t, err)
+		require.NoError(t, appConnMem.Start())
+		t.Cleanup(func() { require.NoError(t, appConnMem.Stop()) })
+
+		txmp := NewTxMempool(log.TestingLogger().With("test", t.Name()), cfg.Mempool, appConnMem, 0)
+		require.NoError(t, txmp.InitWAL())
+		return txmp
+	}
+
+	txmp := newMempool()
+	txs := checkTxs(t, txmp, 10, 0)
+	rawTxs := make([]types.Tx, len(txs))
+	for i, tx := range txs {
+		rawTxs[i] = tx.tx
+	}
+	responses := make([]*abci.ResponseDeliverTx, 3)
+	for i := 0; i < len(responses); i++ {
+		responses[i] = &abci.ResponseDeliverTx{Code: abci.CodeTypeOK}
+	}
+	txmp.Lock()
+	require.NoError(t, txmp.Update(1, rawTxs[:3], responses, nil, nil, nil))
+	txmp.Unlock()
+	require.NoError(t, txmp.RemoveTxByKey(rawTxs[3].Key()))
+	txmp.CloseWAL()
+
+	// the transactions left are replayed by the restarted mempool
+	txmp = newMempool()
+	defer txmp.CloseWAL()
+	require.ElementsMatch(t, rawTxs[4:], txmp.ReapMaxTxs(-1))
+}
+
 func TestTxMempool_ReapMaxBytesMaxGas(t *testing.T) {
 	txmp := setup(t, 0)
 	tTxs := checkTxs(t, txmp, 100, 0) // all txs request 1 gas unit
@@ -499,7 +540,7 @@ func TestTxMempool_ConcurrentTxs(t *testing.T) {
 				}
 
 				txmp.Lock()
-				require.NoError(t, txmp.Update(height, reapedTxs, responses, nil, nil))
+				require.NoError(t, txmp.Update(height, reapedTxs, responses, nil, nil, nil))
 				txmp.Unlock()
 
 				height++
@@ -547,7 +588,7 @@ func TestTxMempool_ExpiredTxs_Timestamp(t *testing.T) {
 	// Trigger an update so that pruning will occur.
 	txmp.Lock()
 	defer txmp.Unlock()
-	require.NoError(t, txmp.Update(txmp.height+1, nil, nil, nil, nil))
+	require.NoError(t, txmp.Update(txmp.height+1, nil, nil, nil, nil, nil))
 
 	// All the transactions in the original set should have been purged.
 	for _, tx := range added1 {
@@ -583,7 +624,7 @@ func TestTxMempool_ExpiredTxs_NumBlocks(t *testing.T) {
 	}
 
 	txmp.Lock()
-	require.NoError(t, txmp.Update(txmp.height+1, reapedTxs, responses, nil, nil))
+	require.NoError(t, txmp.Update(txmp.height+1, reapedTxs, responses, nil, nil, nil))
 	txmp.Unlock()
 
 	require.Equal(t, 95, txmp.Size())
@@ -607,7 +648,7 @@ func TestTxMempool_ExpiredTxs_NumBlocks(t *testing.T) {
 	}
 
 	txmp.Lock()
-	require.NoError(t, txmp.Update(txmp.height+10, reapedTxs, responses, nil, nil))
+	require.NoError(t, txmp.Update(txmp.height+10, reapedTxs, responses, nil, nil, nil))
 	txmp.Unlock()
 
 	require.GreaterOrEqual(t, txmp.Size(), 45)
@@ -652,3 +693,152 @@ func TestTxMempool_CheckTxPostCheckError(t *testing.T) {
 		})
 	}
 }
+
+func TestTxMempool_TxEvents(t *testing.T) {
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	sub, err := eventBus.Subscribe(context.Background(), "test", types.EventQueryMempoolTx, 10)
+	require.NoError(t, err)
+	ensureTxEvent := func(tx types.Tx, status string) {
+		t.Helper()
+		select {
+		case msg := <-sub.Out():
+			edt := msg.Data().(types.EventDataMempoolTx)
+			require.Equal(t, tx.Hash(), []byte(edt.Hash))
+			require.Equal(t, status, edt.Status)
+		case <-time.After(time.Second):
+			t.Fatalf("did not receive a %s event", status)
+		}
+	}
+
+	txmp := setup(t, 100, WithEventBus(eventBus))
+	txmp.config.TTLNumBlocks = 1 //nolint:staticcheck // SA1019 Priority mempool deprecated but still supported in this release.
+
+	tx1, tx2 := types.Tx("sender-1=key1=1"), types.Tx("sender-2=key2=2")
+	require.Equal(t, mempool.TxStatusUnknown, txmp.TxStatus(tx1.Key()))
+
+	mustCheckTx(t, txmp, string(tx1))
+	ensureTxEvent(tx1, types.MempoolTxAdded)
+	require.Equal(t, mempool.TxStatusPending, txmp.TxStatus(tx1.Key()))
+
+	// tx1 is committed at height 1, and tx2 expires at height 2
+	txs := txmp.ReapMaxTxsMaxBytesMaxGas(-1, -1, -1)
+	require.Equal(t, types.Txs{tx1}, txs)
+	ensureTxEvent(tx1, types.MempoolTxReaped)
+
+	txmp.Lock()
+	require.NoError(t, txmp.Update(1, txs, []*abci.ResponseDeliverTx{{Code: abci.CodeTypeOK}}, nil, nil, nil))
+	txmp.Unlock()
+	ensureTxEvent(tx1, types.MempoolTxCommitted)
+	require.Equal(t, mempool.TxStatusInCache, txmp.TxStatus(tx1.Key()))
+
+	mustCheckTx(t, txmp, string(tx2))
+	ensureTxEvent(tx2, types.MempoolTxAdded)
+
+	txmp.Lock()
+	require.NoError(t, txmp.Update(3, nil, nil, nil, nil, nil))
+	txmp.Unlock()
+	ensureTxEvent(tx2, types.MempoolTxExpired)
+	require.Equal(t, mempool.TxStatusUnknown, txmp.TxStatus(tx2.Key()))
+}
+
+func TestTxMempool_SnapshotTxs(t *testing.T) {
+	txmp := setup(t, 100)
+	txmp.height = 3
+
+	mustCheckTx(t, txmp, "sender-1=key1=1")
+	mustCheckTx(t, txmp, "sender-2=key2=5")
+
+	// the txs are in priority order
+	require.Equal(t, []mempool.SnapshotTx{
+		{Tx: types.Tx("sender-2=key2=5"), Height: 3, GasWanted: 1, Sender: "sender-2", Priority: 5},
+		{Tx: types.Tx("sender-1=key1=1"), Height: 3, GasWanted: 1, Sender: "sender-1", Priority: 1},
+	}, txmp.SnapshotTxs())
+}
+
+// recheckApplication records the rechecked transactions, and rejects those of
+// sender "b" on recheck.
+type recheckApplication struct {
+	*application
+
+	mtx       sync.Mutex
+	rechecked []string
+}
+
+func (app *recheckApplication) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	res := app.application.CheckTx(req)
+	if req.Type == abci.CheckTxType_Recheck {
+		app.mtx.Lock()
+		app.rechecked = append(app.rechecked, string(req.Tx))
+		app.mtx.Unlock()
+		if res.Sender == "b" {
+			res.Code = 102
+		}
+	}
+	return res
+}
+
+func (app *recheckApplication) takeRechecked() []string {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	rechecked := app.rechecked
+	app.rechecked = nil
+	sort.Strings(rechecked)
+	return rechecked
+}
+
+func TestTxMempool_RecheckFilter(t *testing.T) {
+	app := &recheckApplication{application: &application{kvstore.NewApplication()}}
+	cc := proxy.NewLocalClientCreator(app)
+	cfg := config.ResetTestRoot(strings.ReplaceAll(t.Name(), "/", "|"))
+	appConnMem, err := cc.NewABCIClient()
+	require.NoError(t, err)
+	require.NoError(t, appConnMem.Start())
+	t.Cleanup(func() {
+		os.RemoveAll(cfg.RootDir)
+		require.NoError(t, appConnMem.Stop())
+	})
+	txmp := NewTxMempool(log.TestingLogger(), cfg.Mempool, appConnMem, 0)
+	txmp.EnableTxsAvailable()
+
+	ensureTxFire := func() {
+		t.Helper()
+		select {
+		case <-txmp.TxsAvailable():
+		case <-time.After(time.Second):
+			require.Fail(t, "expected transactions event")
+		}
+	}
+
+	for _, tx := range []string{"a=k1=1", "b=k2=2", "c=k3=3"} {
+		mustCheckTx(t, txmp, tx)
+	}
+	ensureTxFire()
+
+	// only the transactions of the senders in the filter are rechecked
+	txmp.Lock()
+	require.NoError(t, txmp.Update(1, nil, nil, &abci.RecheckFilter{Senders: []string{"b", "c"}}, nil, nil))
+	txmp.Unlock()
+	ensureTxFire()
+	require.Equal(t, []string{"b=k2=2", "c=k3=3"}, app.takeRechecked())
+	require.Equal(t, 2, txmp.Size())
+
+	// the transactions are available right away if none is selected
+	txmp.Lock()
+	require.NoError(t, txmp.Update(2, nil, nil, &abci.RecheckFilter{}, nil, nil))
+	txmp.Unlock()
+	ensureTxFire()
+	require.Empty(t, app.takeRechecked())
+
+	// all the transactions are rechecked without a filter
+	txmp.Lock()
+	require.NoError(t, txmp.Update(3, nil, nil, nil, nil, nil))
+	txmp.Unlock()
+	ensureTxFire()
+	require.Equal(t, []string{"a=k1=1", "c=k3=3"}, app.takeRechecked())
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/libs/clist"
 	"github.com/cometbft/cometbft/libs/log"
@@ -135,6 +136,7 @@ func (memR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			Priority:            5,
 			RecvMessageCapacity: batchMsg.Size(),
 			MessageType:         &protomem.Message{},
+			Compress:            true,
 		},
 	}
 }
@@ -170,9 +172,10 @@ func (memR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 		}
 
 		var err error
+		cb := memR.markPeerGoodTx(e.Src)
 		for _, tx := range protoTxs {
 			ntx := types.Tx(tx)
-			err = memR.mempool.CheckTx(ntx, nil, txInfo)
+			err = memR.mempool.CheckTx(ntx, cb, txInfo)
 			if errors.Is(err, mempool.ErrTxInCache) {
 				memR.Logger.Debug("Tx already exists in cache", "tx", ntx.String())
 			} else if err != nil {
@@ -181,13 +184,28 @@ func (memR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 		}
 	default:
 		memR.Logger.Error("unknown message type", "src", e.Src, "chId", e.ChannelID, "msg", e.Message)
-		memR.Switch.StopPeerForError(e.Src, fmt.Errorf("mempool cannot handle message of type: %T", e.Message))
+		err := fmt.Errorf("mempool cannot handle message of type: %T", e.Message)
+		memR.Switch.MarkPeerBadEvent(e.Src, err)
+		memR.Switch.StopPeerForError(e.Src, err)
 		return
 	}
 
 	// broadcasting happens from go routines per peer
 }
 
+// markPeerGoodTx returns a CheckTx callback recording a good event of the peer
+// if the tx it sent is added to the mempool.
+func (memR *Reactor) markPeerGoodTx(peer p2p.Peer) func(*abci.Response) {
+	if peer == nil {
+		return nil
+	}
+	return func(res *abci.Response) {
+		if r := res.GetCheckTx(); r != nil && r.Code == abci.CodeTypeOK && r.MempoolError == "" {
+			memR.Switch.MarkPeerGoodEvent(peer)
+		}
+	}
+}
+
 // PeerState describes the state of a peer.
 type PeerState interface {
 	GetHeight() int64
@@ -0,0 +1,333 @@
+package mempool
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	auto "github.com/cometbft/cometbft/libs/autofile"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	"github.com/cometbft/cometbft/libs/service"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// how often the WAL is flushed and sync'd to disk
+	walFlushInterval = 2 * time.Second
+
+	// the WAL is not compacted until its records take at least this size
+	walMinCompactionSize = 1024 * 1024 // 1MB
+
+	// crc (4 bytes) + length (4 bytes) + record type (1 byte)
+	walRecordHeaderSize = 9
+
+	walRecordAddTx    = byte(0x01) // followed by the tx
+	walRecordRemoveTx = byte(0x02) // followed by the key of the tx
+)
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// WAL is the write-ahead log of the mempool, which lets the txs in the
+// mempool survive a restart of the node.
+//
+// The txs accepted by the mempool are appended to the WAL, as well as the keys
+// of the txs leaving the mempool (committed, evicted or invalidated). Once the
+// removed txs take most of the WAL, it is compacted by rewriting the txs still
+// in the mempool to a new file of the group, and removing the older files.
+//
+// The WAL is flushed and synced to disk every 2s, on Trim and once stopped.
+type WAL struct {
+	service.BaseService
+
+	group       *auto.Group
+	flushTicker *time.Ticker
+
+	mtx        cmtsync.Mutex
+	liveBytes  int64 // size of the records of the txs which have not been removed
+	totalBytes int64 // size of all the records since the WAL was compacted
+}
+
+// NewWAL opens the WAL in the given directory, creating it if needed.
+func NewWAL(walDir string) (*WAL, error) {
+	if err := cmtos.EnsureDir(walDir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to ensure WAL directory is in place: %w", err)
+	}
+
+	// Files are only rotated and removed on compaction, which must never drop
+	// the txs still in the mempool.
+	group, err := auto.OpenGroup(filepath.Join(walDir, "wal"), auto.GroupHeadSizeLimit(0), auto.GroupTotalSizeLimit(0))
+	if err != nil {
+		return nil, err
+	}
+	wal := &WAL{group: group}
+	wal.BaseService = *service.NewBaseService(nil, "MempoolWAL", wal)
+	return wal, nil
+}
+
+// SetLogger sets the Logger.
+func (wal *WAL) SetLogger(l log.Logger) {
+	wal.BaseService.Logger = l
+	wal.group.SetLogger(l)
+}
+
+// OnStart implements service.Service.
+func (wal *WAL) OnStart() error {
+	wal.flushTicker = time.NewTicker(walFlushInterval)
+	go wal.processFlushTicks()
+	return nil
+}
+
+// OnStop implements service.Service by flushing the WAL and closing its files.
+func (wal *WAL) OnStop() {
+	wal.flushTicker.Stop()
+
+	wal.mtx.Lock()
+	defer wal.mtx.Unlock()
+	wal.group.Close()
+	if err := wal.group.Head.Close(); err != nil {
+		wal.Logger.Error("Error closing mempool WAL", "err", err)
+	}
+}
+
+func (wal *WAL) processFlushTicks() {
+	for {
+		select {
+		case <-wal.flushTicker.C:
+			if err := wal.group.FlushAndSync(); err != nil {
+				wal.Logger.Error("Periodic mempool WAL flush failed", "err", err)
+			}
+		case <-wal.Quit():
+			return
+		}
+	}
+}
+
+// AddTx appends a tx accepted by the mempool.
+// NOTE: does not call fsync()
+func (wal *WAL) AddTx(tx types.Tx) error {
+	wal.mtx.Lock()
+	defer wal.mtx.Unlock()
+
+	if !wal.IsRunning() {
+		return nil
+	}
+	n, err := wal.writeRecord(walRecordAddTx, tx)
+	wal.liveBytes += int64(n)
+	return err
+}
+
+// RemoveTx records that a tx has left the mempool, so that it's not replayed.
+// NOTE: does not call fsync()
+func (wal *WAL) RemoveTx(tx types.Tx) error {
+	wal.mtx.Lock()
+	defer wal.mtx.Unlock()
+
+	if !wal.IsRunning() {
+		return nil
+	}
+	key := tx.Key()
+	_, err := wal.writeRecord(walRecordRemoveTx, key[:])
+	wal.liveBytes -= int64(walRecordHeaderSize + len(tx))
+	return err
+}
+
+// Trim is called once the mempool is updated with a committed block. It
+// compacts the WAL to the given txs if most of it is made of removed txs, and
+// syncs it to disk.
+//
+// txs must return the txs in the mempool. It's only called if the WAL is
+// compacted, while the WAL is locked, so that no tx accepted by the mempool
+// meanwhile is dropped from the WAL.
+func (wal *WAL) Trim(txs func() types.Txs) error {
+	wal.mtx.Lock()
+	defer wal.mtx.Unlock()
+
+	if !wal.IsRunning() {
+		return nil
+	}
+	if wal.totalBytes < walMinCompactionSize || wal.totalBytes < 2*wal.liveBytes {
+		return wal.group.FlushAndSync()
+	}
+
+	wal.Logger.Debug("Compacting mempool WAL", "size", wal.totalBytes, "live", wal.liveBytes)
+	if err := wal.group.FlushAndSync(); err != nil {
+		return err
+	}
+	wal.group.RotateFile()
+	wal.liveBytes, wal.totalBytes = 0, 0
+	for _, tx := range txs() {
+		n, err := wal.writeRecord(walRecordAddTx, tx)
+		if err != nil {
+			return err
+		}
+		wal.liveBytes += int64(n)
+	}
+	if err := wal.group.FlushAndSync(); err != nil {
+		return err
+	}
+	return wal.removeRotatedFiles()
+}
+
+// Replay reads the txs in the WAL, and passes them to checkTx in the order
+// they were accepted. The txs accepted again must be added back to the WAL by
+// the mempool. flush is called to wait for the replayed txs to be checked.
+//
+// A record that is truncated or corrupted, e.g. because the node crashed while
+// writing it, ends the replay.
+func (wal *WAL) Replay(checkTx func(types.Tx) error, flush func() error) error {
+	txs, err := wal.readTxs()
+	if err != nil {
+		return err
+	}
+
+	// Start a new file, so that the older ones are only removed once all txs
+	// have been checked again.
+	wal.mtx.Lock()
+	if err := wal.group.FlushAndSync(); err != nil {
+		wal.mtx.Unlock()
+		return err
+	}
+	wal.group.RotateFile()
+	wal.liveBytes, wal.totalBytes = 0, 0
+	wal.mtx.Unlock()
+
+	wal.Logger.Info("Replaying mempool WAL", "txs", len(txs))
+	for _, tx := range txs {
+		if err := checkTx(tx); err != nil {
+			wal.Logger.Debug("Replayed tx was not accepted", "tx", tx.Hash(), "err", err)
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	wal.mtx.Lock()
+	defer wal.mtx.Unlock()
+	if err := wal.group.FlushAndSync(); err != nil {
+		return err
+	}
+	return wal.removeRotatedFiles()
+}
+
+// readTxs returns the txs which have been added to the WAL and not removed.
+func (wal *WAL) readTxs() (types.Txs, error) {
+	reader, err := wal.group.NewReader(wal.group.ReadGroupInfo().MinIndex)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	var (
+		txs   = make(types.Txs, 0)
+		index = make(map[types.TxKey]int) // tx key -> index in txs
+	)
+	for {
+		recordType, data, err := readRecord(reader)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			wal.Logger.Error("Stopped reading mempool WAL at an invalid record", "err", err)
+			break
+		}
+
+		switch recordType {
+		case walRecordAddTx:
+			tx := types.Tx(data)
+			// a tx may be written twice if it's accepted while the WAL is compacted
+			if _, ok := index[tx.Key()]; !ok {
+				index[tx.Key()] = len(txs)
+				txs = append(txs, tx)
+			}
+		case walRecordRemoveTx:
+			var key types.TxKey
+			copy(key[:], data)
+			if i, ok := index[key]; ok {
+				txs[i] = nil
+				delete(index, key)
+			}
+		}
+	}
+
+	live := make(types.Txs, 0, len(index))
+	for _, tx := range txs {
+		if tx != nil {
+			live = append(live, tx)
+		}
+	}
+	return live, nil
+}
+
+// writeRecord writes a record to the head of the group, and returns its size.
+// CONTRACT: wal.mtx must be held.
+func (wal *WAL) writeRecord(recordType byte, data []byte) (int, error) {
+	record := make([]byte, walRecordHeaderSize+len(data))
+	record[8] = recordType
+	copy(record[walRecordHeaderSize:], data)
+	binary.BigEndian.PutUint32(record[0:4], crc32.Checksum(record[8:], crc32c))
+	binary.BigEndian.PutUint32(record[4:8], uint32(len(data)))
+
+	if _, err := wal.group.Write(record); err != nil {
+		return 0, fmt.Errorf("failed to write to mempool WAL: %w", err)
+	}
+	wal.totalBytes += int64(len(record))
+	return len(record), nil
+}
+
+// readRecord reads the next record, and returns io.EOF if there are no more
+// records.
+func readRecord(rd io.Reader) (byte, []byte, error) {
+	header := make([]byte, walRecordHeaderSize)
+	if _, err := io.ReadFull(rd, header); err != nil {
+		if errors.Is(err, io.EOF) {
+			return 0, nil, err
+		}
+		return 0, nil, fmt.Errorf("failed to read record header: %w", err)
+	}
+
+	crc := binary.BigEndian.Uint32(header[0:4])
+	length := binary.BigEndian.Uint32(header[4:8])
+	if length > types.MaxBlockSizeBytes {
+		return 0, nil, fmt.Errorf("record length %d exceeds the max block size", length)
+	}
+
+	record := make([]byte, 1+length)
+	record[0] = header[8]
+	if _, err := io.ReadFull(rd, record[1:]); err != nil {
+		return 0, nil, fmt.Errorf("failed to read record data: %w", err)
+	}
+	if actualCRC := crc32.Checksum(record, crc32c); actualCRC != crc {
+		return 0, nil, fmt.Errorf("checksums do not match: read: %v, actual: %v", crc, actualCRC)
+	}
+
+	switch record[0] {
+	case walRecordAddTx:
+	case walRecordRemoveTx:
+		if length != uint32(len(types.TxKey{})) {
+			return 0, nil, fmt.Errorf("invalid tx key length %d", length)
+		}
+	default:
+		return 0, nil, fmt.Errorf("unknown record type %d", record[0])
+	}
+	return record[0], record[1:], nil
+}
+
+// removeRotatedFiles removes all the files of the group but the head.
+// CONTRACT: wal.mtx must be held.
+func (wal *WAL) removeRotatedFiles() error {
+	info := wal.group.ReadGroupInfo()
+	for index := info.MinIndex; index < info.MaxIndex; index++ {
+		path := fmt.Sprintf("%v.%03d", wal.group.Head.Path, index)
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove mempool WAL file: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package mempool
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/types"
+)
+
+func startWAL(t *testing.T, walDir string) *WAL {
+	wal, err := NewWAL(walDir)
+	require.NoError(t, err)
+	wal.SetLogger(log.TestingLogger())
+	require.NoError(t, wal.Start())
+	return wal
+}
+
+// replayWAL restarts the WAL, and returns the replayed txs, which are all
+// accepted again.
+func replayWAL(t *testing.T, walDir string) (*WAL, types.Txs) {
+	wal := startWAL(t, walDir)
+	replayed := make(types.Txs, 0)
+	err := wal.Replay(func(tx types.Tx) error {
+		replayed = append(replayed, tx)
+		return wal.AddTx(tx)
+	}, func() error { return nil })
+	require.NoError(t, err)
+	return wal, replayed
+}
+
+func TestWAL_Replay(t *testing.T) {
+	walDir := t.TempDir()
+	wal := startWAL(t, walDir)
+
+	txs := types.Txs{[]byte("tx1"), []byte("tx2"), []byte("tx3"), []byte("tx4")}
+	for _, tx := range txs {
+		require.NoError(t, wal.AddTx(tx))
+	}
+	require.NoError(t, wal.RemoveTx(txs[1]))
+	// a tx written twice is replayed once
+	require.NoError(t, wal.AddTx(txs[3]))
+	require.NoError(t, wal.Stop())
+
+	wal, replayed := replayWAL(t, walDir)
+	require.Equal(t, types.Txs{txs[0], txs[2], txs[3]}, replayed)
+
+	// the WAL only holds the replayed txs
+	info := wal.group.ReadGroupInfo()
+	require.Equal(t, info.MinIndex, info.MaxIndex)
+	require.NoError(t, wal.Stop())
+
+	_, replayed = replayWAL(t, walDir)
+	require.Equal(t, types.Txs{txs[0], txs[2], txs[3]}, replayed)
+}
+
+func TestWAL_Trim(t *testing.T) {
+	walDir := t.TempDir()
+	wal := startWAL(t, walDir)
+	t.Cleanup(func() { _ = wal.Stop() })
+
+	tx := func(i int) types.Tx {
+		tx := make(types.Tx, 1024)
+		tx[0], tx[1] = byte(i), byte(i>>8)
+		return tx
+	}
+	const numTxs = 2048
+	for i := 0; i < numTxs; i++ {
+		require.NoError(t, wal.AddTx(tx(i)))
+	}
+
+	// the WAL isn't compacted until most of it is made of removed txs
+	live := make(types.Txs, 0)
+	for i := 0; i < numTxs; i++ {
+		if i%4 == 0 {
+			live = append(live, tx(i))
+			continue
+		}
+		require.NoError(t, wal.RemoveTx(tx(i)))
+		if i == numTxs/4 {
+			require.NoError(t, wal.Trim(func() types.Txs {
+				t.Fatal("WAL should not be compacted")
+				return nil
+			}))
+		}
+	}
+	require.NoError(t, wal.Trim(func() types.Txs { return live }))
+	require.Less(t, wal.totalBytes, int64(numTxs*1024/2))
+
+	info := wal.group.ReadGroupInfo()
+	require.Equal(t, info.MinIndex, info.MaxIndex)
+	txs, err := wal.readTxs()
+	require.NoError(t, err)
+	require.Equal(t, live, txs)
+}
+
+func TestWAL_ReplayTruncated(t *testing.T) {
+	walDir := t.TempDir()
+	wal := startWAL(t, walDir)
+
+	txs := types.Txs{[]byte("tx1"), []byte("tx2")}
+	for _, tx := range txs {
+		require.NoError(t, wal.AddTx(tx))
+	}
+	require.NoError(t, wal.Stop())
+
+	// the node crashed while writing the last record
+	walFile := filepath.Join(walDir, "wal")
+	info, err := os.Stat(walFile)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(walFile, info.Size()-1))
+
+	_, replayed := replayWAL(t, walDir)
+	require.Equal(t, txs[:1], replayed)
+}
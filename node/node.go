@@ -34,9 +34,11 @@ import (
 	mempoolv1 "github.com/cometbft/cometbft/mempool/v1" //nolint:staticcheck // SA1019 Priority mempool deprecated but still supported in this release.
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/p2p/pex"
+	"github.com/cometbft/cometbft/p2p/trust"
 	"github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
 	rpccore "github.com/cometbft/cometbft/rpc/core"
+	coreeth "github.com/cometbft/cometbft/rpc/eth"
 	grpccore "github.com/cometbft/cometbft/rpc/grpc"
 	rpcserver "github.com/cometbft/cometbft/rpc/jsonrpc/server"
 	sm "github.com/cometbft/cometbft/state"
@@ -184,6 +186,10 @@ func CustomReactors(reactors map[string]p2p.Reactor) Option {
 						ni.Channels = append(ni.Channels, chDesc.ID)
 						n.transport.AddChannel(chDesc.ID)
 					}
+					if n.config.P2P.Compression && chDesc.Compress && !ni.HasCompressedChannel(chDesc.ID) {
+						ni.CompressedChannels = append(ni.CompressedChannels, chDesc.ID)
+						n.transport.AddCompressedChannel(chDesc.ID)
+					}
 				}
 				n.nodeInfo = ni
 			} else {
@@ -202,6 +208,18 @@ func StateProvider(stateProvider statesync.StateProvider) Option {
 	}
 }
 
+// MempoolTxSender sets the function returning the sender of a tx, so that the
+// v0 mempool checks the txs of a sender in order with several CheckTx workers,
+// even when they are received from different peers. Without it, only the txs
+// received from the same peer are checked in order.
+func MempoolTxSender(txSender mempoolv0.TxSenderFunc) Option {
+	return func(n *Node) {
+		if memR, ok := n.mempoolReactor.(*mempoolv0.Reactor); ok {
+			mempoolv0.WithTxSender(txSender)(memR)
+		}
+	}
+}
+
 // ------------------------------------------------------------------------------
 
 // Node is the highest level interface to a full CometBFT node.
@@ -215,7 +233,7 @@ type Node struct {
 	privValidator types.PrivValidator // local node's validator key
 
 	// network
-	transport   *p2p.MultiplexTransport
+	transport   p2pTransport
 	sw          *p2p.Switch  // p2p connections
 	addrBook    pex.AddrBook // known peers
 	nodeInfo    p2p.NodeInfo
@@ -226,6 +244,8 @@ type Node struct {
 	eventBus          *types.EventBus // pub/sub for services
 	stateStore        sm.Store
 	blockStore        *store.BlockStore // store the blockchain to disk
+	banListDB         dbm.DB            // peers banned by the switch
+	trustHistoryDB    dbm.DB            // trust history of the peers, nil if disabled
 	bcReactor         p2p.Reactor       // for block-syncing
 	mempoolReactor    p2p.Reactor       // for gossipping transactions
 	mempool           mempl.Mempool
@@ -338,10 +358,12 @@ func doHandshake(
 	eventBus types.BlockEventPublisher,
 	proxyApp proxy.AppConns,
 	skipAppHashVerify bool,
+	prefetchConfig *cfg.PrefetchConfig,
 	consensusLogger log.Logger,
 ) error {
 	handshaker := cs.NewHandshaker(stateStore, state, blockStore, genDoc,
-		cs.HandshakerSkipAppHashVerify(skipAppHashVerify))
+		cs.HandshakerSkipAppHashVerify(skipAppHashVerify),
+		cs.HandshakerPrefetchConfig(prefetchConfig))
 	handshaker.SetLogger(consensusLogger)
 	handshaker.SetEventBus(eventBus)
 	if err := handshaker.Handshake(proxyApp); err != nil {
@@ -389,6 +411,7 @@ func createMempoolAndMempoolReactor(
 	config *cfg.Config,
 	proxyApp proxy.AppConns,
 	state sm.State,
+	eventBus *types.EventBus,
 	memplMetrics *mempl.Metrics,
 	logger log.Logger,
 ) (mempl.Mempool, mempl.TxChecker, p2p.Reactor) {
@@ -400,6 +423,7 @@ func createMempoolAndMempoolReactor(
 			proxyApp.Mempool(),
 			state.LastBlockHeight,
 			mempoolv1.WithMetrics(memplMetrics),
+			mempoolv1.WithEventBus(eventBus),
 			mempoolv1.WithPreCheck(sm.TxPreCheck(state)),
 			mempoolv1.WithPostCheck(sm.TxPostCheck(state)),
 		)
@@ -420,15 +444,21 @@ func createMempoolAndMempoolReactor(
 			proxyApp.Mempool(),
 			state.LastBlockHeight,
 			mempoolv0.WithMetrics(memplMetrics),
+			mempoolv0.WithEventBus(eventBus),
 			mempoolv0.WithPreCheck(sm.TxPreCheck(state)),
 			mempoolv0.WithPostCheck(sm.TxPostCheck(state)),
 		)
 
 		mp.SetLogger(logger)
 
+		peerMetrics := mempl.NopPeerMetrics()
+		if config.Instrumentation.Prometheus {
+			peerMetrics = mempl.PrometheusPeerMetrics(config.Instrumentation.Namespace, "chain_id", state.ChainID)
+		}
 		reactor := mempoolv0.NewReactor(
 			config.Mempool,
 			mp,
+			mempoolv0.WithPeerMetrics(peerMetrics),
 		)
 		if config.Consensus.WaitForTxs() {
 			mp.EnableTxsAvailable()
@@ -516,6 +546,7 @@ func createConsensusReactor(config *cfg.Config,
 }
 
 func createVotePoolReactor(config *cfg.Config,
+	dbProvider DBProvider,
 	stateDB dbm.DB,
 	eventBus *types.EventBus,
 	logger log.Logger,
@@ -526,6 +557,11 @@ func createVotePoolReactor(config *cfg.Config,
 	if err != nil {
 		return nil, nil, err
 	}
+
+	metrics := votepool.NopMetrics()
+	if config.Instrumentation.Prometheus {
+		metrics = votepool.PrometheusMetrics(config.Instrumentation.Namespace, "chain_id", state.ChainID)
+	}
 	vals := make([]*types.Validator, 0)
 	if state.Validators != nil {
 		for _, val := range state.Validators.Validators {
@@ -533,29 +569,79 @@ func createVotePoolReactor(config *cfg.Config,
 		}
 	}
 
+	options := []votepool.PoolOption{votepool.WithMetrics(metrics)}
+	if len(config.VotePool.EventTypes) > 0 {
+		params := make([]votepool.EventTypeParams, 0, len(config.VotePool.EventTypes))
+		for _, et := range config.VotePool.EventTypes {
+			params = append(params, votepool.EventTypeParams{
+				EventType: votepool.EventType(et.Type),
+				KeepAlive: et.KeepAlive,
+				MaxVotes:  et.MaxVotes,
+			})
+		}
+		eventTypes, err := votepool.NewEventTypeRegistry(params...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid vote pool event types: %w", err)
+		}
+		options = append(options, votepool.WithEventTypes(eventTypes))
+	}
+	if config.VotePool.Persistent {
+		votePoolDB, err := dbProvider(&DBContext{"votepool", config})
+		if err != nil {
+			return nil, nil, err
+		}
+		options = append(options, votepool.WithDB(votePoolDB))
+	}
+
 	votePoolLogger := logger.With("module", "votepool")
-	votePool := votepool.NewVotePool(logger, vals, eventBus)
-	votePoolReactor := votepool.NewReactor(votePool, eventBus)
+	votePool := votepool.NewVotePool(logger, vals, eventBus, options...)
+	votePoolReactor := votepool.NewReactor(votePool, eventBus, votepool.ReactorMetrics(metrics))
 	votePoolReactor.SetLogger(votePoolLogger)
 	return votePoolReactor, votePool, nil
 }
 
+// p2pTransport is the transport of the node, a MultiplexTransport or a
+// QUICTransport.
+type p2pTransport interface {
+	p2p.Transport
+	Listen(p2p.NetAddress) error
+	Close() error
+	AddChannel(chID byte)
+	AddCompressedChannel(chID byte)
+}
+
 func createTransport(
 	config *cfg.Config,
 	nodeInfo p2p.NodeInfo,
 	nodeKey *p2p.NodeKey,
 	proxyApp proxy.AppConns,
+	banList *p2p.BanList,
 ) (
-	*p2p.MultiplexTransport,
+	p2pTransport,
 	[]p2p.PeerFilterFunc,
+	error,
 ) {
 	var (
 		mConnConfig = p2p.MConnConfig(config.P2P)
-		transport   = p2p.NewMultiplexTransport(nodeInfo, *nodeKey, mConnConfig)
+		transport   p2pTransport
+		mt          *p2p.MultiplexTransport
 		connFilters = []p2p.ConnFilterFunc{}
 		peerFilters = []p2p.PeerFilterFunc{}
 	)
 
+	// The QUIC transport extends the MultiplexTransport, which the options
+	// are applied to.
+	if config.P2P.Transport == cfg.TransportQUIC {
+		qt, err := p2p.NewQUICTransport(nodeInfo, *nodeKey, mConnConfig)
+		if err != nil {
+			return nil, nil, err
+		}
+		transport, mt = qt, qt.MultiplexTransport
+	} else {
+		mt = p2p.NewMultiplexTransport(nodeInfo, *nodeKey, mConnConfig)
+		transport = mt
+	}
+
 	if !config.P2P.AllowDuplicateIP {
 		connFilters = append(connFilters, p2p.ConnDuplicateIPFilter())
 	}
@@ -600,13 +686,18 @@ func createTransport(
 		)
 	}
 
-	p2p.MultiplexTransportConnFilters(connFilters...)(transport)
+	p2p.MultiplexTransportConnFilters(connFilters...)(mt)
+	p2p.MultiplexTransportBanList(banList)(mt)
 
 	// Limit the number of incoming connections.
 	max := config.P2P.MaxNumInboundPeers + len(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
-	p2p.MultiplexTransportMaxIncomingConnections(max)(transport)
+	if config.P2P.Topology == cfg.TopologySentry {
+		// the validators behind a sentry are unconditional peers
+		max += len(splitAndTrimEmpty(config.P2P.PrivatePeerIDs, ",", " "))
+	}
+	p2p.MultiplexTransportMaxIncomingConnections(max)(mt)
 
-	return transport, peerFilters
+	return transport, peerFilters, nil
 }
 
 func createSwitch(config *cfg.Config,
@@ -619,16 +710,21 @@ func createSwitch(config *cfg.Config,
 	consensusReactor *cs.Reactor,
 	evidenceReactor *evidence.Reactor,
 	votePoolReactor *votepool.Reactor,
+	trustMetricStore *trust.MetricStore,
+	banList *p2p.BanList,
 	nodeInfo p2p.NodeInfo,
 	nodeKey *p2p.NodeKey,
 	p2pLogger log.Logger,
 ) *p2p.Switch {
-	sw := p2p.NewSwitch(
-		config.P2P,
-		transport,
+	options := []p2p.SwitchOption{
 		p2p.WithMetrics(p2pMetrics),
 		p2p.SwitchPeerFilters(peerFilters...),
-	)
+		p2p.WithBanList(banList),
+	}
+	if trustMetricStore != nil {
+		options = append(options, p2p.WithTrustMetricStore(trustMetricStore))
+	}
+	sw := p2p.NewSwitch(config.P2P, transport, options...)
 	sw.SetLogger(p2pLogger)
 	sw.AddReactor("MEMPOOL", mempoolReactor)
 	sw.AddReactor("BLOCKCHAIN", bcReactor)
@@ -823,7 +919,7 @@ func NewNode(config *cfg.Config,
 	// and replays any blocks as necessary to sync CometBFT with the app.
 	consensusLogger := logger.With("module", "consensus")
 	if !stateSync {
-		if err := doHandshake(stateStore, state, blockStore, genDoc, eventBus, proxyApp, config.SkipAppHash, consensusLogger); err != nil {
+		if err := doHandshake(stateStore, state, blockStore, genDoc, eventBus, proxyApp, config.SkipAppHash, config.Prefetch, consensusLogger); err != nil {
 			return nil, err
 		}
 
@@ -843,7 +939,7 @@ func NewNode(config *cfg.Config,
 	logNodeStartupInfo(state, pubKey, logger, consensusLogger)
 
 	// Make MempoolReactor
-	mempool, mempoolTxChecker, mempoolReactor := createMempoolAndMempoolReactor(config, proxyApp, state, memplMetrics, logger)
+	mempool, mempoolTxChecker, mempoolReactor := createMempoolAndMempoolReactor(config, proxyApp, state, eventBus, memplMetrics, logger)
 
 	// Make Evidence Reactor
 	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateDB, blockStore, logger)
@@ -860,6 +956,7 @@ func NewNode(config *cfg.Config,
 		mempool,
 		evidencePool,
 		sm.BlockExecutorWithMetrics(smMetrics),
+		sm.BlockExecutorWithPrefetchConfig(config.Prefetch),
 	)
 
 	// Make BlockchainReactor. Don't start block sync if we're doing a state sync first.
@@ -893,24 +990,54 @@ func NewNode(config *cfg.Config,
 	stateSyncReactor.SetLogger(logger.With("module", "statesync"))
 
 	// Make vote pool reactor
-	votePoolReactor, votePool, err := createVotePoolReactor(config, stateDB, eventBus, logger)
+	votePoolReactor, votePool, err := createVotePoolReactor(config, dbProvider, stateDB, eventBus, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeInfo, err := makeNodeInfo(config, nodeKey, txIndexer, genDoc, state, compressedChannels(
+		mempoolReactor, bcReactor, stateSyncReactor, consensusReactor, evidenceReactor, votePoolReactor,
+	))
 	if err != nil {
 		return nil, err
 	}
 
-	nodeInfo, err := makeNodeInfo(config, nodeKey, txIndexer, genDoc, state)
+	// Setup the list of banned peers.
+	banListDB, err := dbProvider(&DBContext{"banlist", config})
 	if err != nil {
 		return nil, err
 	}
+	banList, err := p2p.NewBanList(banListDB)
+	if err != nil {
+		return nil, fmt.Errorf("could not load ban list: %w", err)
+	}
 
 	// Setup Transport.
-	transport, peerFilters := createTransport(config, nodeInfo, nodeKey, proxyApp)
+	transport, peerFilters, err := createTransport(config, nodeInfo, nodeKey, proxyApp, banList)
+	if err != nil {
+		return nil, fmt.Errorf("could not create transport: %w", err)
+	}
+
+	// Setup the trust metrics of the peers, if enabled.
+	var (
+		trustHistoryDB   dbm.DB
+		trustMetricStore *trust.MetricStore
+	)
+	if config.P2P.TrustMetric {
+		trustHistoryDB, err = dbProvider(&DBContext{"trusthistory", config})
+		if err != nil {
+			return nil, err
+		}
+		trustMetricStore = trust.NewTrustMetricStore(trustHistoryDB, trust.DefaultConfig())
+		trustMetricStore.SetLogger(logger.With("module", "trust"))
+	}
 
 	// Setup Switch.
 	p2pLogger := logger.With("module", "p2p")
 	sw := createSwitch(
 		config, transport, p2pMetrics, peerFilters, mempoolReactor, bcReactor,
-		stateSyncReactor, consensusReactor, evidenceReactor, votePoolReactor, nodeInfo, nodeKey, p2pLogger,
+		stateSyncReactor, consensusReactor, evidenceReactor, votePoolReactor, trustMetricStore, banList,
+		nodeInfo, nodeKey, p2pLogger,
 	)
 
 	err = sw.AddPersistentPeers(splitAndTrimEmpty(config.P2P.PersistentPeers, ",", " "))
@@ -928,6 +1055,12 @@ func NewNode(config *cfg.Config,
 		return nil, fmt.Errorf("could not create addrbook: %w", err)
 	}
 
+	// Add private IDs to addrbook to block those peers being added
+	err = sw.AddPrivatePeerIDs(splitAndTrimEmpty(config.P2P.PrivatePeerIDs, ",", " "))
+	if err != nil {
+		return nil, fmt.Errorf("could not add peer ids from private_peer_ids field: %w", err)
+	}
+
 	// Optionally, start the pex reactor
 	//
 	// TODO:
@@ -941,8 +1074,10 @@ func NewNode(config *cfg.Config,
 	// If PEX is on, it should handle dialing the seeds. Otherwise the switch does it.
 	// Note we currently use the addrBook regardless at least for AddOurAddress
 	var pexReactor *pex.Reactor
-	if config.P2P.PexReactor {
+	if config.P2P.PexEnabled() {
 		pexReactor = createPEXReactorAndAddToSwitch(addrBook, config, sw, logger)
+	} else if config.P2P.PexReactor {
+		p2pLogger.Info("Peer exchange is disabled for a validator behind sentries")
 	}
 
 	if config.RPC.PprofListenAddress != "" {
@@ -966,6 +1101,8 @@ func NewNode(config *cfg.Config,
 
 		stateStore:       stateStore,
 		blockStore:       blockStore,
+		banListDB:        banListDB,
+		trustHistoryDB:   trustHistoryDB,
 		bcReactor:        bcReactor,
 		mempoolReactor:   mempoolReactor,
 		mempool:          mempool,
@@ -1003,8 +1140,13 @@ func (n *Node) OnStart() error {
 		time.Sleep(genTime.Sub(now))
 	}
 
-	// Add private IDs to addrbook to block those peers being added
-	n.addrBook.AddPrivateIDs(splitAndTrimEmpty(n.config.P2P.PrivatePeerIDs, ",", " "))
+	// Replay the mempool WAL before the RPC server and the mempool reactor
+	// accept txs, so that all of them are written to it.
+	if n.config.Mempool.WalEnabled() {
+		if err := n.mempool.InitWAL(); err != nil {
+			return fmt.Errorf("init mempool WAL: %w", err)
+		}
+	}
 
 	// Start the RPC server before the P2P server
 	// so we can eg. receive txs for the first block
@@ -1078,6 +1220,20 @@ func (n *Node) OnStop() {
 	if err := n.sw.Stop(); err != nil {
 		n.Logger.Error("Error closing switch", "err", err)
 	}
+	if n.banListDB != nil {
+		if err := n.banListDB.Close(); err != nil {
+			n.Logger.Error("problem closing ban list db", "err", err)
+		}
+	}
+	if n.trustHistoryDB != nil {
+		if err := n.trustHistoryDB.Close(); err != nil {
+			n.Logger.Error("problem closing trust history db", "err", err)
+		}
+	}
+
+	if n.config.Mempool.WalEnabled() {
+		n.mempool.CloseWAL()
+	}
 
 	if err := n.transport.Close(); err != nil {
 		n.Logger.Error("Error closing transport", "err", err)
@@ -1135,6 +1291,7 @@ func (n *Node) ConfigureRPC() error {
 		ConsensusState: n.consensusState,
 		P2PPeers:       n.sw,
 		P2PTransport:   n,
+		P2PAddrBook:    n.addrBook,
 
 		PubKey:           pubKey,
 		GenDoc:           n.genesisDoc,
@@ -1269,6 +1426,35 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 
 	}
 
+	// we expose the Ethereum JSON-RPC API, so that Ethereum wallets can connect to the node directly
+	ethListenAddr := n.config.RPC.EthListenAddress
+	if ethListenAddr != "" {
+		config := rpcserver.DefaultConfig()
+		config.MaxBodyBytes = n.config.RPC.MaxBodyBytes
+		config.MaxHeaderBytes = n.config.RPC.MaxHeaderBytes
+		config.MaxOpenConnections = n.config.RPC.MaxOpenConnections
+		listener, err := rpcserver.Listen(ethListenAddr, config)
+		if err != nil {
+			return nil, err
+		}
+		ethLogger := n.Logger.With("module", "eth-rpc-server")
+		rootHandler := coreeth.NewHandler(ethLogger)
+		if n.config.RPC.IsCorsEnabled() {
+			corsMiddleware := cors.New(cors.Options{
+				AllowedOrigins: n.config.RPC.CORSAllowedOrigins,
+				AllowedMethods: n.config.RPC.CORSAllowedMethods,
+				AllowedHeaders: n.config.RPC.CORSAllowedHeaders,
+			})
+			rootHandler = corsMiddleware.Handler(rootHandler)
+		}
+		go func() {
+			if err := rpcserver.Serve(listener, rootHandler, ethLogger, config); err != nil {
+				n.Logger.Error("Error serving Ethereum JSON-RPC server", "err", err)
+			}
+		}()
+		listeners = append(listeners, listener)
+	}
+
 	return listeners, nil
 }
 
@@ -1377,12 +1563,27 @@ func (n *Node) NodeInfo() p2p.NodeInfo {
 	return n.nodeInfo
 }
 
+// compressedChannels returns the IDs of the channels of the reactors whose
+// messages can be compressed.
+func compressedChannels(reactors ...p2p.Reactor) []byte {
+	var chIDs []byte
+	for _, reactor := range reactors {
+		for _, chDesc := range reactor.GetChannels() {
+			if chDesc.Compress {
+				chIDs = append(chIDs, chDesc.ID)
+			}
+		}
+	}
+	return chIDs
+}
+
 func makeNodeInfo(
 	config *cfg.Config,
 	nodeKey *p2p.NodeKey,
 	txIndexer txindex.TxIndexer,
 	genDoc *types.GenesisDoc,
 	state sm.State,
+	compressedChannels []byte,
 ) (p2p.DefaultNodeInfo, error) {
 	txIndexerStatus := "on"
 	if _, ok := txIndexer.(*null.TxIndex); ok {
@@ -1404,7 +1605,7 @@ func makeNodeInfo(
 			mempl.MempoolChannel,
 			evidence.EvidenceChannel,
 			statesync.SnapshotChannel, statesync.ChunkChannel,
-			votepool.VotePoolChannel,
+			votepool.VotePoolChannel, votepool.VoteSummaryChannel,
 		},
 		Moniker: config.Moniker,
 		Other: p2p.DefaultNodeInfoOther{
@@ -1413,10 +1614,18 @@ func makeNodeInfo(
 		},
 	}
 
-	if config.P2P.PexReactor {
+	if config.P2P.PexEnabled() {
 		nodeInfo.Channels = append(nodeInfo.Channels, pex.PexChannel)
 	}
 
+	if config.P2P.Compression {
+		nodeInfo.CompressedChannels = compressedChannels
+	}
+
+	if config.P2P.Transport == cfg.TransportQUIC {
+		nodeInfo.Transports = []string{cfg.TransportTCP, cfg.TransportQUIC}
+	}
+
 	lAddr := config.P2P.ExternalAddress
 
 	if lAddr == "" {
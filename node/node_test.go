@@ -17,6 +17,7 @@ import (
 	dbm "github.com/cometbft/cometbft-db"
 
 	"github.com/cometbft/cometbft/abci/example/kvstore"
+	bc "github.com/cometbft/cometbft/blocksync"
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/evidence"
@@ -28,6 +29,7 @@ import (
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/p2p/conn"
 	p2pmock "github.com/cometbft/cometbft/p2p/mock"
+	"github.com/cometbft/cometbft/p2p/pex"
 	"github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
 	sm "github.com/cometbft/cometbft/state"
@@ -79,6 +81,25 @@ func TestNodeStartStop(t *testing.T) {
 	}
 }
 
+func TestNodeStopClosesP2PDBs(t *testing.T) {
+	config := cfg.ResetTestRoot("node_p2p_dbs_test")
+	defer os.RemoveAll(config.RootDir)
+	config.DBBackend = string(dbm.GoLevelDBBackend)
+	config.P2P.TrustMetric = true
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+	require.NoError(t, n.Stop())
+
+	// the dbs can only be opened again once they're closed
+	for _, name := range []string{"banlist", "trusthistory"} {
+		db, err := dbm.NewDB(name, dbm.GoLevelDBBackend, config.DBDir())
+		require.NoError(t, err, name)
+		require.NoError(t, db.Close())
+	}
+}
+
 func TestSplitAndTrimEmpty(t *testing.T) {
 	testCases := []struct {
 		s        string
@@ -136,6 +157,53 @@ func TestNodeSetAppVersion(t *testing.T) {
 	assert.Equal(t, n.nodeInfo.(p2p.DefaultNodeInfo).ProtocolVersion.App, appVersion)
 }
 
+func TestNodeCompressedChannels(t *testing.T) {
+	config := cfg.ResetTestRoot("node_compressed_channels_test")
+	defer os.RemoveAll(config.RootDir)
+
+	// compression is disabled by default
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	assert.Empty(t, n.nodeInfo.(p2p.DefaultNodeInfo).CompressedChannels)
+
+	config.P2P.Compression = true
+	n, err = DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	nodeInfo := n.nodeInfo.(p2p.DefaultNodeInfo)
+	assert.True(t, nodeInfo.HasCompressedChannel(bc.BlocksyncChannel))
+	assert.True(t, nodeInfo.HasCompressedChannel(mempl.MempoolChannel))
+	assert.False(t, nodeInfo.HasCompressedChannel(evidence.EvidenceChannel))
+}
+
+func TestNodeTopologyValidatorBehindSentries(t *testing.T) {
+	config := cfg.ResetTestRoot("node_topology_test")
+	defer os.RemoveAll(config.RootDir)
+
+	config.P2P.Topology = cfg.TopologyValidatorBehindSentries
+	config.P2P.PersistentPeers = "6dc7cfdfa18b077c01af3969b8c7cf94737c232c@127.0.0.1:26656"
+	require.True(t, config.P2P.PexReactor)
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+
+	// the peer exchange is disabled, and the sentries are unconditional
+	assert.Nil(t, n.pexReactor)
+	assert.Nil(t, n.Switch().Reactor("PEX"))
+	assert.NotContains(t, []byte(n.nodeInfo.(p2p.DefaultNodeInfo).Channels), pex.PexChannel)
+	assert.True(t, n.Switch().IsPeerUnconditional("6dc7cfdfa18b077c01af3969b8c7cf94737c232c"))
+}
+
+func TestNodeQUICTransport(t *testing.T) {
+	config := cfg.ResetTestRoot("node_quic_test")
+	defer os.RemoveAll(config.RootDir)
+
+	config.P2P.Transport = cfg.TransportQUIC
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+
+	assert.IsType(t, &p2p.QUICTransport{}, n.transport)
+	assert.True(t, n.nodeInfo.(p2p.DefaultNodeInfo).SupportsTransport(cfg.TransportQUIC))
+}
+
 func TestNodeSetPrivValTCP(t *testing.T) {
 	addr := "tcp://" + testFreeAddr(t)
 
@@ -0,0 +1,270 @@
+package p2p
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+var banKeyPrefix = []byte("ban:")
+
+// Ban bans a node ID, an IP or an IP range in CIDR notation until a given
+// time.
+type Ban struct {
+	Target string    `json:"target"`
+	Until  time.Time `json:"until"` // zero if the ban never expires
+	Reason string    `json:"reason"`
+
+	id    ID
+	ipNet *net.IPNet
+}
+
+// newBan parses the target of the ban, and normalizes it.
+func newBan(target string, until time.Time, reason string) (Ban, error) {
+	ban := Ban{Until: until, Reason: reason}
+	target = strings.TrimSpace(target)
+	switch {
+	case strings.Contains(target, "/"):
+		_, ipNet, err := net.ParseCIDR(target)
+		if err != nil {
+			return Ban{}, fmt.Errorf("invalid ban target %q: %w", target, err)
+		}
+		ban.ipNet = ipNet
+		ban.Target = ipNet.String()
+	case net.ParseIP(target) != nil:
+		ip := net.ParseIP(target)
+		bits := 8 * net.IPv6len
+		if ip.To4() != nil {
+			ip, bits = ip.To4(), 8*net.IPv4len
+		}
+		ban.ipNet = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+		ban.Target = ip.String()
+	default:
+		id := ID(strings.ToLower(target))
+		if err := validateID(id); err != nil {
+			return Ban{}, fmt.Errorf("invalid ban target %q: neither an IP, a CIDR nor a node ID: %w", target, err)
+		}
+		ban.id = id
+		ban.Target = string(id)
+	}
+	return ban, nil
+}
+
+// Expired returns true if the ban ended at the given time.
+func (ban Ban) Expired(now time.Time) bool {
+	return !ban.Until.IsZero() && !now.Before(ban.Until)
+}
+
+// Matches returns true if the ban applies to the node with the given ID or
+// IP. Either of them can be empty.
+func (ban Ban) Matches(id ID, ip net.IP) bool {
+	if ban.id != "" {
+		return ban.id == id
+	}
+	return ip != nil && ban.ipNet.Contains(ip)
+}
+
+// BanList is a list of bans of node IDs and IP ranges, persisted in a DB.
+// Expired bans are ignored, and dropped when a ban is added. It is safe for
+// concurrent use.
+type BanList struct {
+	mtx  cmtsync.RWMutex
+	db   dbm.DB
+	bans map[string]Ban // target -> ban
+}
+
+// NewBanList returns a ban list persisted in the given DB, loaded with the
+// bans in it.
+func NewBanList(db dbm.DB) (*BanList, error) {
+	bl := &BanList{
+		db:   db,
+		bans: make(map[string]Ban),
+	}
+
+	iter, err := dbm.IteratePrefix(db, banKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	now := time.Now()
+	for ; iter.Valid(); iter.Next() {
+		var stored Ban
+		if err := json.Unmarshal(iter.Value(), &stored); err != nil {
+			return nil, fmt.Errorf("unmarshaling ban %s: %w", iter.Key(), err)
+		}
+		ban, err := newBan(stored.Target, stored.Until, stored.Reason)
+		if err != nil {
+			return nil, err
+		}
+		if !ban.Expired(now) {
+			bl.bans[ban.Target] = ban
+		}
+	}
+	return bl, iter.Error()
+}
+
+// Add bans the target, a node ID, an IP or an IP range in CIDR notation, until
+// the given time, or forever if zero. A previous ban of the target is
+// replaced.
+func (bl *BanList) Add(target string, until time.Time, reason string) (Ban, error) {
+	ban, err := newBan(target, until, reason)
+	if err != nil {
+		return Ban{}, err
+	}
+	bz, err := json.Marshal(ban)
+	if err != nil {
+		return Ban{}, err
+	}
+
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+	if err := bl.prune(); err != nil {
+		return Ban{}, err
+	}
+	if err := bl.db.SetSync(banKey(ban.Target), bz); err != nil {
+		return Ban{}, err
+	}
+	bl.bans[ban.Target] = ban
+	return ban, nil
+}
+
+// Remove lifts the ban of the target. It returns false if the target isn't
+// banned.
+func (bl *BanList) Remove(target string) (bool, error) {
+	ban, err := newBan(target, time.Time{}, "")
+	if err != nil {
+		return false, err
+	}
+
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+	prev, ok := bl.bans[ban.Target]
+	if !ok {
+		return false, nil
+	}
+	if err := bl.db.DeleteSync(banKey(ban.Target)); err != nil {
+		return false, err
+	}
+	delete(bl.bans, ban.Target)
+	return !prev.Expired(time.Now()), nil
+}
+
+// List returns the bans which didn't expire, sorted by target.
+func (bl *BanList) List() []Ban {
+	bl.mtx.RLock()
+	defer bl.mtx.RUnlock()
+
+	now := time.Now()
+	bans := make([]Ban, 0, len(bl.bans))
+	for _, ban := range bl.bans {
+		if !ban.Expired(now) {
+			bans = append(bans, ban)
+		}
+	}
+	sort.Slice(bans, func(i, j int) bool { return bans[i].Target < bans[j].Target })
+	return bans
+}
+
+// Banned returns the ban applying to the node with the given ID or IP, with
+// the latest end, and false if none. Either of them can be empty.
+func (bl *BanList) Banned(id ID, ip net.IP) (Ban, bool) {
+	bl.mtx.RLock()
+	defer bl.mtx.RUnlock()
+
+	var (
+		now    = time.Now()
+		found  Ban
+		banned bool
+	)
+	for _, ban := range bl.bans {
+		if ban.Expired(now) || !ban.Matches(id, ip) {
+			continue
+		}
+		if !banned || ban.Until.IsZero() || (!found.Until.IsZero() && ban.Until.After(found.Until)) {
+			found, banned = ban, true
+		}
+	}
+	return found, banned
+}
+
+// prune drops the expired bans. It assumes the mutex is held.
+func (bl *BanList) prune() error {
+	now := time.Now()
+	for target, ban := range bl.bans {
+		if !ban.Expired(now) {
+			continue
+		}
+		if err := bl.db.Delete(banKey(target)); err != nil {
+			return err
+		}
+		delete(bl.bans, target)
+	}
+	return nil
+}
+
+func banKey(target string) []byte {
+	return append(append([]byte{}, banKeyPrefix...), target...)
+}
+
+//-----------------------------------------------------------------------------
+
+// BanPeer bans the target, a node ID, an IP or an IP range in CIDR notation,
+// for the given duration, or forever if zero. The connected peers matching the
+// ban are stopped right away.
+func (sw *Switch) BanPeer(target string, duration time.Duration, reason string) (Ban, error) {
+	if duration < 0 {
+		return Ban{}, errors.New("negative ban duration")
+	}
+	var until time.Time
+	if duration > 0 {
+		until = time.Now().Add(duration)
+	}
+	ban, err := sw.banList.Add(target, until, reason)
+	if err != nil {
+		return Ban{}, err
+	}
+	sw.Logger.Info("Banned peers", "target", ban.Target, "until", ban.Until, "reason", reason)
+
+	for _, peer := range sw.peers.List() {
+		if ban.Matches(peer.ID(), peerIP(peer)) {
+			sw.stopAndRemovePeer(peer, ErrSwitchBannedPeer{ID: peer.ID(), Until: ban.Until})
+		}
+	}
+	return ban, nil
+}
+
+// UnbanPeer lifts the ban of the target, a node ID, an IP or an IP range in
+// CIDR notation. It returns false if the target isn't banned.
+func (sw *Switch) UnbanPeer(target string) (bool, error) {
+	return sw.banList.Remove(target)
+}
+
+// Bans returns the bans in effect, including the bans of the peers with a low
+// trust score.
+func (sw *Switch) Bans() []Ban {
+	return sw.banList.List()
+}
+
+// peerBannedUntil returns the time the ban of the peer with the given ID or IP
+// ends, zero if never, and false if the peer isn't banned. The IP can be nil.
+func (sw *Switch) peerBannedUntil(id ID, ip net.IP) (time.Time, bool) {
+	ban, banned := sw.banList.Banned(id, ip)
+	return ban.Until, banned
+}
+
+// peerIP returns the IP of the socket address of the peer, or nil if unknown.
+func peerIP(p Peer) net.IP {
+	if addr := p.SocketAddr(); addr != nil {
+		return addr.IP
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package p2p
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+)
+
+func TestBanList(t *testing.T) {
+	db := dbm.NewMemDB()
+	bl, err := NewBanList(db)
+	require.NoError(t, err)
+
+	id := PubKeyToID(ed25519.GenPrivKey().PubKey())
+	hour := time.Now().Add(time.Hour)
+
+	// invalid targets
+	for _, target := range []string{"", "nope", "1.2.3.4/33", "abcd"} {
+		_, err := bl.Add(target, hour, "")
+		assert.Error(t, err, target)
+	}
+
+	ban, err := bl.Add(string(id), hour, "spam")
+	require.NoError(t, err)
+	assert.Equal(t, string(id), ban.Target)
+	_, err = bl.Add("10.0.1.7/16", time.Time{}, "")
+	require.NoError(t, err)
+	_, err = bl.Add("192.168.0.1", hour, "")
+	require.NoError(t, err)
+	_, err = bl.Add("::1", time.Now().Add(-time.Second), "expired")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		id     ID
+		ip     string
+		banned bool
+	}{
+		{id, "", true},
+		{"", "10.0.255.255", true},
+		{"", "10.1.0.1", false},
+		{"", "192.168.0.1", true},
+		{"", "192.168.0.2", false},
+		{"", "::1", false},
+		{PubKeyToID(ed25519.GenPrivKey().PubKey()), "127.0.0.1", false},
+	}
+	for _, tc := range testCases {
+		_, banned := bl.Banned(tc.id, net.ParseIP(tc.ip))
+		assert.Equal(t, tc.banned, banned, "%v %v", tc.id, tc.ip)
+	}
+
+	// the targets are normalized, and the expired bans aren't listed
+	targets := func(bans []Ban) []string {
+		ts := make([]string, 0, len(bans))
+		for _, ban := range bans {
+			ts = append(ts, ban.Target)
+		}
+		return ts
+	}
+	expected := []string{"10.0.0.0/16", "192.168.0.1", string(id)}
+	assert.ElementsMatch(t, expected, targets(bl.List()))
+
+	// the bans are persisted
+	bl2, err := NewBanList(db)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, expected, targets(bl2.List()))
+	ban, ok := bl2.Banned(id, nil)
+	require.True(t, ok)
+	assert.Equal(t, "spam", ban.Reason)
+	assert.True(t, hour.Equal(ban.Until))
+
+	// a ban is lifted
+	ok, err = bl2.Remove("10.0.0.0/16")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = bl2.Remove("10.0.0.0/16")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	_, banned := bl2.Banned("", net.ParseIP("10.0.0.1"))
+	assert.False(t, banned)
+
+	bl3, err := NewBanList(db)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"192.168.0.1", string(id)}, targets(bl3.List()))
+}
+
+func TestSwitchBanPeer(t *testing.T) {
+	sw1, sw2 := MakeSwitchPair(t, initSwitchFunc)
+	t.Cleanup(func() {
+		for _, sw := range []*Switch{sw1, sw2} {
+			if err := sw.Stop(); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+	require.Equal(t, 1, sw1.Peers().Size())
+
+	_, err := sw1.BanPeer(string(sw2.NodeInfo().ID()), -time.Second, "")
+	require.Error(t, err)
+
+	// the connected peer is dropped right away, and can't be dialed
+	ban, err := sw1.BanPeer(string(sw2.NodeInfo().ID()), time.Hour, "test")
+	require.NoError(t, err)
+	assert.Equal(t, 0, sw1.Peers().Size())
+	assert.Equal(t, []Ban{ban}, sw1.Bans())
+	err = sw1.DialPeerWithAddress(sw2.NetAddress())
+	require.Error(t, err)
+	assert.IsType(t, ErrSwitchBannedPeer{}, err)
+
+	ok, err := sw1.UnbanPeer(string(sw2.NodeInfo().ID()))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Empty(t, sw1.Bans())
+	assert.False(t, sw1.IsPeerBanned(sw2.NodeInfo().ID()))
+}
+
+func TestTransportMultiplexBanList(t *testing.T) {
+	bl, err := NewBanList(dbm.NewMemDB())
+	require.NoError(t, err)
+	_, err = bl.Add("127.0.0.0/8", time.Time{}, "")
+	require.NoError(t, err)
+
+	mt := testSetupMultiplexTransport(t)
+	MultiplexTransportBanList(bl)(mt)
+
+	errc := make(chan error)
+	go func() {
+		addr := NewNetAddress(mt.nodeKey.ID(), mt.listener.Addr())
+		_, err := addr.Dial()
+		errc <- err
+	}()
+	require.NoError(t, <-errc)
+
+	_, err = mt.Accept(peerConfig{})
+	require.Error(t, err)
+	e, ok := err.(ErrRejected)
+	require.True(t, ok, "expected ErrRejected, got %v", err)
+	assert.True(t, e.IsFiltered())
+}
@@ -0,0 +1,62 @@
+package conn
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang/snappy"
+)
+
+// Each message of a channel with compression is prefixed with a byte telling
+// whether the rest of the message is compressed with snappy or not. Messages
+// which don't shrink when compressed, like the ones mostly made of hashes and
+// signatures, are sent as is.
+const (
+	msgUncompressed byte = 0x00
+	msgSnappy       byte = 0x01
+)
+
+// compressMsg compresses the message with snappy, or just prefixes it if the
+// compressed message isn't smaller.
+func compressMsg(msgBytes []byte) []byte {
+	bz := make([]byte, 1+snappy.MaxEncodedLen(len(msgBytes)))
+	encoded := snappy.Encode(bz[1:], msgBytes)
+	if len(encoded) >= len(msgBytes) {
+		bz = bz[:1+len(msgBytes)]
+		bz[0] = msgUncompressed
+		copy(bz[1:], msgBytes)
+		return bz
+	}
+	bz[0] = msgSnappy
+	return bz[:1+len(encoded)]
+}
+
+// decompressMsg reverses compressMsg. It returns an error if the decompressed
+// message would be bigger than maxSize, without decompressing it.
+func decompressMsg(bz []byte, maxSize int) ([]byte, error) {
+	if len(bz) == 0 {
+		return nil, errors.New("empty compressed message")
+	}
+	switch bz[0] {
+	case msgUncompressed:
+		if len(bz)-1 > maxSize {
+			return nil, fmt.Errorf("received message exceeds available capacity: %v < %v", maxSize, len(bz)-1)
+		}
+		return bz[1:], nil
+	case msgSnappy:
+		n, err := snappy.DecodedLen(bz[1:])
+		if err != nil {
+			return nil, fmt.Errorf("decoding compressed message: %w", err)
+		}
+		if n > maxSize {
+			return nil, fmt.Errorf("decompressed message exceeds available capacity: %v < %v", maxSize, n)
+		}
+		msgBytes, err := snappy.Decode(nil, bz[1:])
+		if err != nil {
+			return nil, fmt.Errorf("decoding compressed message: %w", err)
+		}
+		return msgBytes, nil
+	default:
+		return nil, fmt.Errorf("unknown compression %X", bz[0])
+	}
+}
@@ -0,0 +1,58 @@
+package conn
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
+)
+
+func TestCompressMsg(t *testing.T) {
+	testCases := []struct {
+		name       string
+		msg        []byte
+		compressed bool
+	}{
+		{"empty", []byte{}, false},
+		{"compressible", bytes.Repeat([]byte("cometbft"), 1000), true},
+		{"incompressible", cmtrand.Bytes(1000), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			bz := compressMsg(tc.msg)
+			if tc.compressed {
+				assert.Equal(t, msgSnappy, bz[0])
+				assert.Less(t, len(bz), len(tc.msg))
+			} else {
+				assert.Equal(t, msgUncompressed, bz[0])
+				assert.Len(t, bz, len(tc.msg)+1)
+			}
+
+			msg, err := decompressMsg(bz, len(tc.msg))
+			require.NoError(t, err)
+			assert.Equal(t, tc.msg, msg)
+
+			// the message must fit in the capacity once decompressed
+			if len(tc.msg) > 0 {
+				_, err = decompressMsg(bz, len(tc.msg)-1)
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDecompressMsgErrors(t *testing.T) {
+	_, err := decompressMsg(nil, 100)
+	assert.Error(t, err)
+
+	_, err = decompressMsg([]byte{0x02, 0x01}, 100)
+	assert.Error(t, err)
+
+	_, err = decompressMsg([]byte{msgSnappy, 0xff, 0xff}, 100)
+	assert.Error(t, err)
+}
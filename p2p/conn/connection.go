@@ -32,6 +32,10 @@ const (
 	minWriteBufferSize = 65536
 	updateStats        = 2 * time.Second
 
+	// retry sending on the throttled channels after the sampling period of
+	// their flow monitors
+	channelThrottleRetry = 100 * time.Millisecond
+
 	// some of these defaults are written in the user config
 	// flushThrottle, sendRate, recvRate
 	// TODO: remove values present in config
@@ -104,8 +108,9 @@ type MConnection struct {
 	// are safe to call concurrently.
 	stopMtx cmtsync.Mutex
 
-	flushTimer *timer.ThrottleTimer // flush writes as necessary but throttled.
-	pingTimer  *time.Ticker         // send pings periodically
+	flushTimer    *timer.ThrottleTimer // flush writes as necessary but throttled.
+	throttleTimer *timer.ThrottleTimer // retry sending on the channels over their send rate.
+	pingTimer     *time.Ticker         // send pings periodically
 
 	// close conn if pong is not received in pongTimeout
 	pongTimer     *time.Timer
@@ -113,6 +118,9 @@ type MConnection struct {
 
 	chStatsTimer *time.Ticker // update channel stats periodically
 
+	pingSent int64 // atomic, time the unanswered ping was sent, in unix nanoseconds.
+	rtt      int64 // atomic, round-trip time of the last ping, in nanoseconds.
+
 	created time.Time // time of creation
 
 	_maxPacketMsgSize int
@@ -134,6 +142,10 @@ type MConnConfig struct {
 
 	// Maximum wait time for pongs
 	PongTimeout time.Duration `mapstructure:"pong_timeout"`
+
+	// Rate at which packets can be sent on a given channel, in bytes/second.
+	// The channels not listed are only limited by SendRate.
+	ChannelSendRates map[byte]int64 `mapstructure:"channel_send_rates"`
 }
 
 // DefaultMConnConfig returns the default config.
@@ -222,6 +234,7 @@ func (c *MConnection) OnStart() error {
 		return err
 	}
 	c.flushTimer = timer.NewThrottleTimer("flush", c.config.FlushThrottle)
+	c.throttleTimer = timer.NewThrottleTimer("throttle", channelThrottleRetry)
 	c.pingTimer = time.NewTicker(c.config.PingInterval)
 	c.pongTimeoutCh = make(chan bool, 1)
 	c.chStatsTimer = time.NewTicker(updateStats)
@@ -256,6 +269,7 @@ func (c *MConnection) stopServices() (alreadyStopped bool) {
 
 	c.BaseService.OnStop()
 	c.flushTimer.Stop()
+	c.throttleTimer.Stop()
 	c.pingTimer.Stop()
 	c.chStatsTimer.Stop()
 
@@ -362,7 +376,7 @@ func (c *MConnection) Send(chID byte, msgBytes []byte) bool {
 		return false
 	}
 
-	success := channel.sendBytes(msgBytes)
+	success := channel.sendBytes(channel.compress(msgBytes))
 	if success {
 		// Wake up sendRoutine if necessary
 		select {
@@ -391,7 +405,7 @@ func (c *MConnection) TrySend(chID byte, msgBytes []byte) bool {
 		return false
 	}
 
-	ok = channel.trySendBytes(msgBytes)
+	ok = channel.trySendBytes(channel.compress(msgBytes))
 	if ok {
 		// Wake up sendRoutine if necessary
 		select {
@@ -438,6 +452,12 @@ FOR_LOOP:
 			for _, channel := range c.channels {
 				channel.updateStats()
 			}
+		case <-c.throttleTimer.Ch:
+			// Wake sendRoutine up to send on the throttled channels.
+			select {
+			case c.send <- struct{}{}:
+			default:
+			}
 		case <-c.pingTimer.C:
 			c.Logger.Debug("Send Ping")
 			_n, err = protoWriter.WriteMsg(mustWrapPacket(&tmp2p.PacketPing{}))
@@ -446,6 +466,7 @@ FOR_LOOP:
 				break SELECTION
 			}
 			c.sendMonitor.Update(_n)
+			atomic.StoreInt64(&c.pingSent, time.Now().UnixNano())
 			c.Logger.Debug("Starting pong timer", "dur", c.config.PongTimeout)
 			c.pongTimer = time.AfterFunc(c.config.PongTimeout, func() {
 				select {
@@ -522,11 +543,17 @@ func (c *MConnection) sendPacketMsg() bool {
 	// The chosen channel will be the one whose recentlySent/priority is the least.
 	var leastRatio float32 = math.MaxFloat32
 	var leastChannel *Channel
+	var throttled bool
 	for _, channel := range c.channels {
 		// If nothing to send, skip this channel
 		if !channel.isSendPending() {
 			continue
 		}
+		// If the channel is over its send rate, skip it for now
+		if channel.isThrottled() {
+			throttled = true
+			continue
+		}
 		// Get ratio, and keep track of lowest ratio.
 		ratio := float32(channel.recentlySent) / float32(channel.desc.Priority)
 		if ratio < leastRatio {
@@ -537,6 +564,9 @@ func (c *MConnection) sendPacketMsg() bool {
 
 	// Nothing to send?
 	if leastChannel == nil {
+		if throttled {
+			c.throttleTimer.Set()
+		}
 		return true
 	}
 	// c.Logger.Info("Found a msgPacket to send")
@@ -619,6 +649,9 @@ FOR_LOOP:
 			}
 		case *tmp2p.Packet_PacketPong:
 			c.Logger.Debug("Receive Pong")
+			if sent := atomic.SwapInt64(&c.pingSent, 0); sent > 0 {
+				atomic.StoreInt64(&c.rtt, time.Now().UnixNano()-sent)
+			}
 			select {
 			case c.pongTimeoutCh <- false:
 			default:
@@ -634,6 +667,7 @@ FOR_LOOP:
 				break FOR_LOOP
 			}
 
+			channel.recvMonitor.Update(_n)
 			msgBytes, err := channel.recvPacketMsg(*pkt.PacketMsg)
 			if err != nil {
 				if c.IsRunning() {
@@ -684,7 +718,10 @@ func (c *MConnection) maxPacketMsgSize() int {
 }
 
 type ConnectionStatus struct {
-	Duration    time.Duration
+	Duration time.Duration
+	// Round-trip time of the last ping, zero until a pong is received. Not
+	// measured over QUIC, which keeps the connection alive itself.
+	RTT         time.Duration
 	SendMonitor flow.Status
 	RecvMonitor flow.Status
 	Channels    []ChannelStatus
@@ -696,11 +733,25 @@ type ChannelStatus struct {
 	SendQueueSize     int
 	Priority          int
 	RecentlySent      int64
+
+	// Compression is enabled on the channel, and the total size of the
+	// compressed messages sent and received.
+	Compress                bool
+	CompressedBytesSent     int64
+	CompressedBytesReceived int64
+
+	// The rate at which packets can be sent on the channel, in bytes/second,
+	// zero if only the connection send rate applies, and the flows of the
+	// packets sent and received on the channel.
+	SendRate    int64
+	SendMonitor flow.Status
+	RecvMonitor flow.Status
 }
 
 func (c *MConnection) Status() ConnectionStatus {
 	var status ConnectionStatus
 	status.Duration = time.Since(c.created)
+	status.RTT = time.Duration(atomic.LoadInt64(&c.rtt))
 	status.SendMonitor = c.sendMonitor.Status()
 	status.RecvMonitor = c.recvMonitor.Status()
 	status.Channels = make([]ChannelStatus, len(c.channels))
@@ -711,6 +762,14 @@ func (c *MConnection) Status() ConnectionStatus {
 			SendQueueSize:     int(atomic.LoadInt32(&c.channels[i].sendQueueSize)),
 			Priority:          channel.desc.Priority,
 			RecentlySent:      atomic.LoadInt64(&c.channels[i].recentlySent),
+
+			Compress:                channel.desc.Compress,
+			CompressedBytesSent:     atomic.LoadInt64(&c.channels[i].compressedSent),
+			CompressedBytesReceived: atomic.LoadInt64(&c.channels[i].compressedRecv),
+
+			SendRate:    channel.sendRate,
+			SendMonitor: channel.sendMonitor.Status(),
+			RecvMonitor: channel.recvMonitor.Status(),
 		}
 	}
 	return status
@@ -725,6 +784,11 @@ type ChannelDescriptor struct {
 	RecvBufferCapacity  int
 	RecvMessageCapacity int
 	MessageType         proto.Message
+
+	// Compress the messages of the channel with snappy, if compression is
+	// enabled. With a given peer, the channel is only compressed if both nodes
+	// list it in the CompressedChannels of their NodeInfo.
+	Compress bool
 }
 
 func (chDesc ChannelDescriptor) FillDefaults() (filled ChannelDescriptor) {
@@ -752,6 +816,13 @@ type Channel struct {
 	sending       []byte
 	recentlySent  int64 // exponential moving average
 
+	compressedSent int64 // atomic.
+	compressedRecv int64 // atomic.
+
+	sendMonitor *flow.Monitor
+	recvMonitor *flow.Monitor
+	sendRate    int64 // zero if unlimited
+
 	maxPacketMsgPayloadSize int
 
 	Logger log.Logger
@@ -767,6 +838,9 @@ func newChannel(conn *MConnection, desc ChannelDescriptor) *Channel {
 		desc:                    desc,
 		sendQueue:               make(chan []byte, desc.SendQueueCapacity),
 		recving:                 make([]byte, 0, desc.RecvBufferCapacity),
+		sendMonitor:             flow.New(0, 0),
+		recvMonitor:             flow.New(0, 0),
+		sendRate:                conn.config.ChannelSendRates[desc.ID],
 		maxPacketMsgPayloadSize: conn.config.MaxPacketMsgPayloadSize,
 	}
 }
@@ -801,6 +875,15 @@ func (ch *Channel) trySendBytes(bytes []byte) bool {
 	}
 }
 
+// Compresses the message if compression is enabled on this channel.
+// Goroutine-safe
+func (ch *Channel) compress(bytes []byte) []byte {
+	if !ch.desc.Compress {
+		return bytes
+	}
+	return compressMsg(bytes)
+}
+
 // Goroutine-safe
 func (ch *Channel) loadSendQueueSize() (size int) {
 	return int(atomic.LoadInt32(&ch.sendQueueSize))
@@ -821,10 +904,20 @@ func (ch *Channel) isSendPending() bool {
 			return false
 		}
 		ch.sending = <-ch.sendQueue
+		if ch.desc.Compress {
+			atomic.AddInt64(&ch.compressedSent, int64(len(ch.sending)))
+		}
 	}
 	return true
 }
 
+// Returns true if the packets sent on this channel exceed its send rate in
+// the current sampling period.
+// Not goroutine-safe
+func (ch *Channel) isThrottled() bool {
+	return ch.sendRate > 0 && ch.sendMonitor.Limit(1, ch.sendRate, false) == 0
+}
+
 // Creates a new PacketMsg to send.
 // Not goroutine-safe
 func (ch *Channel) nextPacketMsg() tmp2p.PacketMsg {
@@ -848,6 +941,7 @@ func (ch *Channel) writePacketMsgTo(w io.Writer) (n int, err error) {
 	packet := ch.nextPacketMsg()
 	n, err = protoio.NewDelimitedWriter(w).WriteMsg(mustWrapPacket(&packet))
 	atomic.AddInt64(&ch.recentlySent, int64(n))
+	ch.sendMonitor.Update(n)
 	return
 }
 
@@ -858,6 +952,10 @@ func (ch *Channel) writePacketMsgTo(w io.Writer) (n int, err error) {
 func (ch *Channel) recvPacketMsg(packet tmp2p.PacketMsg) ([]byte, error) {
 	ch.Logger.Debug("Read PacketMsg", "conn", ch.conn, "packet", packet)
 	var recvCap, recvReceived = ch.desc.RecvMessageCapacity, len(ch.recving) + len(packet.Data)
+	if ch.desc.Compress {
+		// the compression prefix
+		recvCap++
+	}
 	if recvCap < recvReceived {
 		return nil, fmt.Errorf("received message exceeds available capacity: %v < %v", recvCap, recvReceived)
 	}
@@ -870,6 +968,10 @@ func (ch *Channel) recvPacketMsg(packet tmp2p.PacketMsg) ([]byte, error) {
 		//   suggests this could be a memory leak, but we might as well keep the memory for the channel until it closes,
 		//	at which point the recving slice stops being used and should be garbage collected
 		ch.recving = ch.recving[:0] // make([]byte, 0, ch.desc.RecvBufferCapacity)
+		if ch.desc.Compress {
+			atomic.AddInt64(&ch.compressedRecv, int64(len(msgBytes)))
+			return decompressMsg(msgBytes, ch.desc.RecvMessageCapacity)
+		}
 		return msgBytes, nil
 	}
 	return nil, nil
@@ -3,6 +3,7 @@ package conn
 import (
 	"encoding/hex"
 	"net"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/libs/protoio"
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	tmp2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
 	"github.com/cometbft/cometbft/proto/tendermint/types"
 )
@@ -148,6 +150,123 @@ func TestMConnectionReceiveEnvelope(t *testing.T) {
 	}
 }
 
+func TestMConnectionCompression(t *testing.T) {
+	server, client := NetPipe()
+	defer server.Close()
+	defer client.Close()
+
+	receivedCh := make(chan []byte)
+	errorsCh := make(chan interface{})
+	onReceive := func(chID byte, msgBytes []byte) {
+		receivedCh <- msgBytes
+	}
+	onError := func(r interface{}) {
+		errorsCh <- r
+	}
+	cfg := DefaultMConnConfig()
+	chDescs := []*ChannelDescriptor{{ID: 0x01, Priority: 1, SendQueueCapacity: 1, Compress: true}}
+	mconn1 := NewMConnectionWithConfig(client, chDescs, onReceive, onError, cfg)
+	mconn1.SetLogger(log.TestingLogger())
+	err := mconn1.Start()
+	require.Nil(t, err)
+	defer mconn1.Stop() //nolint:errcheck // ignore for tests
+
+	mconn2 := NewMConnectionWithConfig(server, chDescs, func(byte, []byte) {}, func(interface{}) {}, cfg)
+	mconn2.SetLogger(log.TestingLogger())
+	err = mconn2.Start()
+	require.Nil(t, err)
+	defer mconn2.Stop() //nolint:errcheck // ignore for tests
+
+	// larger than a packet, to be compressed into fewer packets
+	msgs := [][]byte{
+		[]byte(strings.Repeat("Cyclops", 1000)),
+		cmtrand.Bytes(2 * cfg.MaxPacketMsgPayloadSize),
+	}
+	for _, msg := range msgs {
+		assert.True(t, mconn2.Send(0x01, msg))
+		select {
+		case receivedBytes := <-receivedCh:
+			assert.Equal(t, msg, receivedBytes)
+		case err := <-errorsCh:
+			t.Fatalf("Expected %X, got %+v", msg, err)
+		case <-time.After(500 * time.Millisecond):
+			t.Fatalf("Did not receive %X message in 500ms", msg)
+		}
+	}
+
+	sent := mconn2.Status().Channels[0]
+	received := mconn1.Status().Channels[0]
+	assert.True(t, sent.Compress)
+	assert.Equal(t, sent.CompressedBytesSent, received.CompressedBytesReceived)
+	// the compressible message shrinks, the other one only gets a prefix
+	assert.Less(t, sent.CompressedBytesSent, int64(len(msgs[0])))
+	assert.Greater(t, sent.CompressedBytesSent, int64(len(msgs[1])))
+}
+
+func TestMConnectionChannelSendRate(t *testing.T) {
+	server, client := NetPipe()
+	defer server.Close()
+	defer client.Close()
+
+	type received struct {
+		chID byte
+		at   time.Time
+	}
+	receivedCh := make(chan received, 2)
+	onReceive := func(chID byte, msgBytes []byte) {
+		receivedCh <- received{chID, time.Now()}
+	}
+	onError := func(r interface{}) {}
+	chDescs := []*ChannelDescriptor{
+		{ID: 0x01, Priority: 1, SendQueueCapacity: 1},
+		{ID: 0x02, Priority: 1, SendQueueCapacity: 1},
+	}
+	mconn1 := NewMConnectionWithConfig(client, chDescs, onReceive, onError, DefaultMConnConfig())
+	mconn1.SetLogger(log.TestingLogger())
+	err := mconn1.Start()
+	require.Nil(t, err)
+	defer mconn1.Stop() //nolint:errcheck // ignore for tests
+
+	// 2 packets per sampling period on the first channel
+	cfg := DefaultMConnConfig()
+	cfg.ChannelSendRates = map[byte]int64{0x01: 20000}
+	mconn2 := NewMConnectionWithConfig(server, chDescs, func(byte, []byte) {}, onError, cfg)
+	mconn2.SetLogger(log.TestingLogger())
+	err = mconn2.Start()
+	require.Nil(t, err)
+	defer mconn2.Stop() //nolint:errcheck // ignore for tests
+
+	start := time.Now()
+	msg := cmtrand.Bytes(10 * cfg.MaxPacketMsgPayloadSize)
+	assert.True(t, mconn2.Send(0x01, msg))
+	assert.True(t, mconn2.Send(0x02, msg))
+
+	var got []received
+	for len(got) < 2 {
+		select {
+		case r := <-receivedCh:
+			got = append(got, r)
+		case <-time.After(5 * time.Second):
+			t.Fatal("Did not receive the messages in 5s")
+		}
+	}
+	// the throttled channel doesn't hold back the other one
+	assert.Equal(t, byte(0x02), got[0].chID)
+	assert.Equal(t, byte(0x01), got[1].chID)
+	assert.Greater(t, got[1].at.Sub(start), 300*time.Millisecond)
+
+	// the monitors count the bytes of a sampling period once it ends
+	time.Sleep(200 * time.Millisecond)
+	sent := mconn2.Status().Channels
+	recv := mconn1.Status().Channels
+	assert.Equal(t, int64(20000), sent[0].SendRate)
+	assert.Zero(t, sent[1].SendRate)
+	for i := range chDescs {
+		assert.Greater(t, sent[i].SendMonitor.Bytes, int64(len(msg)))
+		assert.Equal(t, sent[i].SendMonitor.Bytes, recv[i].RecvMonitor.Bytes)
+	}
+}
+
 func TestMConnectionStatus(t *testing.T) {
 	server, client := NetPipe()
 	defer server.Close()
@@ -362,6 +481,10 @@ func TestMConnectionPingPongs(t *testing.T) {
 	case <-time.After(2 * pongTimerExpired):
 		assert.True(t, mconn.IsRunning())
 	}
+
+	// the round-trip time of the pings is measured
+	assert.NotZero(t, mconn.Status().RTT)
+	assert.Less(t, mconn.Status().RTT, mconn.config.PongTimeout)
 }
 
 func TestMConnectionStopsAndReturnsError(t *testing.T) {
@@ -0,0 +1,529 @@
+package conn
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/quic-go/quic-go"
+
+	flow "github.com/cometbft/cometbft/libs/flowrate"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/service"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+const (
+	// how long FlushStop waits for the peer to close the connection once all
+	// the streams are closed, before closing it
+	quicFlushTimeout = 2 * time.Second
+
+	// application error codes the QUIC connection is closed with
+	quicCodeStopped quic.ApplicationErrorCode = 0x0
+	quicCodeError   quic.ApplicationErrorCode = 0x1
+)
+
+/*
+QUICConnection sends and receives the messages of the channels of a peer over a
+QUIC connection, with a unidirectional stream per channel in each direction, so
+the messages of a channel are never delayed by the ones of another, unlike with
+MConnection. The connection is kept alive by QUIC, as configured by the
+transport, so there are no pings.
+
+A stream starts with the ID of its channel, followed by the messages, each
+prefixed with its length as a uvarint. A peer only opens a stream for a channel
+when it first sends a message on it, and closes the streams when flushing and
+stopping the connection.
+
+Like MConnection, it has the blocking Send and the nonblocking TrySend, and the
+inbound messages are handled with an onReceive callback function, which is
+called concurrently for different channels.
+*/
+type QUICConnection struct {
+	service.BaseService
+
+	conn        quic.Connection
+	sendMonitor *flow.Monitor
+	recvMonitor *flow.Monitor
+	channels    []*quicChannel
+	channelsIdx map[byte]*quicChannel
+	onReceive   receiveCbFunc
+	onError     errorCbFunc
+	errored     uint32
+	config      MConnConfig
+
+	// Closing quitSendRoutines will cause the sendRoutines to quit, after
+	// sending the queued messages if flushing is set.
+	quitSendRoutines chan struct{}
+	sendRoutines     sync.WaitGroup
+	flushing         bool
+
+	// streams of the peer which were not closed yet, by FlushStop.
+	openRecvStreams int32
+
+	// Canceled to interrupt accepting and opening streams.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// used to ensure FlushStop and OnStop
+	// are safe to call concurrently.
+	stopMtx cmtsync.Mutex
+
+	created time.Time // time of creation
+}
+
+// NewQUICConnection wraps a QUIC connection to a peer, once authenticated.
+func NewQUICConnection(
+	conn quic.Connection,
+	chDescs []*ChannelDescriptor,
+	onReceive receiveCbFunc,
+	onError errorCbFunc,
+	config MConnConfig,
+) *QUICConnection {
+	qc := &QUICConnection{
+		conn:        conn,
+		sendMonitor: flow.New(0, 0),
+		recvMonitor: flow.New(0, 0),
+		channelsIdx: make(map[byte]*quicChannel),
+		onReceive:   onReceive,
+		onError:     onError,
+		config:      config,
+		created:     time.Now(),
+	}
+	for _, desc := range chDescs {
+		channel := newQUICChannel(qc, *desc)
+		qc.channelsIdx[channel.desc.ID] = channel
+		qc.channels = append(qc.channels, channel)
+	}
+	qc.BaseService = *service.NewBaseService(nil, "QUICConnection", qc)
+	return qc
+}
+
+// OnStart implements BaseService
+func (c *QUICConnection) OnStart() error {
+	if err := c.BaseService.OnStart(); err != nil {
+		return err
+	}
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+	c.quitSendRoutines = make(chan struct{})
+	for _, channel := range c.channels {
+		c.sendRoutines.Add(1)
+		go c.sendRoutine(channel)
+	}
+	go c.acceptRoutine()
+	return nil
+}
+
+// stopServices stops the BaseService and closes the quitSendRoutines, setting
+// flushing beforehand. If it was already closed, it returns true, otherwise it
+// returns false.
+func (c *QUICConnection) stopServices(flush bool) (alreadyStopped bool) {
+	c.stopMtx.Lock()
+	defer c.stopMtx.Unlock()
+
+	select {
+	case <-c.quitSendRoutines:
+		// already quit
+		return true
+	default:
+	}
+
+	c.BaseService.OnStop()
+	c.flushing = flush
+	close(c.quitSendRoutines)
+	return false
+}
+
+// FlushStop replicates the logic of OnStop.
+// It additionally ensures that all successful
+// .Send() calls will get sent before closing
+// the connection.
+func (c *QUICConnection) FlushStop() {
+	if c.stopServices(true) {
+		return
+	}
+
+	// the sendRoutines close their streams once the messages are sent, then
+	// the peer closes the connection when all its streams are closed.
+	c.sendRoutines.Wait()
+	select {
+	case <-c.conn.Context().Done():
+	case <-time.After(quicFlushTimeout):
+	}
+	c.close(quicCodeStopped, "stopped")
+}
+
+// OnStop implements BaseService
+func (c *QUICConnection) OnStop() {
+	if c.stopServices(false) {
+		return
+	}
+	c.close(quicCodeStopped, "stopped")
+}
+
+func (c *QUICConnection) close(code quic.ApplicationErrorCode, reason string) {
+	c.cancel()
+	_ = c.conn.CloseWithError(code, reason)
+}
+
+// stopped returns true once OnStop, FlushStop or stopForError was called.
+func (c *QUICConnection) stopped() bool {
+	select {
+	case <-c.quitSendRoutines:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *QUICConnection) String() string {
+	return fmt.Sprintf("QUICConn{%v}", c.conn.RemoteAddr())
+}
+
+// Catch panics, usually caused by the callbacks.
+func (c *QUICConnection) _recover() {
+	if r := recover(); r != nil {
+		c.Logger.Error("QUICConnection panicked", "err", r, "stack", string(debug.Stack()))
+		c.stopForError(fmt.Errorf("recovered from panic: %v", r))
+	}
+}
+
+func (c *QUICConnection) stopForError(r interface{}) {
+	_ = c.conn.CloseWithError(quicCodeError, fmt.Sprint(r))
+	if err := c.Stop(); err != nil {
+		c.Logger.Error("Error stopping connection", "err", err)
+	}
+	if atomic.CompareAndSwapUint32(&c.errored, 0, 1) {
+		if c.onError != nil {
+			c.onError(r)
+		}
+	}
+}
+
+// Queues a message to be sent to channel.
+func (c *QUICConnection) Send(chID byte, msgBytes []byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+
+	c.Logger.Debug("Send", "channel", chID, "conn", c, "msgBytes", log.NewLazySprintf("%X", msgBytes))
+
+	channel, ok := c.channelsIdx[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+		return false
+	}
+
+	select {
+	case channel.sendQueue <- channel.compress(msgBytes):
+		atomic.AddInt32(&channel.sendQueueSize, 1)
+		return true
+	case <-time.After(defaultSendTimeout):
+		c.Logger.Debug("Send failed", "channel", chID, "conn", c, "msgBytes", log.NewLazySprintf("%X", msgBytes))
+		return false
+	}
+}
+
+// Queues a message to be sent to channel.
+// Nonblocking, returns true if successful.
+func (c *QUICConnection) TrySend(chID byte, msgBytes []byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+
+	c.Logger.Debug("TrySend", "channel", chID, "conn", c, "msgBytes", log.NewLazySprintf("%X", msgBytes))
+
+	channel, ok := c.channelsIdx[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+		return false
+	}
+
+	select {
+	case channel.sendQueue <- channel.compress(msgBytes):
+		atomic.AddInt32(&channel.sendQueueSize, 1)
+		return true
+	default:
+		return false
+	}
+}
+
+// CanSend returns true if you can send more data onto the chID, false
+// otherwise. Use only as a heuristic.
+func (c *QUICConnection) CanSend(chID byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+
+	channel, ok := c.channelsIdx[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Unknown channel %X", chID))
+		return false
+	}
+	return int(atomic.LoadInt32(&channel.sendQueueSize)) < defaultSendQueueCapacity
+}
+
+// sendRoutine sends the messages queued on the channel on its stream, opened
+// with the first message.
+func (c *QUICConnection) sendRoutine(channel *quicChannel) {
+	defer c.sendRoutines.Done()
+	defer c._recover()
+
+	var stream quic.SendStream
+	send := func(msgBytes []byte) error {
+		if stream == nil {
+			var err error
+			if stream, err = c.conn.OpenUniStreamSync(c.ctx); err != nil {
+				return err
+			}
+			if err := channel.write(stream, []byte{channel.desc.ID}); err != nil {
+				return err
+			}
+		}
+		bz := binary.AppendUvarint(make([]byte, 0, binary.MaxVarintLen64+len(msgBytes)), uint64(len(msgBytes)))
+		err := channel.write(stream, append(bz, msgBytes...))
+		atomic.AddInt32(&channel.sendQueueSize, -1)
+		if channel.desc.Compress {
+			atomic.AddInt64(&channel.compressedSent, int64(len(msgBytes)))
+		}
+		return err
+	}
+
+	for {
+		select {
+		case msgBytes := <-channel.sendQueue:
+			if err := send(msgBytes); err != nil {
+				if !c.stopped() {
+					c.Logger.Debug("Connection failed @ sendRoutine", "conn", c, "err", err)
+					c.stopForError(err)
+				}
+				return
+			}
+		case <-c.quitSendRoutines:
+			if !c.flushing {
+				return
+			}
+			for {
+				select {
+				case msgBytes := <-channel.sendQueue:
+					if err := send(msgBytes); err != nil {
+						c.Logger.Debug("Failed to flush the channel", "conn", c, "channel", channel.desc.ID, "err", err)
+						return
+					}
+				default:
+					if stream != nil {
+						_ = stream.Close()
+					}
+					return
+				}
+			}
+		}
+	}
+}
+
+// acceptRoutine accepts the streams of the peer, and receives the messages of
+// each of them in its own routine.
+func (c *QUICConnection) acceptRoutine() {
+	for {
+		stream, err := c.conn.AcceptUniStream(c.ctx)
+		if err != nil {
+			if !c.stopped() {
+				c.Logger.Info("Connection is closed @ acceptRoutine (likely by the other side)", "conn", c, "err", err)
+				c.stopForError(err)
+			}
+			return
+		}
+		atomic.AddInt32(&c.openRecvStreams, 1)
+		go c.recvRoutine(stream)
+	}
+}
+
+// recvRoutine reads the messages of a stream of the peer and pushes them to
+// onReceive. Blocks depending on how the connection is throttled.
+func (c *QUICConnection) recvRoutine(stream quic.ReceiveStream) {
+	defer c._recover()
+
+	r := bufio.NewReaderSize(&quicStreamReader{conn: c, stream: stream}, minReadBufferSize)
+	channel, err := c.recvChannel(r)
+	for err == nil {
+		var msgBytes []byte
+		if msgBytes, err = channel.recvMsg(r); err == nil {
+			c.Logger.Debug("Received bytes", "chID", channel.desc.ID, "msgBytes", msgBytes)
+			c.onReceive(channel.desc.ID, msgBytes)
+		}
+	}
+
+	if errors.Is(err, io.EOF) {
+		// the peer is flushing and stopping, and closes the connection
+		// once all its streams are closed. Let it close it.
+		if atomic.AddInt32(&c.openRecvStreams, -1) > 0 {
+			return
+		}
+		c.Logger.Info("Connection is closed @ recvRoutine (by the other side)", "conn", c)
+	}
+	if !c.stopped() {
+		c.Logger.Debug("Connection failed @ recvRoutine", "conn", c, "err", err)
+		c.stopForError(err)
+	}
+}
+
+// recvChannel reads the ID of the channel at the start of a stream. Each
+// channel has at most one stream.
+func (c *QUICConnection) recvChannel(r io.ByteReader) (*quicChannel, error) {
+	chID, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	channel, ok := c.channelsIdx[chID]
+	if !ok {
+		return nil, fmt.Errorf("unknown channel %X", chID)
+	}
+	if !atomic.CompareAndSwapUint32(&channel.receiving, 0, 1) {
+		return nil, fmt.Errorf("duplicate stream for channel %X", chID)
+	}
+	return channel, nil
+}
+
+func (c *QUICConnection) Status() ConnectionStatus {
+	var status ConnectionStatus
+	status.Duration = time.Since(c.created)
+	status.SendMonitor = c.sendMonitor.Status()
+	status.RecvMonitor = c.recvMonitor.Status()
+	status.Channels = make([]ChannelStatus, len(c.channels))
+	for i, channel := range c.channels {
+		status.Channels[i] = ChannelStatus{
+			ID:                channel.desc.ID,
+			SendQueueCapacity: cap(channel.sendQueue),
+			SendQueueSize:     int(atomic.LoadInt32(&channel.sendQueueSize)),
+			Priority:          channel.desc.Priority,
+
+			Compress:                channel.desc.Compress,
+			CompressedBytesSent:     atomic.LoadInt64(&channel.compressedSent),
+			CompressedBytesReceived: atomic.LoadInt64(&channel.compressedRecv),
+
+			SendRate:    channel.sendRate,
+			SendMonitor: channel.sendMonitor.Status(),
+			RecvMonitor: channel.recvMonitor.Status(),
+		}
+	}
+	return status
+}
+
+//-----------------------------------------------------------------------------
+
+// quicChannel is a channel of a QUICConnection. Unlike Channel, there are no
+// packets, the messages are written whole on the stream of the channel.
+type quicChannel struct {
+	conn          *QUICConnection
+	desc          ChannelDescriptor
+	sendQueue     chan []byte
+	sendQueueSize int32  // atomic.
+	receiving     uint32 // atomic, 1 once the peer opened the stream.
+
+	compressedSent int64 // atomic.
+	compressedRecv int64 // atomic.
+
+	sendMonitor *flow.Monitor
+	recvMonitor *flow.Monitor
+	sendRate    int64 // zero if unlimited
+}
+
+func newQUICChannel(conn *QUICConnection, desc ChannelDescriptor) *quicChannel {
+	desc = desc.FillDefaults()
+	if desc.Priority <= 0 {
+		panic("Channel default priority must be a positive integer")
+	}
+	return &quicChannel{
+		conn:        conn,
+		desc:        desc,
+		sendQueue:   make(chan []byte, desc.SendQueueCapacity),
+		sendMonitor: flow.New(0, 0),
+		recvMonitor: flow.New(0, 0),
+		sendRate:    conn.config.ChannelSendRates[desc.ID],
+	}
+}
+
+// Compresses the message if compression is enabled on this channel.
+// Goroutine-safe
+func (ch *quicChannel) compress(bytes []byte) []byte {
+	if !ch.desc.Compress {
+		return bytes
+	}
+	return compressMsg(bytes)
+}
+
+// write writes bz to the stream, no faster than the send rates of the
+// connection and of the channel.
+// Not goroutine-safe
+func (ch *quicChannel) write(w io.Writer, bz []byte) error {
+	for len(bz) > 0 {
+		n := ch.conn.sendMonitor.Limit(len(bz), atomic.LoadInt64(&ch.conn.config.SendRate), true)
+		n = ch.sendMonitor.Limit(n, ch.sendRate, true)
+		n, err := w.Write(bz[:n])
+		ch.conn.sendMonitor.Update(n)
+		ch.sendMonitor.Update(n)
+		if err != nil {
+			return err
+		}
+		bz = bz[n:]
+	}
+	return nil
+}
+
+// recvMsg reads the next message of the stream of the channel.
+// Not goroutine-safe
+func (ch *quicChannel) recvMsg(r *bufio.Reader) ([]byte, error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	recvCap := uint64(ch.desc.RecvMessageCapacity)
+	if ch.desc.Compress {
+		// the compression prefix
+		recvCap++
+	}
+	if size > recvCap {
+		return nil, fmt.Errorf("received message exceeds available capacity: %v < %v", recvCap, size)
+	}
+	msgBytes := make([]byte, size)
+	if _, err := io.ReadFull(r, msgBytes); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	if ch.desc.Compress {
+		atomic.AddInt64(&ch.compressedRecv, int64(len(msgBytes)))
+		return decompressMsg(msgBytes, ch.desc.RecvMessageCapacity)
+	}
+	return msgBytes, nil
+}
+
+// quicStreamReader reads a stream of the peer no faster than the receive rate
+// of the connection, and records the bytes read in the flows of the connection
+// and of the channel of the stream, once known.
+type quicStreamReader struct {
+	conn    *QUICConnection
+	stream  quic.ReceiveStream
+	channel *quicChannel
+}
+
+func (r *quicStreamReader) Read(p []byte) (int, error) {
+	n := r.conn.recvMonitor.Limit(len(p), atomic.LoadInt64(&r.conn.config.RecvRate), true)
+	n, err := r.stream.Read(p[:n])
+	r.conn.recvMonitor.Update(n)
+	if r.channel == nil && n > 0 {
+		r.channel = r.conn.channelsIdx[p[0]]
+	}
+	if r.channel != nil {
+		r.channel.recvMonitor.Update(n)
+	}
+	return n, err
+}
@@ -0,0 +1,188 @@
+package conn
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/quic-go/quic-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+// quicPipe returns both ends of a QUIC connection over loopback.
+func quicPipe(t *testing.T) (quic.Connection, quic.Connection) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	tlsConf := &tls.Config{
+		Certificates:       []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+		InsecureSkipVerify: true, //nolint:gosec
+		NextProtos:         []string{"test"},
+	}
+	quicConf := &quic.Config{MaxIncomingUniStreams: 16}
+
+	listener, err := quic.ListenAddr("127.0.0.1:0", tlsConf, quicConf)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	acceptc := make(chan quic.Connection, 1)
+	go func() {
+		conn, err := listener.Accept(ctx)
+		if err != nil {
+			t.Error(err)
+		}
+		acceptc <- conn
+	}()
+	client, err := quic.DialAddr(ctx, listener.Addr().String(), tlsConf, quicConf)
+	require.NoError(t, err)
+	server := <-acceptc
+	require.NotNil(t, server)
+	t.Cleanup(func() {
+		_ = client.CloseWithError(0, "")
+		_ = server.CloseWithError(0, "")
+	})
+	return client, server
+}
+
+type quicTestMsg struct {
+	chID byte
+	msg  []byte
+}
+
+func createTestQUICConnection(
+	t *testing.T,
+	conn quic.Connection,
+	config MConnConfig,
+	onReceive func(chID byte, msgBytes []byte),
+	onError func(r interface{}),
+) *QUICConnection {
+	chDescs := []*ChannelDescriptor{
+		{ID: 0x01, Priority: 1, SendQueueCapacity: 10},
+		{ID: 0x02, Priority: 1, SendQueueCapacity: 10, Compress: true},
+	}
+	c := NewQUICConnection(conn, chDescs, onReceive, onError, config)
+	c.SetLogger(log.TestingLogger())
+	require.NoError(t, c.Start())
+	t.Cleanup(func() { _ = c.Stop() })
+	return c
+}
+
+func TestQUICConnectionSendRecv(t *testing.T) {
+	client, server := quicPipe(t)
+
+	recvc := make(chan quicTestMsg, 10)
+	onReceive := func(chID byte, msgBytes []byte) { recvc <- quicTestMsg{chID, msgBytes} }
+	onError := func(r interface{}) { t.Errorf("unexpected error: %v", r) }
+	clientConn := createTestQUICConnection(t, client, DefaultMConnConfig(), onReceive, onError)
+	serverConn := createTestQUICConnection(t, server, DefaultMConnConfig(), onReceive, onError)
+
+	assert.True(t, clientConn.Send(0x01, []byte("foo")))
+	assert.True(t, clientConn.TrySend(0x02, []byte("bar")))
+	assert.True(t, serverConn.Send(0x01, []byte("baz")))
+	assert.False(t, clientConn.Send(0x05, []byte("unknown")))
+
+	received := make(map[string]byte)
+	for i := 0; i < 3; i++ {
+		select {
+		case m := <-recvc:
+			received[string(m.msg)] = m.chID
+		case <-time.After(5 * time.Second):
+			t.Fatal("the messages weren't received")
+		}
+	}
+	assert.Equal(t, map[string]byte{"foo": 0x01, "bar": 0x02, "baz": 0x01}, received)
+
+	status := clientConn.Status()
+	require.Len(t, status.Channels, 2)
+	assert.Equal(t, byte(0x02), status.Channels[1].ID)
+	assert.True(t, status.Channels[1].Compress)
+}
+
+func TestQUICConnectionChannelSendRate(t *testing.T) {
+	client, server := quicPipe(t)
+
+	config := DefaultMConnConfig()
+	config.SendRate = 0
+	config.ChannelSendRates = map[byte]int64{0x01: 1000}
+	recvc := make(chan quicTestMsg, 10)
+	onReceive := func(chID byte, msgBytes []byte) { recvc <- quicTestMsg{chID, msgBytes} }
+	onError := func(r interface{}) {}
+	clientConn := createTestQUICConnection(t, client, config, onReceive, onError)
+	createTestQUICConnection(t, server, DefaultMConnConfig(), onReceive, onError)
+
+	// the capped channel takes seconds to send the message, which doesn't
+	// delay the message of the other channel
+	assert.True(t, clientConn.Send(0x01, make([]byte, 5000)))
+	assert.True(t, clientConn.Send(0x02, []byte("bar")))
+
+	select {
+	case m := <-recvc:
+		assert.Equal(t, byte(0x02), m.chID)
+	case <-time.After(2 * time.Second):
+		t.Fatal("the message wasn't received")
+	}
+}
+
+func TestQUICConnectionFlushStop(t *testing.T) {
+	client, server := quicPipe(t)
+
+	recvc := make(chan quicTestMsg, 10)
+	errc := make(chan interface{}, 1)
+	onReceive := func(chID byte, msgBytes []byte) { recvc <- quicTestMsg{chID, msgBytes} }
+	clientConn := createTestQUICConnection(t, client, DefaultMConnConfig(), onReceive, func(interface{}) {})
+	createTestQUICConnection(t, server, DefaultMConnConfig(), onReceive, func(r interface{}) { errc <- r })
+
+	for i := 0; i < 5; i++ {
+		assert.True(t, clientConn.Send(0x01, []byte{byte(i)}))
+	}
+	clientConn.FlushStop()
+
+	for i := 0; i < 5; i++ {
+		select {
+		case m := <-recvc:
+			assert.Equal(t, []byte{byte(i)}, m.msg)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the messages weren't flushed")
+		}
+	}
+	select {
+	case <-errc:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the peer didn't stop")
+	}
+}
+
+func TestQUICConnectionUnknownChannel(t *testing.T) {
+	client, server := quicPipe(t)
+
+	errc := make(chan interface{}, 1)
+	createTestQUICConnection(t, server, DefaultMConnConfig(), func(byte, []byte) {}, func(r interface{}) { errc <- r })
+
+	stream, err := client.OpenUniStream()
+	require.NoError(t, err)
+	_, err = stream.Write([]byte{0x05, 0x03, 'f', 'o', 'o'})
+	require.NoError(t, err)
+
+	select {
+	case r := <-errc:
+		assert.Contains(t, r.(error).Error(), "unknown channel")
+	case <-time.After(5 * time.Second):
+		t.Fatal("the connection wasn't stopped")
+	}
+}
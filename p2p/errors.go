@@ -3,6 +3,7 @@ package p2p
 import (
 	"fmt"
 	"net"
+	"time"
 )
 
 // ErrFilterTimeout indicates that a filter operation timed out.
@@ -125,6 +126,20 @@ func (e ErrSwitchConnectToSelf) Error() string {
 	return fmt.Sprintf("connect to self: %v", e.Addr)
 }
 
+// ErrSwitchBannedPeer to be raised when connecting with a peer banned by the
+// operator or for its low trust score. Until is zero if the ban never ends.
+type ErrSwitchBannedPeer struct {
+	ID    ID
+	Until time.Time
+}
+
+func (e ErrSwitchBannedPeer) Error() string {
+	if e.Until.IsZero() {
+		return fmt.Sprintf("peer %v is banned", e.ID)
+	}
+	return fmt.Sprintf("peer %v is banned until %v", e.ID, e.Until.Format(time.RFC3339))
+}
+
 type ErrSwitchAuthenticationFailure struct {
 	Dialed *NetAddress
 	Got    ID
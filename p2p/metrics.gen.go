@@ -32,6 +32,30 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "peer_send_bytes_total",
 			Help:      "Number of bytes sent to a given peer.",
 		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerReceiveCompressedBytesTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_receive_compressed_bytes_total",
+			Help:      "Number of bytes received from a given peer on a compressed channel, before decompression.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerSendCompressedBytesTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_send_compressed_bytes_total",
+			Help:      "Number of bytes sent to a given peer on a compressed channel, after compression.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerReceivePacketBytesTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_receive_packet_bytes_total",
+			Help:      "Number of bytes of the packets received from a given peer on a channel, including the packet framing. These count towards the receive rate.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerSendPacketBytesTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_send_packet_bytes_total",
+			Help:      "Number of bytes of the packets sent to a given peer on a channel, including the packet framing. These count towards the send rates.",
+		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
 		PeerPendingSendBytes: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -61,12 +85,16 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 
 func NopMetrics() *Metrics {
 	return &Metrics{
-		Peers:                    discard.NewGauge(),
-		PeerReceiveBytesTotal:    discard.NewCounter(),
-		PeerSendBytesTotal:       discard.NewCounter(),
-		PeerPendingSendBytes:     discard.NewGauge(),
-		NumTxs:                   discard.NewGauge(),
-		MessageReceiveBytesTotal: discard.NewCounter(),
-		MessageSendBytesTotal:    discard.NewCounter(),
+		Peers:                           discard.NewGauge(),
+		PeerReceiveBytesTotal:           discard.NewCounter(),
+		PeerSendBytesTotal:              discard.NewCounter(),
+		PeerReceiveCompressedBytesTotal: discard.NewCounter(),
+		PeerSendCompressedBytesTotal:    discard.NewCounter(),
+		PeerReceivePacketBytesTotal:     discard.NewCounter(),
+		PeerSendPacketBytesTotal:        discard.NewCounter(),
+		PeerPendingSendBytes:            discard.NewGauge(),
+		NumTxs:                          discard.NewGauge(),
+		MessageReceiveBytesTotal:        discard.NewCounter(),
+		MessageSendBytesTotal:           discard.NewCounter(),
 	}
 }
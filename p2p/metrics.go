@@ -32,6 +32,18 @@ type Metrics struct {
 	PeerReceiveBytesTotal metrics.Counter `metrics_labels:"peer_id,chID"`
 	// Number of bytes sent to a given peer.
 	PeerSendBytesTotal metrics.Counter `metrics_labels:"peer_id,chID"`
+	// Number of bytes received from a given peer on a compressed channel,
+	// before decompression.
+	PeerReceiveCompressedBytesTotal metrics.Counter `metrics_labels:"peer_id,chID"`
+	// Number of bytes sent to a given peer on a compressed channel, after
+	// compression.
+	PeerSendCompressedBytesTotal metrics.Counter `metrics_labels:"peer_id,chID"`
+	// Number of bytes of the packets received from a given peer on a channel,
+	// including the packet framing. These count towards the receive rate.
+	PeerReceivePacketBytesTotal metrics.Counter `metrics_labels:"peer_id,chID"`
+	// Number of bytes of the packets sent to a given peer on a channel,
+	// including the packet framing. These count towards the send rates.
+	PeerSendPacketBytesTotal metrics.Counter `metrics_labels:"peer_id,chID"`
 	// Pending bytes to be sent to a given peer.
 	PeerPendingSendBytes metrics.Gauge `metrics_labels:"peer_id"`
 	// Number of transactions submitted by each peer.
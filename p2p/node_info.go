@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/cometbft/cometbft/config"
 	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtstrings "github.com/cometbft/cometbft/libs/strings"
 	tmp2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
@@ -13,8 +14,9 @@ import (
 )
 
 const (
-	maxNodeInfoSize = 10240 // 10KB
-	maxNumChannels  = 16    // plenty of room for upgrades, for now
+	maxNodeInfoSize  = 10240 // 10KB
+	maxNumChannels   = 16    // plenty of room for upgrades, for now
+	maxNumTransports = 8
 )
 
 // Max size of the NodeInfo struct
@@ -93,6 +95,14 @@ type DefaultNodeInfo struct {
 	// ASCIIText fields
 	Moniker string               `json:"moniker"` // arbitrary moniker
 	Other   DefaultNodeInfoOther `json:"other"`   // other application specific data
+
+	// Channels whose messages this node can compress. A channel is only
+	// compressed if both nodes list it here.
+	CompressedChannels cmtbytes.HexBytes `json:"compressed_channels"`
+
+	// Transports this node accepts connections on, like "tcp" and "quic".
+	// Empty for the nodes which only know of TCP.
+	Transports []string `json:"transports"`
 }
 
 // DefaultNodeInfoOther is the misc. applcation specific data
@@ -151,6 +161,36 @@ func (info DefaultNodeInfo) Validate() error {
 		channels[ch] = struct{}{}
 	}
 
+	// Validate CompressedChannels - ensure they are known channels and check
+	// for duplicates.
+	compressed := make(map[byte]struct{})
+	for _, ch := range info.CompressedChannels {
+		if _, ok := channels[ch]; !ok {
+			return fmt.Errorf("info.CompressedChannels contains unknown channel id %v", ch)
+		}
+		if _, ok := compressed[ch]; ok {
+			return fmt.Errorf("info.CompressedChannels contains duplicate channel id %v", ch)
+		}
+		compressed[ch] = struct{}{}
+	}
+
+	// Validate Transports - ensure max and check for duplicates. Unknown
+	// transports are allowed, to interoperate with the nodes supporting newer
+	// ones.
+	if len(info.Transports) > maxNumTransports {
+		return fmt.Errorf("info.Transports is too long (%v). Max is %v", len(info.Transports), maxNumTransports)
+	}
+	transports := make(map[string]struct{})
+	for _, transport := range info.Transports {
+		if !cmtstrings.IsASCIIText(transport) || cmtstrings.ASCIITrim(transport) == "" {
+			return fmt.Errorf("info.Transports must be valid ASCII text without tabs, but got %v", transport)
+		}
+		if _, ok := transports[transport]; ok {
+			return fmt.Errorf("info.Transports contains duplicate transport %v", transport)
+		}
+		transports[transport] = struct{}{}
+	}
+
 	// Validate Moniker.
 	if !cmtstrings.IsASCIIText(info.Moniker) || cmtstrings.ASCIITrim(info.Moniker) == "" {
 		return fmt.Errorf("info.Moniker must be valid non-empty ASCII text without tabs, but got %v", info.Moniker)
@@ -227,6 +267,26 @@ func (info DefaultNodeInfo) HasChannel(chID byte) bool {
 	return bytes.Contains(info.Channels, []byte{chID})
 }
 
+// HasCompressedChannel returns true if the node can compress the messages of
+// the channel.
+func (info DefaultNodeInfo) HasCompressedChannel(chID byte) bool {
+	return bytes.Contains(info.CompressedChannels, []byte{chID})
+}
+
+// SupportsTransport returns true if the node accepts connections on the given
+// transport. All nodes accept TCP connections.
+func (info DefaultNodeInfo) SupportsTransport(transport string) bool {
+	if transport == config.TransportTCP {
+		return true
+	}
+	for _, t := range info.Transports {
+		if t == transport {
+			return true
+		}
+	}
+	return false
+}
+
 func (info DefaultNodeInfo) ToProto() *tmp2p.DefaultNodeInfo {
 
 	dni := new(tmp2p.DefaultNodeInfo)
@@ -246,6 +306,8 @@ func (info DefaultNodeInfo) ToProto() *tmp2p.DefaultNodeInfo {
 		TxIndex:    info.Other.TxIndex,
 		RPCAddress: info.Other.RPCAddress,
 	}
+	dni.CompressedChannels = info.CompressedChannels
+	dni.Transports = info.Transports
 
 	return dni
 }
@@ -270,6 +332,8 @@ func DefaultNodeInfoFromToProto(pb *tmp2p.DefaultNodeInfo) (DefaultNodeInfo, err
 			TxIndex:    pb.Other.TxIndex,
 			RPCAddress: pb.Other.RPCAddress,
 		},
+		CompressedChannels: pb.CompressedChannels,
+		Transports:         pb.Transports,
 	}
 
 	return dni, nil
@@ -39,6 +39,19 @@ func TestNodeInfoValidate(t *testing.T) {
 		{"Duplicate Channel", func(ni *DefaultNodeInfo) { ni.Channels = dupChannels }, true},
 		{"Good Channels", func(ni *DefaultNodeInfo) { ni.Channels = ni.Channels[:5] }, false},
 
+		{"Unknown Compressed Channel", func(ni *DefaultNodeInfo) {
+			ni.CompressedChannels = []byte{byte(maxNumChannels)}
+		}, true},
+		{"Duplicate Compressed Channel", func(ni *DefaultNodeInfo) { ni.CompressedChannels = []byte{1, 2, 1} }, true},
+		{"Good Compressed Channels", func(ni *DefaultNodeInfo) { ni.CompressedChannels = []byte{1, 2} }, false},
+
+		{"Too Many Transports", func(ni *DefaultNodeInfo) {
+			ni.Transports = []string{"a", "b", "c", "d", "e", "f", "g", "h", "i"}
+		}, true},
+		{"Duplicate Transport", func(ni *DefaultNodeInfo) { ni.Transports = []string{"tcp", "quic", "tcp"} }, true},
+		{"Non-ASCII Transport", func(ni *DefaultNodeInfo) { ni.Transports = []string{nonASCII} }, true},
+		{"Good Transports", func(ni *DefaultNodeInfo) { ni.Transports = []string{"tcp", "quic", "webtransport"} }, false},
+
 		{"Invalid NetAddress", func(ni *DefaultNodeInfo) { ni.ListenAddr = "not-an-address" }, true},
 		{"Good NetAddress", func(ni *DefaultNodeInfo) { ni.ListenAddr = "0.0.0.0:26656" }, false},
 
@@ -102,6 +102,23 @@ func (pc peerConn) RemoteIP() net.IP {
 	return pc.ip
 }
 
+// muxConn is the connection multiplexing the channels of a peer, an
+// MConnection over TCP or a QUICConnection.
+type muxConn interface {
+	service.Service
+	FlushStop()
+
+	Send(chID byte, msgBytes []byte) bool
+	TrySend(chID byte, msgBytes []byte) bool
+	CanSend(chID byte) bool
+	Status() cmtconn.ConnectionStatus
+}
+
+var (
+	_ muxConn = (*cmtconn.MConnection)(nil)
+	_ muxConn = (*cmtconn.QUICConnection)(nil)
+)
+
 // peer implements Peer.
 //
 // Before using a peer, you will need to perform a handshake on connection.
@@ -110,7 +127,7 @@ type peer struct {
 
 	// raw peerConn and the multiplex connection
 	peerConn
-	mconn *cmtconn.MConnection
+	mconn muxConn
 
 	// peer's node info and the channel it knows about
 	// channels = nodeInfo.Channels
@@ -125,6 +142,9 @@ type peer struct {
 	metricsTicker *time.Ticker
 	mlc           *metricsLabelCache
 
+	// status of the channels at the last metrics report
+	lastChStatus map[byte]cmtconn.ChannelStatus
+
 	// When removal of a peer fails, we set this flag
 	removalAttemptFailed bool
 }
@@ -150,17 +170,31 @@ func newPeer(
 		metricsTicker: time.NewTicker(metricsTickerDuration),
 		metrics:       NopMetrics(),
 		mlc:           mlc,
+
+		lastChStatus: make(map[byte]cmtconn.ChannelStatus),
 	}
 
-	p.mconn = createMConnection(
-		pc.conn,
-		p,
-		reactorsByCh,
-		msgTypeByChID,
-		chDescs,
-		onPeerError,
-		mConfig,
-	)
+	if qc, ok := pc.conn.(*quicConn); ok {
+		p.mconn = createQUICConnection(
+			qc,
+			p,
+			reactorsByCh,
+			msgTypeByChID,
+			chDescs,
+			onPeerError,
+			mConfig,
+		)
+	} else {
+		p.mconn = createMConnection(
+			pc.conn,
+			p,
+			reactorsByCh,
+			msgTypeByChID,
+			chDescs,
+			onPeerError,
+			mConfig,
+		)
+	}
 	p.BaseService = *service.NewBaseService(nil, "Peer", p)
 	for _, option := range options {
 		option(p)
@@ -376,6 +410,7 @@ func (p *peer) metricsReporter() {
 			var sendQueueSize float64
 			for _, chStatus := range status.Channels {
 				sendQueueSize += float64(chStatus.SendQueueSize)
+				p.reportChannelBytes(chStatus)
 			}
 
 			p.metrics.PeerPendingSendBytes.With("peer_id", string(p.ID())).Set(sendQueueSize)
@@ -385,6 +420,29 @@ func (p *peer) metricsReporter() {
 	}
 }
 
+// reportChannelBytes adds the packet bytes, and the compressed bytes if the
+// channel is compressed, sent and received on the channel since the last
+// report to the metrics. The message bytes are reported as they are sent and
+// received.
+func (p *peer) reportChannelBytes(chStatus cmtconn.ChannelStatus) {
+	labels := []string{
+		"peer_id", string(p.ID()),
+		"chID", fmt.Sprintf("%#x", chStatus.ID),
+	}
+	last := p.lastChStatus[chStatus.ID]
+	p.metrics.PeerSendPacketBytesTotal.With(labels...).
+		Add(float64(chStatus.SendMonitor.Bytes - last.SendMonitor.Bytes))
+	p.metrics.PeerReceivePacketBytesTotal.With(labels...).
+		Add(float64(chStatus.RecvMonitor.Bytes - last.RecvMonitor.Bytes))
+	if chStatus.Compress {
+		p.metrics.PeerSendCompressedBytesTotal.With(labels...).
+			Add(float64(chStatus.CompressedBytesSent - last.CompressedBytesSent))
+		p.metrics.PeerReceiveCompressedBytesTotal.With(labels...).
+			Add(float64(chStatus.CompressedBytesReceived - last.CompressedBytesReceived))
+	}
+	p.lastChStatus[chStatus.ID] = chStatus
+}
+
 //------------------------------------------------------------------
 // helper funcs
 
@@ -397,8 +455,41 @@ func createMConnection(
 	onPeerError func(Peer, interface{}),
 	config cmtconn.MConnConfig,
 ) *cmtconn.MConnection {
+	return cmtconn.NewMConnectionWithConfig(
+		conn,
+		chDescs,
+		onReceiveFunc(p, reactorsByCh, msgTypeByChID),
+		func(r interface{}) { onPeerError(p, r) },
+		config,
+	)
+}
 
-	onReceive := func(chID byte, msgBytes []byte) {
+func createQUICConnection(
+	conn *quicConn,
+	p *peer,
+	reactorsByCh map[byte]Reactor,
+	msgTypeByChID map[byte]proto.Message,
+	chDescs []*cmtconn.ChannelDescriptor,
+	onPeerError func(Peer, interface{}),
+	config cmtconn.MConnConfig,
+) *cmtconn.QUICConnection {
+	return cmtconn.NewQUICConnection(
+		conn.conn,
+		chDescs,
+		onReceiveFunc(p, reactorsByCh, msgTypeByChID),
+		func(r interface{}) { onPeerError(p, r) },
+		config,
+	)
+}
+
+// onReceiveFunc returns the callback of the connection of the peer, passing the
+// messages received to the reactors of their channels.
+func onReceiveFunc(
+	p *peer,
+	reactorsByCh map[byte]Reactor,
+	msgTypeByChID map[byte]proto.Message,
+) func(chID byte, msgBytes []byte) {
+	return func(chID byte, msgBytes []byte) {
 		reactor := reactorsByCh[chID]
 		if reactor == nil {
 			// Note that its ok to panic here as it's caught in the conn._recover,
@@ -429,16 +520,4 @@ func createMConnection(
 			Message:   msg,
 		})
 	}
-
-	onError := func(r interface{}) {
-		onPeerError(p, r)
-	}
-
-	return cmtconn.NewMConnectionWithConfig(
-		conn,
-		chDescs,
-		onReceive,
-		onError,
-		config,
-	)
 }
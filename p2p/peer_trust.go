@@ -0,0 +1,113 @@
+package p2p
+
+import (
+	"time"
+)
+
+// MaxTrustScore is the highest trust score, that of a peer without trust
+// metric yet.
+const MaxTrustScore = 100
+
+// MarkPeerGoodEvent records a good event of the peer in its trust metric, like
+// a valid vote, block or tx received from it. No-op if the peer reputation is
+// disabled, or the peer has no trust metric, since it never connected or it
+// disconnected long ago.
+func (sw *Switch) MarkPeerGoodEvent(peer Peer) {
+	if sw.trustStore == nil {
+		return
+	}
+	if tm, ok := sw.trustStore.FindPeerTrustMetric(string(peer.ID())); ok {
+		tm.GoodEvents(1)
+	}
+}
+
+// MarkPeerBadEvent records a bad event of the peer in its trust metric, like an
+// invalid message received from it. If its trust score drops below the ban
+// threshold, the peer is stopped and banned for a while, unless it is
+// persistent or unconditional. No-op if the peer reputation is disabled, or the
+// peer has no trust metric.
+func (sw *Switch) MarkPeerBadEvent(peer Peer, reason interface{}) {
+	if sw.trustStore == nil {
+		return
+	}
+	tm, ok := sw.trustStore.FindPeerTrustMetric(string(peer.ID()))
+	if !ok {
+		return
+	}
+	tm.BadEvents(1)
+	score := tm.TrustScore()
+	sw.Logger.Debug("Peer misbehaved", "peer", peer, "reason", reason, "score", score)
+
+	if score >= sw.config.TrustBanThreshold || peer.IsPersistent() || sw.IsPeerUnconditional(peer.ID()) {
+		return
+	}
+	until := time.Now().Add(sw.config.TrustBanDuration)
+	if _, err := sw.banList.Add(string(peer.ID()), until, "low trust score"); err != nil {
+		sw.Logger.Error("Failed to ban peer", "peer", peer, "err", err)
+	}
+	sw.Logger.Info("Banning peer with a low trust score", "peer", peer, "score", score, "until", until)
+	sw.StopPeerForError(peer, ErrSwitchBannedPeer{ID: peer.ID(), Until: until})
+}
+
+// IsPeerTrustEnabled returns true if the behavior of the peers is tracked in
+// trust metrics.
+func (sw *Switch) IsPeerTrustEnabled() bool {
+	return sw.trustStore != nil
+}
+
+// PeerTrustScore returns the trust score of the peer with the given ID, between
+// 0 and 100, and false if the peer reputation is disabled or the peer has no
+// trust metric yet.
+func (sw *Switch) PeerTrustScore(id ID) (int, bool) {
+	if sw.trustStore == nil {
+		return 0, false
+	}
+	return sw.trustStore.GetPeerTrustScore(string(id))
+}
+
+// IsPeerBanned returns true if the peer with the given ID is banned, for its
+// low trust score or by the operator.
+func (sw *Switch) IsPeerBanned(id ID) bool {
+	_, banned := sw.peerBannedUntil(id, nil)
+	return banned
+}
+
+// evictInboundPeer stops the inbound peer with the lowest trust score, to make
+// room for the new inbound peer, if the score is below the eviction threshold
+// and lower than the score of the new peer. No peer is evicted for a new peer
+// which would be filtered out anyway. Returns false if no peer was evicted.
+func (sw *Switch) evictInboundPeer(p Peer) bool {
+	if sw.trustStore == nil || sw.config.TrustEvictThreshold == 0 {
+		return false
+	}
+	id := p.ID()
+	newScore, ok := sw.PeerTrustScore(id)
+	if !ok {
+		newScore = MaxTrustScore
+	}
+
+	var (
+		worst      Peer
+		worstScore = sw.config.TrustEvictThreshold
+	)
+	for _, peer := range sw.peers.List() {
+		if peer.IsOutbound() || peer.IsPersistent() || sw.IsPeerUnconditional(peer.ID()) {
+			continue
+		}
+		if score, ok := sw.PeerTrustScore(peer.ID()); ok && score < worstScore {
+			worst, worstScore = peer, score
+		}
+	}
+	if worst == nil || worstScore >= newScore {
+		return false
+	}
+	if err := sw.filterPeer(p); err != nil {
+		sw.Logger.Info("Not evicting an inbound peer for a rejected peer", "newPeer", id, "err", err)
+		return false
+	}
+
+	sw.Logger.Info("Evicting inbound peer with a low trust score",
+		"peer", worst, "score", worstScore, "newPeer", id, "newScore", newScore)
+	sw.stopAndRemovePeer(worst, "evicted for a peer with a higher trust score")
+	return true
+}
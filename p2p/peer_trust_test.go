@@ -0,0 +1,139 @@
+package p2p
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p/trust"
+)
+
+func newTestTrustMetricStore() *trust.MetricStore {
+	store := trust.NewTrustMetricStore(dbm.NewMemDB(), trust.DefaultConfig())
+	store.SetLogger(log.TestingLogger())
+	return store
+}
+
+func TestSwitchPeerTrustBan(t *testing.T) {
+	sw1, sw2 := MakeSwitchPair(t, func(i int, sw *Switch) *Switch {
+		if i == 0 {
+			WithTrustMetricStore(newTestTrustMetricStore())(sw)
+		}
+		return initSwitchFunc(i, sw)
+	})
+	t.Cleanup(func() {
+		for _, sw := range []*Switch{sw1, sw2} {
+			if err := sw.Stop(); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+	require.True(t, sw1.IsPeerTrustEnabled())
+	require.False(t, sw2.IsPeerTrustEnabled())
+
+	p := sw1.Peers().List()[0]
+	score, ok := sw1.PeerTrustScore(p.ID())
+	require.True(t, ok)
+	assert.Equal(t, MaxTrustScore, score)
+
+	// a bad event among good ones lowers the score without banning the peer
+	sw1.MarkPeerGoodEvent(p)
+	sw1.MarkPeerBadEvent(p, errors.New("bad"))
+	score, _ = sw1.PeerTrustScore(p.ID())
+	assert.Less(t, score, MaxTrustScore)
+	assert.GreaterOrEqual(t, score, cfg.TrustBanThreshold)
+	assert.False(t, sw1.IsPeerBanned(p.ID()))
+	assert.Equal(t, 1, sw1.Peers().Size())
+
+	// the peer is stopped and banned once its score drops below the threshold
+	for i := 0; i < 5; i++ {
+		sw1.MarkPeerBadEvent(p, errors.New("bad"))
+	}
+	assert.True(t, sw1.IsPeerBanned(p.ID()))
+	assert.Equal(t, 0, sw1.Peers().Size())
+	err := sw1.DialPeerWithAddress(sw2.NetAddress())
+	require.Error(t, err)
+	assert.IsType(t, ErrSwitchBannedPeer{}, err)
+
+	// the score is remembered after the peer is disconnected
+	score, ok = sw1.PeerTrustScore(p.ID())
+	require.True(t, ok)
+	assert.Less(t, score, cfg.TrustBanThreshold)
+
+	// no trust metric is created for the events of an unknown peer
+	unknown := newMockPeer(net.IP{127, 0, 0, 2})
+	sw1.MarkPeerGoodEvent(unknown)
+	sw1.MarkPeerBadEvent(unknown, errors.New("bad"))
+	_, ok = sw1.PeerTrustScore(unknown.ID())
+	assert.False(t, ok)
+}
+
+func TestSwitchEvictsInboundPeerWithLowTrustScore(t *testing.T) {
+	swCfg := *cfg
+	swCfg.MaxNumInboundPeers = 1
+
+	sw := MakeSwitch(&swCfg, 1, "testing", "123.123.123", initSwitchFunc,
+		WithTrustMetricStore(newTestTrustMetricStore()))
+	require.NoError(t, sw.Start())
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	dialPeer := func(rp *remotePeer) *remotePeer {
+		rp.Start()
+		t.Cleanup(rp.Stop)
+		c, err := rp.Dial(sw.NetAddress())
+		require.NoError(t, err)
+		// spawn a reading routine to prevent connection from closing
+		go func(c net.Conn) {
+			one := make([]byte, 1)
+			for {
+				if _, err := c.Read(one); err != nil {
+					return
+				}
+			}
+		}(c)
+		time.Sleep(100 * time.Millisecond)
+		return rp
+	}
+	dial := func() *remotePeer {
+		return dialPeer(&remotePeer{PrivKey: ed25519.GenPrivKey(), Config: &swCfg})
+	}
+
+	first := dial()
+	require.True(t, sw.Peers().Has(first.ID()))
+	p := sw.Peers().Get(first.ID())
+
+	// a peer with a perfect score isn't evicted
+	second := dial()
+	assert.True(t, sw.Peers().Has(first.ID()))
+	assert.False(t, sw.Peers().Has(second.ID()))
+
+	// a peer with a score below the eviction threshold is evicted
+	sw.MarkPeerGoodEvent(p)
+	sw.MarkPeerBadEvent(p, errors.New("bad"))
+	score, _ := sw.PeerTrustScore(p.ID())
+	require.Less(t, score, swCfg.TrustEvictThreshold)
+
+	// but not for a peer which is rejected anyway
+	banned := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: &swCfg}
+	_, err := sw.BanPeer(string(banned.ID()), 0, "test")
+	require.NoError(t, err)
+	dialPeer(banned)
+	assert.True(t, sw.Peers().Has(first.ID()))
+	assert.False(t, sw.Peers().Has(banned.ID()))
+
+	third := dial()
+	assert.False(t, sw.Peers().Has(first.ID()))
+	assert.True(t, sw.Peers().Has(third.ID()))
+}
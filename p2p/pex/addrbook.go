@@ -11,6 +11,7 @@ import (
 	"math"
 	"math/rand"
 	"net"
+	"sort"
 	"sync"
 	"time"
 
@@ -70,6 +71,14 @@ type AddrBook interface {
 	IsGood(*p2p.NetAddress) bool
 	IsBanned(*p2p.NetAddress) bool
 
+	// Record the health of an address
+	RecordDial(addr *p2p.NetAddress, err error)
+	RecordConnected(id p2p.ID, services PeerServices)
+	RecordDisconnected(id p2p.ID, duration time.Duration, reason interface{})
+	RecordRTT(id p2p.ID, rtt time.Duration)
+	// Score the health of an address, between 0 and 100
+	HealthScore(*p2p.NetAddress) int
+
 	// Send a selection of addresses to peers
 	GetSelection() []*p2p.NetAddress
 	// Send a selection of addresses with bias
@@ -77,10 +86,32 @@ type AddrBook interface {
 
 	Size() int
 
+	// Stats of all the addresses, for debugging
+	Stats() []AddrStats
+
 	// Persist to disk
 	Save()
 }
 
+// AddrStats are the stats of an address of the book, including its health.
+type AddrStats struct {
+	Addr *p2p.NetAddress `json:"addr"`
+	Src  *p2p.NetAddress `json:"src"`
+	// Old if the peer was marked as good, and banned if marked as bad.
+	Old    bool `json:"old"`
+	Banned bool `json:"banned"`
+
+	Attempts    int32     `json:"attempts"`
+	LastAttempt time.Time `json:"last_attempt"`
+	LastSuccess time.Time `json:"last_success"`
+
+	Dials       []DialOutcome `json:"dials"`
+	Conns       []ConnOutcome `json:"conns"`
+	RTT         time.Duration `json:"rtt"`
+	Services    PeerServices  `json:"services"`
+	HealthScore int           `json:"health_score"`
+}
+
 var _ AddrBook = (*addrBook)(nil)
 
 // addrBook - concurrency safe peer address manager.
@@ -385,6 +416,110 @@ func (a *addrBook) ReinstateBadPeers() {
 	}
 }
 
+// RecordDial implements AddrBook - it records the outcome of a dial of the
+// address, err being nil if it succeeded.
+func (a *addrBook) RecordDial(addr *p2p.NetAddress, err error) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	ka := a.addrLookup[addr.ID]
+	if ka == nil {
+		return
+	}
+	ka.recordDial(err)
+}
+
+// RecordConnected implements AddrBook - it records the services the peer
+// advertised when it connected.
+func (a *addrBook) RecordConnected(id p2p.ID, services PeerServices) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	ka := a.addrLookup[id]
+	if ka == nil {
+		return
+	}
+	ka.Services = services
+}
+
+// RecordDisconnected implements AddrBook - it records how long the connection
+// to the peer lasted, and the reason it was stopped for, nil if gracefully.
+func (a *addrBook) RecordDisconnected(id p2p.ID, duration time.Duration, reason interface{}) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	ka := a.addrLookup[id]
+	if ka == nil {
+		return
+	}
+	ka.recordConn(duration, reason)
+}
+
+// RecordRTT implements AddrBook - it records a round-trip time to the peer.
+func (a *addrBook) RecordRTT(id p2p.ID, rtt time.Duration) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	ka := a.addrLookup[id]
+	if ka == nil || rtt <= 0 {
+		return
+	}
+	ka.recordRTT(rtt)
+}
+
+// HealthScore implements AddrBook - it scores the health of the address from
+// its records, the rate of successful dials and stable connections, the
+// round-trip time and the services of the peer. The addresses not in the book
+// have the score of an address without records.
+func (a *addrBook) HealthScore(addr *p2p.NetAddress) int {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	ka := a.addrLookup[addr.ID]
+	if ka == nil {
+		ka = &knownAddress{}
+	}
+	return ka.healthScore()
+}
+
+// Stats implements AddrBook - it returns the stats of the addresses in the
+// book and of the banned ones, the healthiest first.
+func (a *addrBook) Stats() []AddrStats {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	stats := make([]AddrStats, 0, len(a.addrLookup)+len(a.badPeers))
+	addStats := func(ka *knownAddress, banned bool) {
+		stats = append(stats, AddrStats{
+			Addr:        ka.Addr,
+			Src:         ka.Src,
+			Old:         ka.isOld(),
+			Banned:      banned,
+			Attempts:    ka.Attempts,
+			LastAttempt: ka.LastAttempt,
+			LastSuccess: ka.LastSuccess,
+			Dials:       append([]DialOutcome(nil), ka.Dials...),
+			Conns:       append([]ConnOutcome(nil), ka.Conns...),
+			RTT:         ka.RTT,
+			Services:    ka.Services,
+			HealthScore: ka.healthScore(),
+		})
+	}
+	for _, ka := range a.addrLookup {
+		addStats(ka, false)
+	}
+	for _, ka := range a.badPeers {
+		addStats(ka, true)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].HealthScore != stats[j].HealthScore {
+			return stats[i].HealthScore > stats[j].HealthScore
+		}
+		return stats[i].Addr.ID < stats[j].Addr.ID
+	})
+	return stats
+}
+
 // GetSelection implements AddrBook.
 // It randomly selects some addresses (old & new). Suitable for peer-exchange protocols.
 // Must never return a nil address.
@@ -2,6 +2,7 @@ package pex
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math"
 	"net"
@@ -710,6 +711,65 @@ func TestAddrBookGroupKey(t *testing.T) {
 	}
 }
 
+func TestAddrBookHealth(t *testing.T) {
+	fname := createTempFileName("addrbook_test")
+	defer deleteTempFile(fname)
+
+	book := NewAddrBook(fname, true)
+	book.SetLogger(log.TestingLogger())
+	randAddrs := randNetAddressPairs(t, 3)
+	for _, addrSrc := range randAddrs {
+		require.NoError(t, book.AddAddress(addrSrc.addr, addrSrc.src))
+	}
+	healthy, unhealthy, unknown := randAddrs[0].addr, randAddrs[1].addr, randAddrs[2].addr
+
+	// an address without records scores half of the rates and of the RTT
+	assert.Equal(t, 45, book.HealthScore(unknown))
+	assert.Equal(t, 45, book.HealthScore(randIPv4Address(t)))
+
+	book.RecordDial(healthy, nil)
+	book.RecordConnected(healthy.ID, PeerServices{Blocks: true, Snapshots: true})
+	book.RecordRTT(healthy.ID, 50*time.Millisecond)
+	book.RecordDisconnected(healthy.ID, time.Hour, errors.New("EOF"))
+	assert.Equal(t, maxHealthScore, book.HealthScore(healthy))
+
+	for i := 0; i < maxDialHistory+2; i++ {
+		book.RecordDial(unhealthy, errors.New("i/o timeout"))
+	}
+	book.RecordDisconnected(unhealthy.ID, time.Second, errors.New("EOF"))
+	book.RecordRTT(unhealthy.ID, 2*time.Second)
+	assert.Zero(t, book.HealthScore(unhealthy))
+
+	// the RTT is smoothed
+	book.RecordRTT(unhealthy.ID, 400*time.Millisecond)
+	assert.Equal(t, 1800*time.Millisecond, book.(*addrBook).addrLookup[unhealthy.ID].RTT)
+
+	stats := book.Stats()
+	require.Len(t, stats, 3)
+	assert.Equal(t, healthy, stats[0].Addr)
+	assert.Equal(t, PeerServices{Blocks: true, Snapshots: true}, stats[0].Services)
+	require.Len(t, stats[0].Conns, 1)
+	assert.Equal(t, "EOF", stats[0].Conns[0].Error)
+	assert.Equal(t, unknown, stats[1].Addr)
+	assert.Equal(t, unhealthy, stats[2].Addr)
+	assert.Len(t, stats[2].Dials, maxDialHistory)
+
+	// the records are saved
+	book.Save()
+	book = NewAddrBook(fname, true)
+	book.SetLogger(log.TestingLogger())
+	require.NoError(t, book.Start())
+	defer book.Stop() //nolint:errcheck // ignore for tests
+	assert.Equal(t, maxHealthScore, book.HealthScore(healthy))
+	assert.Equal(t, stats[2].Dials[0].Error, book.Stats()[2].Dials[0].Error)
+
+	// the banned addresses are dumped too
+	book.MarkBad(unhealthy, time.Hour)
+	stats = book.Stats()
+	require.Len(t, stats, 3)
+	assert.True(t, stats[2].Banned)
+}
+
 func assertMOldAndNNewAddrsInSelection(t *testing.T, m, n int, addrs []*p2p.NetAddress, book *addrBook) {
 	nOld, nNew := countOldAndNewAddrsInSelection(addrs, book)
 	assert.Equal(t, m, nOld, "old addresses")
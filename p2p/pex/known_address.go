@@ -1,6 +1,8 @@
 package pex
 
 import (
+	"fmt"
+	"math"
 	"time"
 
 	"github.com/cometbft/cometbft/p2p"
@@ -17,6 +19,34 @@ type knownAddress struct {
 	LastAttempt time.Time       `json:"last_attempt"`
 	LastSuccess time.Time       `json:"last_success"`
 	LastBanTime time.Time       `json:"last_ban_time"`
+
+	// Health of the address, recorded by the PEX reactor: the outcomes of the
+	// last dials and connections, the round-trip time to the peer, and the
+	// services it advertised when last connected.
+	Dials    []DialOutcome `json:"dials,omitempty"`
+	Conns    []ConnOutcome `json:"conns,omitempty"`
+	RTT      time.Duration `json:"rtt,omitempty"`
+	Services PeerServices  `json:"services"`
+}
+
+// DialOutcome is the outcome of a dial of an address.
+type DialOutcome struct {
+	Time  time.Time `json:"time"`
+	Error string    `json:"error,omitempty"` // empty if the peer was dialed
+}
+
+// ConnOutcome is the outcome of a connection to a peer, once it ended.
+type ConnOutcome struct {
+	Time     time.Time     `json:"time"` // when the connection ended
+	Duration time.Duration `json:"duration"`
+	Error    string        `json:"error,omitempty"` // empty if the peer was stopped gracefully
+}
+
+// PeerServices are the services a peer advertises in its NodeInfo, with the
+// channels of their reactors.
+type PeerServices struct {
+	Blocks    bool `json:"blocks"`
+	Snapshots bool `json:"snapshots"`
 }
 
 func newKnownAddress(addr *p2p.NetAddress, src *p2p.NetAddress) *knownAddress {
@@ -55,6 +85,87 @@ func (ka *knownAddress) markGood() {
 	ka.LastSuccess = now
 }
 
+func (ka *knownAddress) recordDial(err error) {
+	outcome := DialOutcome{Time: time.Now()}
+	if err != nil {
+		outcome.Error = err.Error()
+	}
+	ka.Dials = append(ka.Dials, outcome)
+	if len(ka.Dials) > maxDialHistory {
+		ka.Dials = ka.Dials[len(ka.Dials)-maxDialHistory:]
+	}
+}
+
+func (ka *knownAddress) recordConn(duration time.Duration, reason interface{}) {
+	outcome := ConnOutcome{Time: time.Now(), Duration: duration}
+	if reason != nil {
+		outcome.Error = fmt.Sprint(reason)
+	}
+	ka.Conns = append(ka.Conns, outcome)
+	if len(ka.Conns) > maxConnHistory {
+		ka.Conns = ka.Conns[len(ka.Conns)-maxConnHistory:]
+	}
+}
+
+// recordRTT smooths the round-trip time like TCP, see RFC 6298.
+func (ka *knownAddress) recordRTT(rtt time.Duration) {
+	if ka.RTT == 0 {
+		ka.RTT = rtt
+		return
+	}
+	ka.RTT = (7*ka.RTT + rtt) / 8
+}
+
+// healthScore scores the health of the address between 0 and
+// maxHealthScore, from the rate of successful dials, the rate of stable
+// connections, the round-trip time and the services of the peer. Without any
+// records, the rates and the round-trip time count for half.
+func (ka *knownAddress) healthScore() int {
+	dialRate := 0.5
+	if len(ka.Dials) > 0 {
+		dialed := 0
+		for _, dial := range ka.Dials {
+			if dial.Error == "" {
+				dialed++
+			}
+		}
+		dialRate = float64(dialed) / float64(len(ka.Dials))
+	}
+
+	// a connection is stable if it lasted long enough, or we stopped it
+	connRate := 0.5
+	if len(ka.Conns) > 0 {
+		stable := 0
+		for _, conn := range ka.Conns {
+			if conn.Error == "" || conn.Duration >= minStableConnDuration {
+				stable++
+			}
+		}
+		connRate = float64(stable) / float64(len(ka.Conns))
+	}
+
+	rttRate := 0.5
+	switch {
+	case ka.RTT == 0:
+	case ka.RTT <= goodRTT:
+		rttRate = 1
+	case ka.RTT >= badRTT:
+		rttRate = 0
+	default:
+		rttRate = float64(badRTT-ka.RTT) / float64(badRTT-goodRTT)
+	}
+
+	servicesRate := 0.0
+	if ka.Services.Blocks {
+		servicesRate += 0.5
+	}
+	if ka.Services.Snapshots {
+		servicesRate += 0.5
+	}
+
+	return int(math.Round(maxHealthScore * (0.4*dialRate + 0.3*connRate + 0.2*rttRate + 0.1*servicesRate)))
+}
+
 func (ka *knownAddress) ban(banTime time.Duration) {
 	if ka.LastBanTime.Before(time.Now().Add(banTime)) {
 		ka.LastBanTime = time.Now().Add(banTime)
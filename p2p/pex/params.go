@@ -52,4 +52,22 @@ const (
 	// max addresses returned by GetSelection
 	// NOTE: this must match "maxMsgSize"
 	maxGetSelection = 250
+
+	// outcomes of the last dials of an address kept for its health score.
+	maxDialHistory = 10
+
+	// outcomes of the last connections to an address kept for its health score.
+	maxConnHistory = 10
+
+	// connections which ended with an error before lasting this long count
+	// against the health score.
+	minStableConnDuration = 10 * time.Minute
+
+	// round-trip times up to which the health score is the highest, and from
+	// which it is the lowest.
+	goodRTT = 100 * time.Millisecond
+	badRTT  = time.Second
+
+	// max health score of an address.
+	maxHealthScore = 100
 )
@@ -1,8 +1,10 @@
 package pex
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -50,6 +52,11 @@ const (
 
 	// if a peer is marked bad, it will be banned for at least this time period
 	defaultBanTime = 24 * time.Hour
+
+	// channels of the reactors of the services recorded in the address book,
+	// see blocksync.BlocksyncChannel and statesync.SnapshotChannel.
+	blocksyncChannel = byte(0x40)
+	snapshotChannel  = byte(0x60)
 )
 
 type errMaxAttemptsToDial struct {
@@ -213,13 +220,32 @@ func (r *Reactor) AddPeer(p Peer) {
 		err = r.book.AddAddress(addr, src)
 		r.logErrAddrBook(err)
 	}
+
+	r.book.RecordConnected(p.ID(), peerServices(p))
 }
 
-// RemovePeer implements Reactor by resetting peer's requests info.
+// RemovePeer implements Reactor by resetting peer's requests info, and
+// recording the outcome of the connection in the address book.
 func (r *Reactor) RemovePeer(p Peer, reason interface{}) {
 	id := string(p.ID())
 	r.requestsSent.Delete(id)
 	r.lastReceivedRequests.Delete(id)
+
+	status := p.Status()
+	r.book.RecordRTT(p.ID(), status.RTT)
+	r.book.RecordDisconnected(p.ID(), status.Duration, reason)
+}
+
+// peerServices returns the services the peer advertises in its NodeInfo.
+func peerServices(p Peer) PeerServices {
+	nodeInfo, ok := p.NodeInfo().(p2p.DefaultNodeInfo)
+	if !ok {
+		return PeerServices{}
+	}
+	return PeerServices{
+		Blocks:    bytes.Contains(nodeInfo.Channels, []byte{blocksyncChannel}),
+		Snapshots: bytes.Contains(nodeInfo.Channels, []byte{snapshotChannel}),
+	}
 }
 
 func (r *Reactor) logErrAddrBook(err error) {
@@ -463,6 +489,11 @@ func (r *Reactor) ensurePeers() {
 		"numToDial", numToDial,
 	)
 
+	// record the round-trip times to the peers, measured with pings
+	for _, peer := range r.Switch.Peers().List() {
+		r.book.RecordRTT(peer.ID(), peer.Status().RTT)
+	}
+
 	if numToDial <= 0 {
 		return
 	}
@@ -473,10 +504,11 @@ func (r *Reactor) ensurePeers() {
 	newBias := cmtmath.MinInt(out, 8)*10 + 10
 
 	toDial := make(map[p2p.ID]*p2p.NetAddress)
-	// Try maxAttempts times to pick numToDial addresses to dial
+	// Try maxAttempts times to pick addresses to dial, then dial the
+	// numToDial preferred ones, see dialPriority.
 	maxAttempts := numToDial * 3
 
-	for i := 0; i < maxAttempts && len(toDial) < numToDial; i++ {
+	for i := 0; i < maxAttempts; i++ {
 		try := r.book.PickAddress(newBias)
 		if try == nil {
 			continue
@@ -484,7 +516,7 @@ func (r *Reactor) ensurePeers() {
 		if _, selected := toDial[try.ID]; selected {
 			continue
 		}
-		if r.Switch.IsDialingOrExistingAddress(try) {
+		if r.Switch.IsDialingOrExistingAddress(try) || r.Switch.IsPeerBanned(try.ID) {
 			continue
 		}
 		// TODO: consider moving some checks from toDial into here
@@ -494,7 +526,7 @@ func (r *Reactor) ensurePeers() {
 	}
 
 	// Dial picked addresses
-	for _, addr := range toDial {
+	for _, addr := range r.dialPriority(toDial, numToDial) {
 		go func(addr *p2p.NetAddress) {
 			err := r.dialPeer(addr)
 			if err != nil {
@@ -534,6 +566,60 @@ func (r *Reactor) ensurePeers() {
 	}
 }
 
+// dialPriority returns up to numToDial of the picked addresses, those of the
+// peers with the highest trust scores first, then the healthiest ones, as
+// scored by the address book. To connect to diverse peers, the addresses in
+// the network groups of the peers, or of the addresses picked before them,
+// come after the others.
+func (r *Reactor) dialPriority(toDial map[p2p.ID]*p2p.NetAddress, numToDial int) []*p2p.NetAddress {
+	addrs := make([]*p2p.NetAddress, 0, len(toDial))
+	scores := make(map[p2p.ID]int, len(toDial))
+	health := make(map[p2p.ID]int, len(toDial))
+	for id, addr := range toDial {
+		addrs = append(addrs, addr)
+		if score, ok := r.Switch.PeerTrustScore(id); ok {
+			scores[id] = score
+		} else {
+			scores[id] = p2p.MaxTrustScore
+		}
+		health[id] = r.book.HealthScore(addr)
+	}
+	sort.SliceStable(addrs, func(i, j int) bool {
+		if scores[addrs[i].ID] != scores[addrs[j].ID] {
+			return scores[addrs[i].ID] > scores[addrs[j].ID]
+		}
+		return health[addrs[i].ID] > health[addrs[j].ID]
+	})
+
+	groups := make(map[string]struct{})
+	for _, peer := range r.Switch.Peers().List() {
+		if addr := peer.SocketAddr(); addr != nil {
+			groups[groupKeyFor(addr, false)] = struct{}{}
+		}
+	}
+	selection := make([]*p2p.NetAddress, 0, cmtmath.MinInt(numToDial, len(addrs)))
+	var sameGroup []*p2p.NetAddress
+	for _, addr := range addrs {
+		if len(selection) == numToDial {
+			break
+		}
+		group := groupKeyFor(addr, false)
+		if _, ok := groups[group]; ok {
+			sameGroup = append(sameGroup, addr)
+			continue
+		}
+		groups[group] = struct{}{}
+		selection = append(selection, addr)
+	}
+	for _, addr := range sameGroup {
+		if len(selection) == numToDial {
+			break
+		}
+		selection = append(selection, addr)
+	}
+	return selection
+}
+
 func (r *Reactor) dialAttemptsInfo(addr *p2p.NetAddress) (attempts int, lastDialed time.Time) {
 	_attempts, ok := r.attemptsToDial.Load(addr.DialString())
 	if !ok {
@@ -562,6 +648,9 @@ func (r *Reactor) dialPeer(addr *p2p.NetAddress) error {
 	}
 
 	err := r.Switch.DialPeerWithAddress(addr)
+	if _, ok := err.(p2p.ErrCurrentlyDialingOrExistingAddress); !ok {
+		r.book.RecordDial(addr, err)
+	}
 	if err != nil {
 		if _, ok := err.(p2p.ErrCurrentlyDialingOrExistingAddress); ok {
 			return err
@@ -2,7 +2,9 @@ package pex
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"testing"
@@ -12,10 +14,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	dbm "github.com/cometbft/cometbft-db"
+
 	"github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/p2p/mock"
+	"github.com/cometbft/cometbft/p2p/trust"
 	tmp2p "github.com/cometbft/cometbft/proto/tendermint/p2p"
 )
 
@@ -49,6 +54,12 @@ func TestPEXReactorAddRemovePeer(t *testing.T) {
 
 	r.RemovePeer(peer, "peer not available")
 
+	// the outcome of the connection is recorded
+	stats := book.Stats()
+	require.Len(t, stats, size+1)
+	require.Len(t, stats[0].Conns, 1)
+	assert.Equal(t, "peer not available", stats[0].Conns[0].Error)
+
 	outboundPeer := p2p.CreateRandomPeer(true)
 
 	r.AddPeer(outboundPeer)
@@ -532,6 +543,59 @@ func TestPEXReactorDialPeer(t *testing.T) {
 	}
 }
 
+func TestPEXReactorDialPriority(t *testing.T) {
+	pexR, book := createReactor(&ReactorConfig{})
+	defer teardownReactor(book)
+
+	store := trust.NewTrustMetricStore(dbm.NewMemDB(), trust.DefaultConfig())
+	store.SetLogger(log.TestingLogger())
+	require.NoError(t, store.Start())
+	t.Cleanup(func() {
+		if err := store.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	sw := p2p.MakeSwitch(cfg, 0, "127.0.0.1", "123.123.123",
+		func(i int, sw *p2p.Switch) *p2p.Switch { return sw }, p2p.WithTrustMetricStore(store))
+	sw.AddReactor(pexR.String(), pexR)
+
+	bad, average, unknown := mock.NewPeer(nil).SocketAddr(), mock.NewPeer(nil).SocketAddr(), mock.NewPeer(nil).SocketAddr()
+	store.GetPeerTrustMetric(string(bad.ID)).BadEvents(1)
+	store.GetPeerTrustMetric(string(average.ID)).GoodEvents(1)
+	store.GetPeerTrustMetric(string(average.ID)).BadEvents(1)
+
+	toDial := map[p2p.ID]*p2p.NetAddress{bad.ID: bad, average.ID: average, unknown.ID: unknown}
+	assert.Equal(t, []*p2p.NetAddress{unknown, average}, pexR.dialPriority(toDial, 2))
+	assert.Equal(t, []*p2p.NetAddress{unknown, average, bad}, pexR.dialPriority(toDial, 5))
+}
+
+func TestPEXReactorDialPriorityHealth(t *testing.T) {
+	pexR, book := createReactor(&ReactorConfig{})
+	defer teardownReactor(book)
+	sw := createSwitchAndAddReactors(pexR)
+	sw.SetAddrBook(book)
+
+	healthy := mock.NewPeer(net.IP{1, 2, 3, 4}).SocketAddr()
+	sameGroup := mock.NewPeer(net.IP{1, 2, 5, 6}).SocketAddr()
+	unknown := mock.NewPeer(net.IP{5, 6, 7, 8}).SocketAddr()
+	unhealthy := mock.NewPeer(net.IP{9, 10, 11, 12}).SocketAddr()
+	for _, addr := range []*p2p.NetAddress{healthy, sameGroup, unknown, unhealthy} {
+		require.NoError(t, book.AddAddress(addr, addr))
+	}
+	book.RecordDial(healthy, nil)
+	book.RecordRTT(healthy.ID, 50*time.Millisecond)
+	book.RecordDial(sameGroup, nil)
+	book.RecordRTT(sameGroup.ID, 200*time.Millisecond)
+	book.RecordDial(unhealthy, errors.New("i/o timeout"))
+
+	toDial := map[p2p.ID]*p2p.NetAddress{
+		healthy.ID: healthy, sameGroup.ID: sameGroup, unknown.ID: unknown, unhealthy.ID: unhealthy,
+	}
+	// the healthiest first, in different network groups first
+	assert.Equal(t, []*p2p.NetAddress{healthy, unknown}, pexR.dialPriority(toDial, 2))
+	assert.Equal(t, []*p2p.NetAddress{healthy, unknown, unhealthy, sameGroup}, pexR.dialPriority(toDial, 4))
+}
+
 func assertPeersWithTimeout(
 	t *testing.T,
 	switches []*p2p.Switch,
@@ -0,0 +1,426 @@
+package p2p
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+
+	"github.com/quic-go/quic-go"
+
+	"github.com/cometbft/cometbft/config"
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p/conn"
+)
+
+const (
+	// ALPN protocol of the QUIC connections between nodes
+	quicProtocol = "cometbft-p2p"
+
+	// maximum number of streams a peer can open: the one the NodeInfos are
+	// exchanged on, and one per channel.
+	quicMaxStreams    = 1
+	quicMaxUniStreams = maxNumChannels
+
+	// time after which a peer which didn't advertise QUIC is dialed over QUIC
+	// again, in case it was upgraded.
+	tcpOnlyTimeout = time.Hour
+)
+
+// quicConn is a QUIC connection to a peer, with the stream the NodeInfos are
+// exchanged on, so it can be filtered and upgraded like a TCP connection. The
+// channels are on their own streams, see conn.QUICConnection.
+type quicConn struct {
+	quic.Stream
+	conn quic.Connection
+}
+
+var _ net.Conn = (*quicConn)(nil)
+
+// LocalAddr implements net.Conn.
+func (c *quicConn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+// RemoteAddr implements net.Conn.
+func (c *quicConn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+// Close implements net.Conn, closing the whole connection.
+func (c *quicConn) Close() error {
+	return c.conn.CloseWithError(0, "closed")
+}
+
+// QUICTransport accepts and dials QUIC connections, on UDP at the port of its
+// listening address, and upgrades them to peers with a stream per channel. It
+// also accepts TCP connections, and dials the peers which don't advertise QUIC
+// in their NodeInfos over TCP, like the MultiplexTransport it extends.
+//
+// The peers are authenticated with TLS 1.3, with self-signed certificates of
+// their node keys, which must be ed25519 keys.
+type QUICTransport struct {
+	*MultiplexTransport
+
+	tlsConfig  *tls.Config
+	quicConfig *quic.Config
+
+	udpConn  net.PacketConn
+	tr       *quic.Transport
+	listener *quic.Listener
+
+	// IDs of the peers which don't advertise QUIC, with the time they're
+	// dialed over TCP until.
+	mtx     cmtsync.Mutex
+	tcpOnly map[ID]time.Time
+}
+
+// Test QUICTransport for interface completeness.
+var _ Transport = (*QUICTransport)(nil)
+var _ transportLifecycle = (*QUICTransport)(nil)
+
+// NewQUICTransport returns a QUIC transport for the node. The
+// MultiplexTransportOptions of its MultiplexTransport apply to the TCP and
+// QUIC connections alike, the maximum number of incoming connections to each
+// of them. It returns an error if the node key isn't an ed25519 key.
+func NewQUICTransport(
+	nodeInfo NodeInfo,
+	nodeKey NodeKey,
+	mConfig conn.MConnConfig,
+) (*QUICTransport, error) {
+	cert, err := quicCertificate(nodeKey)
+	if err != nil {
+		return nil, err
+	}
+	return &QUICTransport{
+		MultiplexTransport: NewMultiplexTransport(nodeInfo, nodeKey, mConfig),
+		tlsConfig: &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			// the peers are authenticated by their IDs, not by a CA
+			ClientAuth:            tls.RequireAnyClientCert,
+			InsecureSkipVerify:    true, //nolint:gosec
+			VerifyPeerCertificate: verifyQUICCertificate,
+			NextProtos:            []string{quicProtocol},
+			MinVersion:            tls.VersionTLS13,
+		},
+		quicConfig: &quic.Config{
+			HandshakeIdleTimeout:  defaultHandshakeTimeout,
+			MaxIdleTimeout:        mConfig.PingInterval + mConfig.PongTimeout,
+			KeepAlivePeriod:       mConfig.PingInterval,
+			MaxIncomingStreams:    quicMaxStreams,
+			MaxIncomingUniStreams: quicMaxUniStreams,
+		},
+		tcpOnly: make(map[ID]time.Time),
+	}, nil
+}
+
+// Accept implements Transport, recording whether the peer advertises QUIC.
+func (qt *QUICTransport) Accept(cfg peerConfig) (Peer, error) {
+	p, err := qt.MultiplexTransport.Accept(cfg)
+	if err != nil {
+		return nil, err
+	}
+	qt.recordTransports(p.NodeInfo())
+	return p, nil
+}
+
+// Dial implements Transport. Unless it didn't advertise QUIC lately, the peer
+// is dialed over QUIC first, then over TCP if it can't be dialed.
+func (qt *QUICTransport) Dial(
+	addr NetAddress,
+	cfg peerConfig,
+) (Peer, error) {
+	if !qt.isTCPOnly(addr.ID) {
+		p, err := qt.dialQUIC(addr, cfg)
+		if err == nil {
+			qt.recordTransports(p.NodeInfo())
+			return p, nil
+		}
+		if _, ok := err.(ErrRejected); ok {
+			return nil, err
+		}
+	}
+
+	p, err := qt.MultiplexTransport.Dial(addr, cfg)
+	if err != nil {
+		return nil, err
+	}
+	qt.recordTransports(p.NodeInfo())
+	return p, nil
+}
+
+// isTCPOnly returns true if the peer didn't advertise QUIC in the last
+// tcpOnlyTimeout.
+func (qt *QUICTransport) isTCPOnly(id ID) bool {
+	qt.mtx.Lock()
+	defer qt.mtx.Unlock()
+
+	until, ok := qt.tcpOnly[id]
+	if ok && time.Now().After(until) {
+		delete(qt.tcpOnly, id)
+		return false
+	}
+	return ok
+}
+
+// recordTransports records whether the peer advertises QUIC in its NodeInfo,
+// for it to be dialed over TCP if it doesn't.
+func (qt *QUICTransport) recordTransports(nodeInfo NodeInfo) {
+	ni, ok := nodeInfo.(DefaultNodeInfo)
+	if !ok {
+		return
+	}
+
+	qt.mtx.Lock()
+	defer qt.mtx.Unlock()
+
+	if ni.SupportsTransport(config.TransportQUIC) {
+		delete(qt.tcpOnly, ni.ID())
+	} else {
+		qt.tcpOnly[ni.ID()] = time.Now().Add(tcpOnlyTimeout)
+	}
+}
+
+// Close implements transportLifecycle.
+func (qt *QUICTransport) Close() error {
+	err := qt.MultiplexTransport.Close()
+	if qt.listener != nil {
+		if lerr := qt.listener.Close(); err == nil {
+			err = lerr
+		}
+	}
+	if qt.tr != nil {
+		if terr := qt.tr.Close(); err == nil {
+			err = terr
+		}
+		if cerr := qt.udpConn.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// Listen implements transportLifecycle, listening for TCP connections on the
+// address, and for QUIC connections on UDP at the same port.
+func (qt *QUICTransport) Listen(addr NetAddress) error {
+	udpConn, err := net.ListenPacket("udp", addr.DialString())
+	if err != nil {
+		return err
+	}
+	if addr.Port == 0 {
+		// listen for TCP connections at the port picked for UDP
+		addr.Port = uint16(udpConn.LocalAddr().(*net.UDPAddr).Port)
+	}
+	if err := qt.MultiplexTransport.Listen(addr); err != nil {
+		_ = udpConn.Close()
+		return err
+	}
+
+	qt.udpConn = udpConn
+	qt.tr = &quic.Transport{Conn: udpConn}
+	qt.listener, err = qt.tr.Listen(qt.tlsConfig, qt.quicConfig)
+	if err != nil {
+		return err
+	}
+
+	go qt.acceptQUICPeers()
+
+	return nil
+}
+
+func (qt *QUICTransport) dialQUIC(addr NetAddress, cfg peerConfig) (Peer, error) {
+	if qt.tr == nil {
+		return nil, errors.New("not listening for QUIC connections")
+	}
+	udpAddr, err := net.ResolveUDPAddr("udp", addr.DialString())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), qt.dialTimeout)
+	defer cancel()
+	qconn, err := qt.tr.Dial(ctx, udpAddr, qt.tlsConfig, qt.quicConfig)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := qconn.OpenStreamSync(ctx)
+	if err != nil {
+		_ = qconn.CloseWithError(0, "closed")
+		return nil, err
+	}
+	c := &quicConn{Stream: stream, conn: qconn}
+
+	// TODO(xla): Evaluate if we should apply filters if we explicitly dial.
+	if err := qt.filterConn(c); err != nil {
+		return nil, err
+	}
+
+	nodeInfo, err := qt.upgradeQUIC(c, &addr)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.outbound = true
+
+	return qt.wrapPeer(c, nodeInfo, cfg, &addr), nil
+}
+
+func (qt *QUICTransport) acceptQUICPeers() {
+	// limit the number of simultaneous QUIC connections, like the
+	// LimitListener of the TCP connections.
+	var sem chan struct{}
+	if qt.maxIncomingConnections > 0 {
+		sem = make(chan struct{}, qt.maxIncomingConnections)
+	}
+
+	for {
+		if sem != nil {
+			select {
+			case sem <- struct{}{}:
+			case <-qt.closec:
+				return
+			}
+		}
+
+		qconn, err := qt.listener.Accept(context.Background())
+		if err != nil {
+			// If Close() has been called, silently exit.
+			select {
+			case _, ok := <-qt.closec:
+				if !ok {
+					return
+				}
+			default:
+				// Transport is not closed
+			}
+
+			qt.acceptc <- accept{err: err}
+			return
+		}
+		if sem != nil {
+			go func() {
+				<-qconn.Context().Done()
+				<-sem
+			}()
+		}
+
+		// Connection upgrade and filtering should be asynchronous to avoid
+		// Head-of-line blocking, see MultiplexTransport.acceptPeers.
+		go func(qconn quic.Connection) {
+			var (
+				c        net.Conn
+				nodeInfo NodeInfo
+				netAddr  *NetAddress
+			)
+
+			ctx, cancel := context.WithTimeout(context.Background(), qt.handshakeTimeout)
+			stream, err := qconn.AcceptStream(ctx)
+			cancel()
+			if err == nil {
+				qc := &quicConn{Stream: stream, conn: qconn}
+				c = qc
+				err = qt.filterConn(c)
+				if err == nil {
+					nodeInfo, err = qt.upgradeQUIC(qc, nil)
+					if err == nil {
+						netAddr = NewNetAddress(nodeInfo.ID(), c.RemoteAddr())
+					}
+				}
+			} else {
+				_ = qconn.CloseWithError(0, "closed")
+			}
+
+			select {
+			case qt.acceptc <- accept{netAddr, c, nodeInfo, err}:
+				// Make the upgraded peer available.
+			case <-qt.closec:
+				// Give up if the transport was closed.
+				_ = qconn.CloseWithError(0, "closed")
+				return
+			}
+		}(qconn)
+	}
+}
+
+// upgradeQUIC authenticates the peer with its TLS certificate, then exchanges
+// the NodeInfos with it, like upgrade with a secret connection.
+func (qt *QUICTransport) upgradeQUIC(
+	c *quicConn,
+	dialedAddr *NetAddress,
+) (nodeInfo NodeInfo, err error) {
+	defer func() {
+		if err != nil {
+			_ = qt.cleanup(c)
+		}
+	}()
+
+	connID, err := quicPeerID(c.conn)
+	if err != nil {
+		return nil, ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("quic conn failed: %v", err),
+			isAuthFailure: true,
+		}
+	}
+
+	return qt.handshakePeer(c, c, connID, dialedAddr)
+}
+
+//-----------------------------------------------------------------------------
+
+// quicCertificate returns a self-signed TLS certificate of the node key.
+func quicCertificate(nodeKey NodeKey) (tls.Certificate, error) {
+	privKey, ok := nodeKey.PrivKey.(cmted25519.PrivKey)
+	if !ok {
+		return tls.Certificate{}, fmt.Errorf("the QUIC transport needs an ed25519 node key, got %v", nodeKey.PrivKey.Type())
+	}
+	key := ed25519.PrivateKey(privKey)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(100, 0, 0),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
+
+// verifyQUICCertificate checks the peer presented a single certificate of an
+// ed25519 key. TLS checks the peer holds the key, and the ID of the peer is
+// then derived from it.
+func verifyQUICCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) != 1 {
+		return fmt.Errorf("expected 1 certificate, got %v", len(rawCerts))
+	}
+	cert, err := x509.ParseCertificate(rawCerts[0])
+	if err != nil {
+		return err
+	}
+	if _, ok := cert.PublicKey.(ed25519.PublicKey); !ok {
+		return fmt.Errorf("expected an ed25519 key, got %T", cert.PublicKey)
+	}
+	return nil
+}
+
+// quicPeerID returns the ID of the peer, authenticated by its TLS certificate.
+func quicPeerID(qconn quic.Connection) (ID, error) {
+	certs := qconn.ConnectionState().TLS.PeerCertificates
+	if len(certs) != 1 {
+		return "", errors.New("missing peer certificate")
+	}
+	pubKey, ok := certs[0].PublicKey.(ed25519.PublicKey)
+	if !ok {
+		return "", fmt.Errorf("expected an ed25519 key, got %T", certs[0].PublicKey)
+	}
+	return PubKeyToID(cmted25519.PubKey(pubKey)), nil
+}
@@ -0,0 +1,266 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/p2p/conn"
+	p2pproto "github.com/cometbft/cometbft/proto/tendermint/p2p"
+)
+
+// testSetupQUICTransport returns a QUIC transport listening on loopback.
+func testSetupQUICTransport(t *testing.T) *QUICTransport {
+	pv := ed25519.GenPrivKey()
+	id := PubKeyToID(pv.PubKey())
+	ni := testNodeInfo(id, "transport").(DefaultNodeInfo)
+	ni.Transports = []string{config.TransportTCP, config.TransportQUIC}
+	qt, err := NewQUICTransport(ni, NodeKey{PrivKey: pv}, conn.DefaultMConnConfig())
+	require.NoError(t, err)
+
+	addr, err := NewNetAddressString(IDAddressString(id, "127.0.0.1:0"))
+	require.NoError(t, err)
+	require.NoError(t, qt.Listen(*addr))
+	t.Cleanup(func() { _ = qt.Close() })
+	return qt
+}
+
+func TestQUICTransportDialAccept(t *testing.T) {
+	qt := testSetupQUICTransport(t)
+	dialer := testSetupQUICTransport(t)
+
+	acceptc := make(chan Peer)
+	go func() {
+		p, err := qt.Accept(peerConfig{})
+		if err != nil {
+			t.Error(err)
+		}
+		acceptc <- p
+	}()
+
+	addr := qt.NetAddress()
+	p, err := dialer.Dial(addr, peerConfig{})
+	require.NoError(t, err)
+	t.Cleanup(func() { dialer.Cleanup(p) })
+	assert.True(t, p.IsOutbound())
+	assert.Equal(t, qt.nodeKey.ID(), p.ID())
+	assert.IsType(t, &quicConn{}, p.(*peer).conn)
+	assert.IsType(t, &conn.QUICConnection{}, p.(*peer).mconn)
+	assert.True(t, p.NodeInfo().(DefaultNodeInfo).SupportsTransport(config.TransportQUIC))
+
+	var inbound Peer
+	select {
+	case inbound = <-acceptc:
+	case <-time.After(time.Second):
+		t.Fatal("the peer wasn't accepted")
+	}
+	require.NotNil(t, inbound)
+	t.Cleanup(func() { qt.Cleanup(inbound) })
+	assert.False(t, inbound.IsOutbound())
+	assert.Equal(t, dialer.nodeKey.ID(), inbound.ID())
+	assert.IsType(t, &quicConn{}, inbound.(*peer).conn)
+	assert.Equal(t, uint16(dialer.NetAddress().Port), uint16(inbound.SocketAddr().Port))
+}
+
+func TestQUICTransportDialRejectWrongID(t *testing.T) {
+	qt := testSetupQUICTransport(t)
+	dialer := testSetupQUICTransport(t)
+
+	addr := qt.NetAddress()
+	addr.ID = PubKeyToID(ed25519.GenPrivKey().PubKey())
+	_, err := dialer.Dial(addr, peerConfig{})
+	require.Error(t, err)
+	require.IsType(t, ErrRejected{}, err)
+	assert.True(t, err.(ErrRejected).IsAuthFailure())
+
+	// the peer isn't dialed over TCP after a rejection
+	assert.Empty(t, dialer.tcpOnly)
+}
+
+func TestQUICTransportFallbackToTCP(t *testing.T) {
+	mt := testSetupMultiplexTransport(t)
+	t.Cleanup(func() { _ = mt.Close() })
+	dialer := testSetupQUICTransport(t)
+
+	go func() {
+		for {
+			if _, err := mt.Accept(peerConfig{}); err != nil {
+				return
+			}
+		}
+	}()
+
+	addr := NewNetAddress(mt.nodeKey.ID(), mt.listener.Addr())
+	p, err := dialer.Dial(*addr, peerConfig{})
+	require.NoError(t, err)
+	assert.IsType(t, &conn.SecretConnection{}, p.(*peer).conn)
+	assert.IsType(t, &conn.MConnection{}, p.(*peer).mconn)
+	assert.False(t, p.NodeInfo().(DefaultNodeInfo).SupportsTransport(config.TransportQUIC))
+	require.Contains(t, dialer.tcpOnly, mt.nodeKey.ID())
+	assert.WithinDuration(t, time.Now().Add(tcpOnlyTimeout), dialer.tcpOnly[mt.nodeKey.ID()], time.Minute)
+	dialer.Cleanup(p)
+
+	// the peer is dialed over QUIC again once the fallback expired
+	dialer.tcpOnly[mt.nodeKey.ID()] = time.Now().Add(-time.Second)
+	assert.False(t, dialer.isTCPOnly(mt.nodeKey.ID()))
+	assert.NotContains(t, dialer.tcpOnly, mt.nodeKey.ID())
+
+	p, err = dialer.Dial(*addr, peerConfig{})
+	require.NoError(t, err)
+	t.Cleanup(func() { dialer.Cleanup(p) })
+	assert.IsType(t, &conn.SecretConnection{}, p.(*peer).conn)
+	assert.True(t, dialer.isTCPOnly(mt.nodeKey.ID()))
+}
+
+func TestQUICTransportClearTCPOnly(t *testing.T) {
+	qt := testSetupQUICTransport(t)
+	dialer := testSetupQUICTransport(t)
+
+	go func() {
+		for {
+			if _, err := qt.Accept(peerConfig{}); err != nil {
+				return
+			}
+		}
+	}()
+
+	// the peer is dialed over TCP, and advertises QUIC
+	addr := qt.NetAddress()
+	dialer.tcpOnly[addr.ID] = time.Now().Add(tcpOnlyTimeout)
+	p, err := dialer.Dial(addr, peerConfig{})
+	require.NoError(t, err)
+	assert.IsType(t, &conn.SecretConnection{}, p.(*peer).conn)
+	assert.NotContains(t, dialer.tcpOnly, addr.ID)
+	dialer.Cleanup(p)
+
+	p, err = dialer.Dial(addr, peerConfig{})
+	require.NoError(t, err)
+	t.Cleanup(func() { dialer.Cleanup(p) })
+	assert.IsType(t, &quicConn{}, p.(*peer).conn)
+}
+
+func TestQUICTransportAcceptTCP(t *testing.T) {
+	qt := testSetupQUICTransport(t)
+	dialer := testSetupMultiplexTransport(t)
+	t.Cleanup(func() { _ = dialer.Close() })
+
+	acceptc := make(chan Peer)
+	go func() {
+		p, err := qt.Accept(peerConfig{})
+		if err != nil {
+			t.Error(err)
+		}
+		acceptc <- p
+	}()
+
+	p, err := dialer.Dial(qt.NetAddress(), peerConfig{})
+	require.NoError(t, err)
+	t.Cleanup(func() { dialer.Cleanup(p) })
+
+	inbound := <-acceptc
+	require.NotNil(t, inbound)
+	t.Cleanup(func() { qt.Cleanup(inbound) })
+	assert.IsType(t, &conn.SecretConnection{}, inbound.(*peer).conn)
+
+	// the peer doesn't advertise QUIC, so it's dialed over TCP
+	assert.True(t, qt.isTCPOnly(dialer.nodeKey.ID()))
+}
+
+func TestQUICTransportNodeKey(t *testing.T) {
+	pv := secp256k1.GenPrivKey()
+	ni := testNodeInfo(PubKeyToID(pv.PubKey()), "transport")
+	_, err := NewQUICTransport(ni, NodeKey{PrivKey: pv}, conn.DefaultMConnConfig())
+	assert.Error(t, err)
+}
+
+func TestSwitchQUIC(t *testing.T) {
+	quicCfg := *cfg
+	quicCfg.Transport = config.TransportQUIC
+	initQUICSwitch := func(i int, sw *Switch) *Switch {
+		sw = initTopologySwitch(i, sw)
+		sw.AddReactor("foo", NewTestReactor([]*conn.ChannelDescriptor{
+			{ID: byte(0x02), Priority: 10, MessageType: &p2pproto.Message{}},
+			{ID: byte(0x03), Priority: 1, MessageType: &p2pproto.Message{}},
+		}, true))
+		return sw
+	}
+
+	s1 := MakeSwitch(&quicCfg, 0, TestHost, "123.123.123", initQUICSwitch)
+	s2 := MakeSwitch(&quicCfg, 1, TestHost, "123.123.123", initQUICSwitch)
+	switches := []*Switch{s1, s2}
+	require.NoError(t, StartSwitches(switches))
+	t.Cleanup(func() {
+		for _, sw := range switches {
+			if err := sw.Stop(); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+
+	require.NoError(t, s1.DialPeerWithAddress(s2.NetAddress()))
+	require.Eventually(t, func() bool { return s2.Peers().Size() == 1 }, time.Second, 10*time.Millisecond)
+	p := s1.Peers().Get(s2.NetAddress().ID)
+	require.NotNil(t, p)
+	assert.IsType(t, &conn.QUICConnection{}, p.(*peer).mconn)
+
+	// a large message doesn't hold back the messages of another channel
+	bigMsg := &p2pproto.PexAddrs{Addrs: make([]p2pproto.NetAddress, 10000)}
+	smallMsg := &p2pproto.PexRequest{}
+	assert.True(t, p.SendEnvelope(Envelope{ChannelID: 0x03, Message: bigMsg}))
+	assert.True(t, p.SendEnvelope(Envelope{ChannelID: 0x02, Message: smallMsg}))
+
+	reactor := s2.Reactor("foo").(*TestReactor)
+	require.Eventually(t, func() bool {
+		return len(reactor.getMsgs(0x02)) == 1 && len(reactor.getMsgs(0x03)) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, smallMsg, reactor.getMsgs(0x02)[0].Contents)
+	assert.Len(t, reactor.getMsgs(0x03)[0].Contents.(*p2pproto.PexAddrs).Addrs, 10000)
+
+	// the peer is removed from both switches when stopped
+	s1.StopPeerGracefully(p)
+	require.Eventually(t, func() bool { return s2.Peers().Size() == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestSwitchQUICToTCP(t *testing.T) {
+	quicCfg := *cfg
+	quicCfg.Transport = config.TransportQUIC
+	initSwitch := func(i int, sw *Switch) *Switch {
+		sw = initTopologySwitch(i, sw)
+		sw.AddReactor("foo", NewTestReactor([]*conn.ChannelDescriptor{
+			{ID: byte(0x02), Priority: 10, MessageType: &p2pproto.Message{}},
+		}, true))
+		return sw
+	}
+
+	s1 := MakeSwitch(&quicCfg, 0, TestHost, "123.123.123", initSwitch)
+	s2 := MakeSwitch(cfg, 1, TestHost, "123.123.123", initSwitch)
+	switches := []*Switch{s1, s2}
+	require.NoError(t, StartSwitches(switches))
+	t.Cleanup(func() {
+		for _, sw := range switches {
+			if err := sw.Stop(); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+
+	// the TCP-only peer is dialed over TCP
+	require.NoError(t, s1.DialPeerWithAddress(s2.NetAddress()))
+	require.Eventually(t, func() bool { return s2.Peers().Size() == 1 }, time.Second, 10*time.Millisecond)
+	p := s1.Peers().Get(s2.NetAddress().ID)
+	require.NotNil(t, p)
+	assert.IsType(t, &conn.MConnection{}, p.(*peer).mconn)
+
+	msg := &p2pproto.PexRequest{}
+	assert.True(t, p.SendEnvelope(Envelope{ChannelID: 0x02, Message: msg}))
+	reactor := s2.Reactor("foo").(*TestReactor)
+	require.Eventually(t, func() bool {
+		return len(reactor.getMsgs(0x02)) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, msg, reactor.getMsgs(0x02)[0].Contents)
+}
@@ -8,11 +8,14 @@ import (
 
 	"github.com/cosmos/gogoproto/proto"
 
+	dbm "github.com/cometbft/cometbft-db"
+
 	"github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/libs/cmap"
 	"github.com/cometbft/cometbft/libs/rand"
 	"github.com/cometbft/cometbft/libs/service"
 	"github.com/cometbft/cometbft/p2p/conn"
+	"github.com/cometbft/cometbft/p2p/trust"
 )
 
 const (
@@ -39,6 +42,8 @@ func MConnConfig(cfg *config.P2PConfig) conn.MConnConfig {
 	mConfig.SendRate = cfg.SendRate
 	mConfig.RecvRate = cfg.RecvRate
 	mConfig.MaxPacketMsgPayloadSize = cfg.MaxPacketMsgPayloadSize
+	// the rates are checked when the config is validated
+	mConfig.ChannelSendRates, _ = cfg.ChannelSendRateCaps()
 	return mConfig
 }
 
@@ -84,6 +89,7 @@ type Switch struct {
 	// peers addresses with whom we'll maintain constant connection
 	persistentPeersAddrs []*NetAddress
 	unconditionalPeerIDs map[ID]struct{}
+	privatePeerIDs       map[ID]struct{}
 
 	transport Transport
 
@@ -92,6 +98,12 @@ type Switch struct {
 
 	rng *rand.Rand // seed for randomizing dial times and orders
 
+	// trust metrics of the peers, if enabled
+	trustStore *trust.MetricStore
+
+	// banned peers, by the operator or for their low trust score
+	banList *BanList
+
 	metrics *Metrics
 	mlc     *metricsLabelCache
 }
@@ -126,9 +138,17 @@ func NewSwitch(
 		filterTimeout:        defaultFilterTimeout,
 		persistentPeersAddrs: make([]*NetAddress, 0),
 		unconditionalPeerIDs: make(map[ID]struct{}),
+		privatePeerIDs:       make(map[ID]struct{}),
 		mlc:                  newMetricsLabelCache(),
 	}
 
+	// Keep the bans in memory, unless a persisted ban list is set.
+	banList, err := NewBanList(dbm.NewMemDB())
+	if err != nil {
+		panic(err)
+	}
+	sw.banList = banList
+
 	// Ensure we have a completely undeterministic PRNG.
 	sw.rng = rand.NewRand()
 
@@ -156,6 +176,19 @@ func WithMetrics(metrics *Metrics) SwitchOption {
 	return func(sw *Switch) { sw.metrics = metrics }
 }
 
+// WithTrustMetricStore sets the store of the trust metrics of the peers, which
+// enables the peer reputation. The store is started and stopped with the
+// switch.
+func WithTrustMetricStore(store *trust.MetricStore) SwitchOption {
+	return func(sw *Switch) { sw.trustStore = store }
+}
+
+// WithBanList sets the list of banned peers, shared with the transport to
+// filter the connections.
+func WithBanList(banList *BanList) SwitchOption {
+	return func(sw *Switch) { sw.banList = banList }
+}
+
 //---------------------------------------------------------------------
 // Switch setup
 
@@ -230,6 +263,12 @@ func (sw *Switch) SetNodeKey(nodeKey *NodeKey) {
 
 // OnStart implements BaseService. It starts all the reactors and peers.
 func (sw *Switch) OnStart() error {
+	if sw.trustStore != nil {
+		if err := sw.trustStore.Start(); err != nil {
+			return fmt.Errorf("failed to start trust metric store: %w", err)
+		}
+	}
+
 	// Start reactors
 	for _, reactor := range sw.reactors {
 		err := reactor.Start()
@@ -258,6 +297,12 @@ func (sw *Switch) OnStop() {
 			sw.Logger.Error("error while stopped reactor", "reactor", reactor, "error", err)
 		}
 	}
+
+	if sw.trustStore != nil {
+		if err := sw.trustStore.Stop(); err != nil {
+			sw.Logger.Error("error while stopping trust metric store", "error", err)
+		}
+	}
 }
 
 //---------------------------------------------------------------------
@@ -315,7 +360,7 @@ func (sw *Switch) NumPeers() (outbound, inbound, dialing int) {
 
 func (sw *Switch) IsPeerUnconditional(id ID) bool {
 	_, ok := sw.unconditionalPeerIDs[id]
-	return ok
+	return ok || sw.isTopologyUnconditional(id)
 }
 
 // MaxNumOutboundPeers returns a maximum number of outbound peers.
@@ -373,6 +418,10 @@ func (sw *Switch) stopAndRemovePeer(peer Peer, reason interface{}) {
 		reactor.RemovePeer(peer, reason)
 	}
 
+	if sw.trustStore != nil {
+		sw.trustStore.PeerDisconnected(string(peer.ID()))
+	}
+
 	// Removing a peer should go last to avoid a situation where a peer
 	// reconnect to our node and the switch calls InitPeer before
 	// RemovePeer is finished.
@@ -551,6 +600,9 @@ func (sw *Switch) DialPeerWithAddress(addr *NetAddress) error {
 	if sw.IsDialingOrExistingAddress(addr) {
 		return ErrCurrentlyDialingOrExistingAddress{addr.String()}
 	}
+	if until, banned := sw.peerBannedUntil(addr.ID, addr.IP); banned {
+		return ErrSwitchBannedPeer{ID: addr.ID, Until: until}
+	}
 
 	sw.dialing.Set(string(addr.ID), addr)
 	defer sw.dialing.Delete(string(addr.ID))
@@ -613,6 +665,7 @@ func (sw *Switch) AddPrivatePeerIDs(ids []string) error {
 			return fmt.Errorf("wrong ID #%d: %w", i, err)
 		}
 		validIDs = append(validIDs, id)
+		sw.privatePeerIDs[ID(id)] = struct{}{}
 	}
 
 	sw.addrBook.AddPrivateIDs(validIDs)
@@ -689,8 +742,9 @@ func (sw *Switch) acceptRoutine() {
 
 		if !sw.IsPeerUnconditional(p.NodeInfo().ID()) {
 			// Ignore connection if we already have enough peers.
+			// Unless an inbound peer with a low trust score can be evicted for it.
 			_, in, _ := sw.NumPeers()
-			if in >= sw.config.MaxNumInboundPeers {
+			if in >= sw.config.MaxNumInboundPeers && !sw.evictInboundPeer(p) {
 				sw.Logger.Info(
 					"Ignoring inbound connection: already have enough inbound peers",
 					"address", p.SocketAddr(),
@@ -783,6 +837,14 @@ func (sw *Switch) filterPeer(p Peer) error {
 		return ErrRejected{id: p.ID(), isDuplicate: true}
 	}
 
+	if until, banned := sw.peerBannedUntil(p.ID(), peerIP(p)); banned {
+		return ErrRejected{id: p.ID(), err: ErrSwitchBannedPeer{ID: p.ID(), Until: until}, isFiltered: true}
+	}
+
+	if err := sw.filterTopology(p); err != nil {
+		return ErrRejected{id: p.ID(), err: err, isFiltered: true}
+	}
+
 	errc := make(chan error, len(sw.peerFilters))
 
 	for _, f := range sw.peerFilters {
@@ -851,6 +913,11 @@ func (sw *Switch) addPeer(p Peer) error {
 	}
 	sw.metrics.Peers.Add(float64(1))
 
+	if sw.trustStore != nil {
+		// Track the behavior of the peer, from the history saved if any.
+		sw.trustStore.PeerConnected(string(p.ID()))
+	}
+
 	// Start all the reactor protocols on the peer.
 	for _, reactor := range sw.reactors {
 		reactor.AddPeer(p)
@@ -40,7 +40,7 @@ func CreateRandomPeer(outbound bool) Peer {
 			socketAddr: netAddr,
 		},
 		nodeInfo: mockNodeInfo{netAddr},
-		mconn:    &conn.MConnection{},
+		mconn:    conn.NewMConnection(nil, nil, nil, nil),
 		metrics:  NopMetrics(),
 	}
 	p.SetLogger(log.TestingLogger().With("peer", addr))
@@ -187,6 +187,11 @@ func MakeSwitch(
 		PrivKey: ed25519.GenPrivKey(),
 	}
 	nodeInfo := testNodeInfo(nodeKey.ID(), fmt.Sprintf("node%d", i))
+	if cfg.Transport == config.TransportQUIC {
+		ni := nodeInfo.(DefaultNodeInfo)
+		ni.Transports = []string{config.TransportTCP, config.TransportQUIC}
+		nodeInfo = ni
+	}
 	addr, err := NewNetAddressString(
 		IDAddressString(nodeKey.ID(), nodeInfo.(DefaultNodeInfo).ListenAddr),
 	)
@@ -194,7 +199,18 @@ func MakeSwitch(
 		panic(err)
 	}
 
-	t := NewMultiplexTransport(nodeInfo, nodeKey, MConnConfig(cfg))
+	mt := NewMultiplexTransport(nodeInfo, nodeKey, MConnConfig(cfg))
+	var t interface {
+		Transport
+		transportLifecycle
+	} = mt
+	if cfg.Transport == config.TransportQUIC {
+		qt, err := NewQUICTransport(nodeInfo, nodeKey, MConnConfig(cfg))
+		if err != nil {
+			panic(err)
+		}
+		mt, t = qt.MultiplexTransport, qt
+	}
 
 	if err := t.Listen(*addr); err != nil {
 		panic(err)
@@ -213,7 +229,7 @@ func MakeSwitch(
 
 	// TODO: We need to setup reactors ahead of time so the NodeInfo is properly
 	// populated and we don't have to do those awkward overrides and setters.
-	t.nodeInfo = nodeInfo
+	mt.nodeInfo = nodeInfo
 	sw.SetNodeInfo(nodeInfo)
 
 	return sw
@@ -0,0 +1,95 @@
+package p2p
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cometbft/cometbft/config"
+)
+
+// Topology returns the role of the node in the network topology, one of
+// config.TopologyPublic, config.TopologySentry or
+// config.TopologyValidatorBehindSentries.
+func (sw *Switch) Topology() string {
+	return sw.config.Topology
+}
+
+// TopologyViolations returns the deviations of the peers from the topology of
+// the node, like a validator connected to a peer which isn't one of its
+// sentries, or a sentry which isn't connected to its validators. Empty if the
+// topology is public.
+func (sw *Switch) TopologyViolations() []string {
+	var violations []string
+	switch sw.config.Topology {
+	case config.TopologyValidatorBehindSentries:
+		if sw.Reactor("PEX") != nil {
+			violations = append(violations, "the peer-exchange reactor is enabled")
+		}
+		sentries := 0
+		for _, peer := range sw.peers.List() {
+			if sw.isSentry(peer.ID()) {
+				sentries++
+				continue
+			}
+			violations = append(violations, fmt.Sprintf("connected to %v, which isn't a sentry", peer.ID()))
+		}
+		if sentries == 0 {
+			violations = append(violations, "no sentry is connected")
+		}
+
+	case config.TopologySentry:
+		ids := make([]string, 0, len(sw.privatePeerIDs))
+		for id := range sw.privatePeerIDs {
+			ids = append(ids, string(id))
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			peer := sw.peers.Get(ID(id))
+			if peer == nil {
+				violations = append(violations, fmt.Sprintf("validator %v isn't connected", id))
+				continue
+			}
+			addr, err := peer.NodeInfo().NetAddress()
+			if err == nil && sw.addrBook != nil && sw.addrBook.HasAddress(addr) {
+				violations = append(violations,
+					fmt.Sprintf("the address of validator %v is in the address book, and may be gossiped", id))
+			}
+		}
+	}
+	return violations
+}
+
+// isTopologyUnconditional returns true if the peer with the given ID is
+// unconditional because of the topology: the sentries of a validator, and the
+// validators behind a sentry.
+func (sw *Switch) isTopologyUnconditional(id ID) bool {
+	switch sw.config.Topology {
+	case config.TopologyValidatorBehindSentries:
+		return sw.isSentry(id)
+	case config.TopologySentry:
+		_, ok := sw.privatePeerIDs[id]
+		return ok
+	default:
+		return false
+	}
+}
+
+// filterTopology rejects the peers a validator behind sentries shouldn't be
+// connected to, the ones which aren't its sentries.
+func (sw *Switch) filterTopology(p Peer) error {
+	if sw.config.Topology == config.TopologyValidatorBehindSentries && !sw.isSentry(p.ID()) {
+		return fmt.Errorf("%v isn't a sentry, the only peers of a validator behind sentries", p.ID())
+	}
+	return nil
+}
+
+// isSentry returns true if the peer with the given ID is a persistent peer,
+// which are the sentries of a validator behind sentries.
+func (sw *Switch) isSentry(id ID) bool {
+	for _, pa := range sw.persistentPeersAddrs {
+		if pa.ID == id {
+			return true
+		}
+	}
+	return false
+}
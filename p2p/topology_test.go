@@ -0,0 +1,98 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/config"
+)
+
+// initTopologySwitch sets an address book on the switch, without reactors for
+// the switches to connect to each other over TCP.
+func initTopologySwitch(_ int, sw *Switch) *Switch {
+	sw.SetAddrBook(&AddrBookMock{
+		Addrs:        make(map[string]struct{}),
+		OurAddrs:     make(map[string]struct{}),
+		PrivateAddrs: make(map[string]struct{}),
+	})
+	return sw
+}
+
+func TestSwitchTopologyValidatorBehindSentries(t *testing.T) {
+	valCfg := *cfg
+	valCfg.Topology = config.TopologyValidatorBehindSentries
+
+	sentry := MakeSwitch(cfg, 0, TestHost, "123.123.123", initTopologySwitch)
+	other := MakeSwitch(cfg, 1, TestHost, "123.123.123", initTopologySwitch)
+	val := MakeSwitch(&valCfg, 2, TestHost, "123.123.123", initTopologySwitch)
+	switches := []*Switch{sentry, other, val}
+	require.NoError(t, StartSwitches(switches))
+	t.Cleanup(func() {
+		for _, sw := range switches {
+			if err := sw.Stop(); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+	require.NoError(t, val.AddPersistentPeers([]string{sentry.NetAddress().String()}))
+
+	assert.Equal(t, config.TopologyValidatorBehindSentries, val.Topology())
+	assert.True(t, val.IsPeerUnconditional(sentry.NetAddress().ID))
+	assert.False(t, val.IsPeerUnconditional(other.NetAddress().ID))
+	assert.Equal(t, []string{"no sentry is connected"}, val.TopologyViolations())
+
+	// only the sentries are accepted
+	err := val.DialPeerWithAddress(other.NetAddress())
+	require.Error(t, err)
+	assert.IsType(t, ErrRejected{}, err)
+	assert.True(t, err.(ErrRejected).IsFiltered())
+
+	require.NoError(t, val.DialPeerWithAddress(sentry.NetAddress()))
+	assert.True(t, val.Peers().Has(sentry.NetAddress().ID))
+	assert.Empty(t, val.TopologyViolations())
+}
+
+func TestSwitchTopologySentry(t *testing.T) {
+	sentryCfg := *cfg
+	sentryCfg.Topology = config.TopologySentry
+
+	sentry := MakeSwitch(&sentryCfg, 0, TestHost, "123.123.123", initTopologySwitch)
+	book := sentry.addrBook.(*AddrBookMock)
+	val := MakeSwitch(cfg, 1, TestHost, "123.123.123", initTopologySwitch)
+	switches := []*Switch{sentry, val}
+	require.NoError(t, StartSwitches(switches))
+	t.Cleanup(func() {
+		for _, sw := range switches {
+			if err := sw.Stop(); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+
+	valID := val.NetAddress().ID
+	require.NoError(t, sentry.AddPrivatePeerIDs([]string{string(valID)}))
+	assert.Contains(t, book.PrivateAddrs, string(valID))
+	assert.True(t, sentry.IsPeerUnconditional(valID))
+	assert.Equal(t, []string{"validator " + string(valID) + " isn't connected"}, sentry.TopologyViolations())
+
+	require.NoError(t, val.DialPeerWithAddress(sentry.NetAddress()))
+	require.Eventually(t, func() bool { return sentry.Peers().Has(valID) }, time.Second, 10*time.Millisecond)
+	assert.Empty(t, sentry.TopologyViolations())
+
+	// the address of the validator leaked into the address book
+	addr, err := sentry.Peers().Get(valID).NodeInfo().NetAddress()
+	require.NoError(t, err)
+	book.Addrs[addr.String()] = struct{}{}
+	violations := sentry.TopologyViolations()
+	require.Len(t, violations, 1)
+	assert.Contains(t, violations[0], "is in the address book")
+}
+
+func TestSwitchTopologyPublic(t *testing.T) {
+	sw := MakeSwitch(cfg, 0, TestHost, "123.123.123", initTopologySwitch)
+	assert.Equal(t, config.TopologyPublic, sw.Topology())
+	assert.Empty(t, sw.TopologyViolations())
+}
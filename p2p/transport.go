@@ -128,6 +128,12 @@ func MultiplexTransportResolver(resolver IPResolver) MultiplexTransportOption {
 	return func(mt *MultiplexTransport) { mt.resolver = resolver }
 }
 
+// MultiplexTransportBanList sets the list of banned peers, to reject the
+// connections from and to banned IPs.
+func MultiplexTransportBanList(banList *BanList) MultiplexTransportOption {
+	return func(mt *MultiplexTransport) { mt.banList = banList }
+}
+
 // MultiplexTransportMaxIncomingConnections sets the maximum number of
 // simultaneous connections (incoming). Default: 0 (unlimited)
 func MultiplexTransportMaxIncomingConnections(n int) MultiplexTransportOption {
@@ -147,6 +153,7 @@ type MultiplexTransport struct {
 	// Lookup table for duplicate ip and id checks.
 	conns       ConnSet
 	connFilters []ConnFilterFunc
+	banList     *BanList
 
 	dialTimeout      time.Duration
 	filterTimeout    time.Duration
@@ -278,6 +285,18 @@ func (mt *MultiplexTransport) AddChannel(chID byte) {
 	}
 }
 
+// AddCompressedChannel registers a channel whose messages can be compressed to
+// nodeInfo.
+// NOTE: NodeInfo must be of type DefaultNodeInfo else channels won't be updated
+func (mt *MultiplexTransport) AddCompressedChannel(chID byte) {
+	if ni, ok := mt.nodeInfo.(DefaultNodeInfo); ok {
+		if !ni.HasCompressedChannel(chID) {
+			ni.CompressedChannels = append(ni.CompressedChannels, chID)
+		}
+		mt.nodeInfo = ni
+	}
+}
+
 func (mt *MultiplexTransport) acceptPeers() {
 	for {
 		c, err := mt.listener.Accept()
@@ -378,6 +397,15 @@ func (mt *MultiplexTransport) filterConn(c net.Conn) (err error) {
 		return err
 	}
 
+	// Reject if an IP is banned.
+	if mt.banList != nil {
+		for _, ip := range ips {
+			if ban, banned := mt.banList.Banned("", ip); banned {
+				return ErrRejected{conn: c, err: fmt.Errorf("ip<%v> is banned by %v", ip, ban.Target), isFiltered: true}
+			}
+		}
+	}
+
 	errc := make(chan error, len(mt.connFilters))
 
 	for _, f := range mt.connFilters {
@@ -422,11 +450,26 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
+	nodeInfo, err = mt.handshakePeer(c, secretConn, PubKeyToID(secretConn.RemotePubKey()), dialedAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return secretConn, nodeInfo, nil
+}
+
+// handshakePeer exchanges the NodeInfos over hc with the peer authenticated
+// with connID on the connection c, and checks the peer is the one dialed, if
+// any, and that it is compatible with us.
+func (mt *MultiplexTransport) handshakePeer(
+	c net.Conn,
+	hc net.Conn,
+	connID ID,
+	dialedAddr *NetAddress,
+) (NodeInfo, error) {
 	// For outgoing conns, ensure connection key matches dialed key.
-	connID := PubKeyToID(secretConn.RemotePubKey())
 	if dialedAddr != nil {
 		if dialedID := dialedAddr.ID; connID != dialedID {
-			return nil, nil, ErrRejected{
+			return nil, ErrRejected{
 				conn: c,
 				id:   connID,
 				err: fmt.Errorf(
@@ -439,9 +482,9 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	nodeInfo, err = handshake(secretConn, mt.handshakeTimeout, mt.nodeInfo)
+	nodeInfo, err := handshake(hc, mt.handshakeTimeout, mt.nodeInfo)
 	if err != nil {
-		return nil, nil, ErrRejected{
+		return nil, ErrRejected{
 			conn:          c,
 			err:           fmt.Errorf("handshake failed: %v", err),
 			isAuthFailure: true,
@@ -449,7 +492,7 @@ func (mt *MultiplexTransport) upgrade(
 	}
 
 	if err := nodeInfo.Validate(); err != nil {
-		return nil, nil, ErrRejected{
+		return nil, ErrRejected{
 			conn:              c,
 			err:               err,
 			isNodeInfoInvalid: true,
@@ -458,7 +501,7 @@ func (mt *MultiplexTransport) upgrade(
 
 	// Ensure connection key matches self reported key.
 	if connID != nodeInfo.ID() {
-		return nil, nil, ErrRejected{
+		return nil, ErrRejected{
 			conn: c,
 			id:   connID,
 			err: fmt.Errorf(
@@ -472,7 +515,7 @@ func (mt *MultiplexTransport) upgrade(
 
 	// Reject self.
 	if mt.nodeInfo.ID() == nodeInfo.ID() {
-		return nil, nil, ErrRejected{
+		return nil, ErrRejected{
 			addr:   *NewNetAddress(nodeInfo.ID(), c.RemoteAddr()),
 			conn:   c,
 			id:     nodeInfo.ID(),
@@ -481,7 +524,7 @@ func (mt *MultiplexTransport) upgrade(
 	}
 
 	if err := mt.nodeInfo.CompatibleWith(nodeInfo); err != nil {
-		return nil, nil, ErrRejected{
+		return nil, ErrRejected{
 			conn:           c,
 			err:            err,
 			id:             nodeInfo.ID(),
@@ -489,7 +532,7 @@ func (mt *MultiplexTransport) upgrade(
 		}
 	}
 
-	return secretConn, nodeInfo, nil
+	return nodeInfo, nil
 }
 
 func (mt *MultiplexTransport) wrapPeer(
@@ -524,7 +567,7 @@ func (mt *MultiplexTransport) wrapPeer(
 		ni,
 		cfg.reactorsByCh,
 		cfg.msgTypeByChID,
-		cfg.chDescs,
+		mt.negotiateCompression(cfg.chDescs, ni),
 		cfg.onPeerError,
 		cfg.mlc,
 		PeerMetrics(cfg.metrics),
@@ -533,6 +576,25 @@ func (mt *MultiplexTransport) wrapPeer(
 	return p
 }
 
+// negotiateCompression returns a copy of the channel descriptors with
+// compression enabled only on the channels both we and the peer can compress,
+// so the channels of peers without compression stay uncompressed.
+func (mt *MultiplexTransport) negotiateCompression(
+	chDescs []*conn.ChannelDescriptor,
+	ni NodeInfo,
+) []*conn.ChannelDescriptor {
+	ourNodeInfo, _ := mt.nodeInfo.(DefaultNodeInfo)
+	peerNodeInfo, _ := ni.(DefaultNodeInfo)
+
+	descs := make([]*conn.ChannelDescriptor, len(chDescs))
+	for i, chDesc := range chDescs {
+		desc := *chDesc
+		desc.Compress = ourNodeInfo.HasCompressedChannel(desc.ID) && peerNodeInfo.HasCompressedChannel(desc.ID)
+		descs[i] = &desc
+	}
+	return descs
+}
+
 func handshake(
 	c net.Conn,
 	timeout time.Duration,
@@ -636,6 +636,51 @@ func TestTransportAddChannel(t *testing.T) {
 	}
 }
 
+func TestTransportNegotiateCompression(t *testing.T) {
+	mt := newMultiplexTransport(
+		DefaultNodeInfo{Channels: []byte{0x01, 0x02, 0x03}},
+		NodeKey{
+			PrivKey: ed25519.GenPrivKey(),
+		},
+	)
+	mt.AddCompressedChannel(0x01)
+	mt.AddCompressedChannel(0x02)
+
+	chDescs := []*conn.ChannelDescriptor{
+		{ID: 0x01, Compress: true},
+		{ID: 0x02, Compress: true},
+		{ID: 0x03},
+	}
+	peerNodeInfo := DefaultNodeInfo{
+		Channels:           []byte{0x01, 0x02, 0x03},
+		CompressedChannels: []byte{0x02, 0x03},
+	}
+
+	// only the channels both nodes can compress are compressed
+	descs := mt.negotiateCompression(chDescs, peerNodeInfo)
+	if have, want := len(descs), len(chDescs); have != want {
+		t.Fatalf("have %v, want %v", have, want)
+	}
+	for i, want := range []bool{false, true, false} {
+		if have := descs[i].Compress; have != want {
+			t.Errorf("channel %v: have compression %v, want %v", descs[i].ID, have, want)
+		}
+	}
+
+	// peers without compression fall back to uncompressed channels
+	descs = mt.negotiateCompression(chDescs, DefaultNodeInfo{Channels: []byte{0x01, 0x02, 0x03}})
+	for _, desc := range descs {
+		if desc.Compress {
+			t.Errorf("channel %v: unexpected compression", desc.ID)
+		}
+	}
+
+	// the descriptors shared by the peers are left untouched
+	if !chDescs[0].Compress {
+		t.Errorf("channel descriptor modified")
+	}
+}
+
 // create listener
 func testSetupMultiplexTransport(t *testing.T) *MultiplexTransport {
 	var (
@@ -11,7 +11,13 @@ import (
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 )
 
-const defaultStorePeriodicSaveInterval = 1 * time.Minute
+const (
+	defaultStorePeriodicSaveInterval = 1 * time.Minute
+
+	// Maximum number of trust metrics of disconnected peers kept by a store.
+	// The metrics of the peers which disconnected first are dropped beyond.
+	defaultStoreMaxDisconnectedPeers = 1000
+)
 
 var trustMetricKey = []byte("trustMetricStore")
 
@@ -22,6 +28,11 @@ type MetricStore struct {
 	// Maps a Peer.Key to that peer's TrustMetric
 	peerMetrics map[string]*Metric
 
+	// Maps the Peer.Key of the disconnected peers to the time they
+	// disconnected, so that their metrics can be dropped past the limit
+	disconnected    map[string]time.Time
+	maxDisconnected int
+
 	// Mutex that protects the map and history data file
 	mtx cmtsync.Mutex
 
@@ -37,9 +48,11 @@ type MetricStore struct {
 // Use Start to to initialize the trust metric store
 func NewTrustMetricStore(db dbm.DB, tmc MetricConfig) *MetricStore {
 	tms := &MetricStore{
-		peerMetrics: make(map[string]*Metric),
-		db:          db,
-		config:      tmc,
+		peerMetrics:     make(map[string]*Metric),
+		disconnected:    make(map[string]time.Time),
+		maxDisconnected: defaultStoreMaxDisconnectedPeers,
+		db:              db,
+		config:          tmc,
 	}
 
 	tms.BaseService = *service.NewBaseService(nil, "MetricStore", tms)
@@ -116,7 +129,44 @@ func (tms *MetricStore) GetPeerTrustMetric(key string) *Metric {
 	return tm
 }
 
-// PeerDisconnected pauses the trust metric associated with the peer identified by the key
+// GetPeerTrustScore returns the trust score of the peer identified by the key,
+// and false if the store has no trust metric for it. Unlike
+// GetPeerTrustMetric, it doesn't create a trust metric for an unknown peer.
+func (tms *MetricStore) GetPeerTrustScore(key string) (int, bool) {
+	tms.mtx.Lock()
+	defer tms.mtx.Unlock()
+
+	tm, ok := tms.peerMetrics[key]
+	if !ok {
+		return 0, false
+	}
+	return tm.TrustScore(), true
+}
+
+// FindPeerTrustMetric returns the trust metric of the peer identified by the
+// key, and false if the store has no trust metric for it. Unlike
+// GetPeerTrustMetric, it doesn't create a trust metric for an unknown peer.
+func (tms *MetricStore) FindPeerTrustMetric(key string) (*Metric, bool) {
+	tms.mtx.Lock()
+	defer tms.mtx.Unlock()
+
+	tm, ok := tms.peerMetrics[key]
+	return tm, ok
+}
+
+// PeerConnected returns the trust metric of the peer identified by the key,
+// creating it if needed. The metric is kept until the peer disconnects.
+func (tms *MetricStore) PeerConnected(key string) *Metric {
+	tms.mtx.Lock()
+	delete(tms.disconnected, key)
+	tms.mtx.Unlock()
+
+	return tms.GetPeerTrustMetric(key)
+}
+
+// PeerDisconnected pauses the trust metric associated with the peer identified by the key.
+// Past the maximum number of disconnected peers, the metrics of the peers which
+// disconnected first are dropped.
 func (tms *MetricStore) PeerDisconnected(key string) {
 	tms.mtx.Lock()
 	defer tms.mtx.Unlock()
@@ -124,6 +174,8 @@ func (tms *MetricStore) PeerDisconnected(key string) {
 	// If the Peer that disconnected has a metric, pause it
 	if tm, ok := tms.peerMetrics[key]; ok {
 		tm.Pause()
+		tms.disconnected[key] = time.Now()
+		tms.pruneDisconnected()
 	}
 }
 
@@ -143,6 +195,28 @@ func (tms *MetricStore) size() int {
 	return len(tms.peerMetrics)
 }
 
+// pruneDisconnected drops the metrics of the peers which disconnected first,
+// until there are no more than maxDisconnected of them.
+// CONTRACT: tms.mtx must be held.
+func (tms *MetricStore) pruneDisconnected() {
+	for len(tms.disconnected) > tms.maxDisconnected {
+		var (
+			oldestKey string
+			oldest    time.Time
+		)
+		for key, at := range tms.disconnected {
+			if oldestKey == "" || at.Before(oldest) {
+				oldestKey, oldest = key, at
+			}
+		}
+		if err := tms.peerMetrics[oldestKey].Stop(); err != nil {
+			tms.Logger.Error("unable to stop metric", "error", err)
+		}
+		delete(tms.peerMetrics, oldestKey)
+		delete(tms.disconnected, oldestKey)
+	}
+}
+
 /* Loading & Saving */
 /* Both loadFromDB and savetoDB assume the mutex has been acquired */
 
@@ -165,7 +239,8 @@ func (tms *MetricStore) loadFromDB() bool {
 	}
 
 	// If history data exists in the file,
-	// load it into trust metric
+	// load it into trust metric. The peers are all disconnected.
+	now := time.Now()
 	for key, p := range peers {
 		tm := NewMetricWithConfig(tms.config)
 
@@ -175,7 +250,9 @@ func (tms *MetricStore) loadFromDB() bool {
 		tm.Init(p)
 		// Load the peer trust metric into the store
 		tms.peerMetrics[key] = tm
+		tms.disconnected[key] = now
 	}
+	tms.pruneDisconnected()
 	return true
 }
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -138,6 +139,8 @@ func TestTrustMetricStorePeerScore(t *testing.T) {
 	require.NoError(t, err)
 
 	key := "TestKey"
+	_, ok := store.GetPeerTrustScore(key)
+	assert.False(t, ok)
 	tm := store.GetPeerTrustMetric(key)
 
 	// This peer is innocent so far
@@ -157,8 +160,58 @@ func TestTrustMetricStorePeerScore(t *testing.T) {
 	store.PeerDisconnected(key)
 
 	// We will remember our experiences with this peer
+	score, ok := store.GetPeerTrustScore(key)
+	assert.True(t, ok)
+	assert.Equal(t, second, score)
 	tm = store.GetPeerTrustMetric(key)
 	assert.NotEqual(t, 100, tm.TrustScore())
 	err = store.Stop()
 	require.NoError(t, err)
 }
+
+func TestTrustMetricStoreDropDisconnected(t *testing.T) {
+	historyDB, err := dbm.NewDB("", "memdb", "")
+	require.NoError(t, err)
+
+	store := NewTrustMetricStore(historyDB, DefaultConfig())
+	store.SetLogger(log.TestingLogger())
+	store.maxDisconnected = 2
+	err = store.Start()
+	require.NoError(t, err)
+
+	// The metrics of the connected peers are kept
+	for i := 0; i < 4; i++ {
+		store.PeerConnected(fmt.Sprintf("peer_%d", i))
+	}
+	assert.Equal(t, 4, store.Size())
+
+	// Past the limit, the peers which disconnected first are dropped
+	for i := 0; i < 3; i++ {
+		store.PeerDisconnected(fmt.Sprintf("peer_%d", i))
+		time.Sleep(time.Millisecond)
+	}
+	assert.Equal(t, 3, store.Size())
+	_, ok := store.FindPeerTrustMetric("peer_0")
+	assert.False(t, ok)
+	for i := 1; i < 4; i++ {
+		_, ok := store.FindPeerTrustMetric(fmt.Sprintf("peer_%d", i))
+		assert.True(t, ok)
+	}
+
+	// A peer which reconnects is kept
+	store.PeerConnected("peer_1")
+	store.PeerDisconnected("peer_3")
+	assert.Equal(t, 3, store.Size())
+	err = store.Stop()
+	require.NoError(t, err)
+
+	// The dropped metrics are not saved, and the loaded peers are disconnected
+	store = NewTrustMetricStore(historyDB, DefaultConfig())
+	store.SetLogger(log.TestingLogger())
+	store.maxDisconnected = 1
+	err = store.Start()
+	require.NoError(t, err)
+	assert.Equal(t, 1, store.Size())
+	err = store.Stop()
+	require.NoError(t, err)
+}
@@ -9,6 +9,7 @@ import (
 )
 
 var _ p2p.Wrapper = &Vote{}
+var _ p2p.Wrapper = &VoteSummary{}
 var _ p2p.Unwrapper = &Message{}
 
 // Wrap implements the p2p Wrapper interface and wraps a votepool message.
@@ -18,6 +19,13 @@ func (m *Vote) Wrap() proto.Message {
 	return mm
 }
 
+// Wrap implements the p2p Wrapper interface and wraps a votepool summary message.
+func (m *VoteSummary) Wrap() proto.Message {
+	mm := &Message{}
+	mm.Sum = &Message_VoteSummary{VoteSummary: m}
+	return mm
+}
+
 // Unwrap implements the p2p Wrapper interface and unwraps a wrapped votepool
 // message.
 func (m *Message) Unwrap() (proto.Message, error) {
@@ -25,6 +33,9 @@ func (m *Message) Unwrap() (proto.Message, error) {
 	case *Message_Vote:
 		return m.GetVote(), nil
 
+	case *Message_VoteSummary:
+		return m.GetVoteSummary(), nil
+
 	default:
 		return nil, fmt.Errorf("unknown message: %T", msg)
 	}
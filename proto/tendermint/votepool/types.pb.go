@@ -90,10 +90,119 @@ func (m *Vote) GetEventHash() []byte {
 	return nil
 }
 
+// VoteDigest summarizes the votes held for an event.
+type VoteDigest struct {
+	EventType uint32 `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	EventHash []byte `protobuf:"bytes,2,opt,name=event_hash,json=eventHash,proto3" json:"event_hash,omitempty"`
+	// hash of the sorted public keys of the votes
+	Digest []byte `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (m *VoteDigest) Reset()         { *m = VoteDigest{} }
+func (m *VoteDigest) String() string { return proto.CompactTextString(m) }
+func (*VoteDigest) ProtoMessage()    {}
+func (*VoteDigest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_546ebc6ebb4a65fe, []int{1}
+}
+func (m *VoteDigest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteDigest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteDigest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteDigest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteDigest.Merge(m, src)
+}
+func (m *VoteDigest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteDigest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteDigest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteDigest proto.InternalMessageInfo
+
+func (m *VoteDigest) GetEventType() uint32 {
+	if m != nil {
+		return m.EventType
+	}
+	return 0
+}
+
+func (m *VoteDigest) GetEventHash() []byte {
+	if m != nil {
+		return m.EventHash
+	}
+	return nil
+}
+
+func (m *VoteDigest) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+// VoteSummary is sent to a newly connected peer, so that it only sends the votes we are missing.
+// It is only sent on a separate channel, to the peers advertising it.
+type VoteSummary struct {
+	Digests []*VoteDigest `protobuf:"bytes,1,rep,name=digests,proto3" json:"digests,omitempty"`
+}
+
+func (m *VoteSummary) Reset()         { *m = VoteSummary{} }
+func (m *VoteSummary) String() string { return proto.CompactTextString(m) }
+func (*VoteSummary) ProtoMessage()    {}
+func (*VoteSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_546ebc6ebb4a65fe, []int{2}
+}
+func (m *VoteSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteSummary.Merge(m, src)
+}
+func (m *VoteSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteSummary proto.InternalMessageInfo
+
+func (m *VoteSummary) GetDigests() []*VoteDigest {
+	if m != nil {
+		return m.Digests
+	}
+	return nil
+}
+
 type Message struct {
 	// Types that are valid to be assigned to Sum:
 	//
 	//	*Message_Vote
+	//	*Message_VoteSummary
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
 
@@ -101,7 +210,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_546ebc6ebb4a65fe, []int{1}
+	return fileDescriptor_546ebc6ebb4a65fe, []int{3}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -139,8 +248,12 @@ type isMessage_Sum interface {
 type Message_Vote struct {
 	Vote *Vote `protobuf:"bytes,1,opt,name=vote,proto3,oneof" json:"vote,omitempty"`
 }
+type Message_VoteSummary struct {
+	VoteSummary *VoteSummary `protobuf:"bytes,2,opt,name=vote_summary,json=voteSummary,proto3,oneof" json:"vote_summary,omitempty"`
+}
 
-func (*Message_Vote) isMessage_Sum() {}
+func (*Message_Vote) isMessage_Sum()        {}
+func (*Message_VoteSummary) isMessage_Sum() {}
 
 func (m *Message) GetSum() isMessage_Sum {
 	if m != nil {
@@ -156,38 +269,54 @@ func (m *Message) GetVote() *Vote {
 	return nil
 }
 
+func (m *Message) GetVoteSummary() *VoteSummary {
+	if x, ok := m.GetSum().(*Message_VoteSummary); ok {
+		return x.VoteSummary
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Message) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Message_Vote)(nil),
+		(*Message_VoteSummary)(nil),
 	}
 }
 
 func init() {
 	proto.RegisterType((*Vote)(nil), "tendermint.votepool.Vote")
+	proto.RegisterType((*VoteDigest)(nil), "tendermint.votepool.VoteDigest")
+	proto.RegisterType((*VoteSummary)(nil), "tendermint.votepool.VoteSummary")
 	proto.RegisterType((*Message)(nil), "tendermint.votepool.Message")
 }
 
 func init() { proto.RegisterFile("tendermint/votepool/types.proto", fileDescriptor_546ebc6ebb4a65fe) }
 
 var fileDescriptor_546ebc6ebb4a65fe = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2f, 0x49, 0xcd, 0x4b,
-	0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0xcb, 0x2f, 0x49, 0x2d, 0xc8, 0xcf, 0xcf, 0xd1,
-	0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x46, 0x28, 0xd0,
-	0x83, 0x29, 0x50, 0xaa, 0xe6, 0x62, 0x09, 0xcb, 0x2f, 0x49, 0x15, 0x12, 0xe7, 0x62, 0x2f, 0x28,
-	0x4d, 0x8a, 0xcf, 0x4e, 0xad, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x09, 0x62, 0x2b, 0x28, 0x4d,
-	0xf2, 0x4e, 0xad, 0x14, 0x92, 0xe1, 0xe2, 0x2c, 0xce, 0x4c, 0xcf, 0x4b, 0x2c, 0x29, 0x2d, 0x4a,
-	0x95, 0x60, 0x02, 0x4b, 0x21, 0x04, 0x84, 0x64, 0xb9, 0xb8, 0x52, 0xcb, 0x52, 0xf3, 0x4a, 0xe2,
-	0x41, 0x16, 0x49, 0x30, 0x2b, 0x30, 0x6a, 0xf0, 0x06, 0x71, 0x82, 0x45, 0x42, 0x2a, 0x0b, 0x90,
-	0xa4, 0x33, 0x12, 0x8b, 0x33, 0x24, 0x58, 0x20, 0xba, 0xc1, 0x22, 0x1e, 0x89, 0xc5, 0x19, 0x4a,
-	0x8e, 0x5c, 0xec, 0xbe, 0xa9, 0xc5, 0xc5, 0x89, 0xe9, 0xa9, 0x42, 0xfa, 0x5c, 0x2c, 0x20, 0x37,
-	0x81, 0x2d, 0xe7, 0x36, 0x92, 0xd4, 0xc3, 0xe2, 0x56, 0x3d, 0x90, 0x43, 0x3d, 0x18, 0x82, 0xc0,
-	0x0a, 0x9d, 0x58, 0xb9, 0x98, 0x8b, 0x4b, 0x73, 0x9d, 0x42, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
-	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
-	0xf1, 0x58, 0x8e, 0x21, 0xca, 0x2a, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57,
-	0x1f, 0x29, 0x68, 0x90, 0x98, 0xe0, 0x60, 0xd1, 0xc7, 0x12, 0x6c, 0x49, 0x6c, 0x60, 0x29, 0x63,
-	0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x79, 0xed, 0x57, 0x54, 0x01, 0x00, 0x00,
+	// 340 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x4a, 0xfb, 0x40,
+	0x10, 0xc6, 0xb3, 0x6d, 0xff, 0x2d, 0x9d, 0xf4, 0x7f, 0x59, 0x41, 0x23, 0x68, 0x1a, 0x72, 0xea,
+	0x29, 0x81, 0x7a, 0xd2, 0x63, 0x51, 0x08, 0x88, 0x97, 0x58, 0x3c, 0x78, 0x29, 0x89, 0x1d, 0x92,
+	0xa0, 0xc9, 0x86, 0xec, 0x6e, 0x21, 0xf8, 0x02, 0x1e, 0x7d, 0x2c, 0x8f, 0x3d, 0x7a, 0x94, 0xf6,
+	0x45, 0x24, 0xdb, 0x96, 0x84, 0xd2, 0x7a, 0x4a, 0x66, 0xbe, 0x6f, 0xbe, 0xf9, 0xb1, 0x0c, 0x0c,
+	0x05, 0x66, 0x73, 0x2c, 0xd2, 0x24, 0x13, 0xee, 0x82, 0x09, 0xcc, 0x19, 0x7b, 0x73, 0x45, 0x99,
+	0x23, 0x77, 0xf2, 0x82, 0x09, 0x46, 0x4f, 0x6a, 0x83, 0xb3, 0x33, 0xd8, 0xef, 0xd0, 0x79, 0x62,
+	0x02, 0xe9, 0x19, 0xf4, 0x72, 0x19, 0xce, 0x5e, 0xb1, 0x34, 0x88, 0x45, 0x46, 0x03, 0xbf, 0x9b,
+	0xcb, 0xf0, 0x1e, 0x4b, 0x7a, 0x01, 0x7d, 0x9e, 0x44, 0x59, 0x20, 0x64, 0x81, 0x46, 0x4b, 0x49,
+	0x75, 0x83, 0x5e, 0x02, 0xe0, 0x02, 0x33, 0x31, 0xab, 0x16, 0x19, 0x6d, 0x8b, 0x8c, 0xfe, 0xfb,
+	0x7d, 0xd5, 0x99, 0x96, 0x79, 0x43, 0x8e, 0x03, 0x1e, 0x1b, 0x9d, 0xcd, 0xb4, 0xea, 0x78, 0x01,
+	0x8f, 0xed, 0x10, 0xa0, 0x5a, 0x7e, 0x9b, 0x44, 0xc8, 0xc5, 0x5e, 0x16, 0xf9, 0x3b, 0xab, 0xb5,
+	0x97, 0x45, 0x4f, 0xa1, 0x3b, 0x57, 0x39, 0x8a, 0x62, 0xe0, 0x6f, 0x2b, 0xdb, 0x03, 0xbd, 0xda,
+	0xf1, 0x28, 0xd3, 0x34, 0x28, 0x4a, 0x7a, 0x0d, 0xbd, 0x8d, 0xc0, 0x0d, 0x62, 0xb5, 0x47, 0xfa,
+	0x78, 0xe8, 0x1c, 0x78, 0x16, 0xa7, 0xc6, 0xf2, 0x77, 0x7e, 0xfb, 0x83, 0x40, 0xef, 0x01, 0x39,
+	0x0f, 0x22, 0xa4, 0x2e, 0x74, 0x2a, 0xaf, 0xa2, 0xd4, 0xc7, 0xe7, 0x47, 0x33, 0x3c, 0xcd, 0x57,
+	0x46, 0x7a, 0x07, 0x83, 0xea, 0x3b, 0xe3, 0x1b, 0x0e, 0xc5, 0xaf, 0x8f, 0xad, 0xa3, 0x83, 0x5b,
+	0x5e, 0x4f, 0xf3, 0xf5, 0x45, 0x5d, 0x4e, 0xfe, 0x41, 0x9b, 0xcb, 0x74, 0x32, 0xfd, 0x5a, 0x99,
+	0x64, 0xb9, 0x32, 0xc9, 0xcf, 0xca, 0x24, 0x9f, 0x6b, 0x53, 0x5b, 0xae, 0x4d, 0xed, 0x7b, 0x6d,
+	0x6a, 0xcf, 0x37, 0x51, 0x22, 0x62, 0x19, 0x3a, 0x2f, 0x2c, 0x75, 0x1b, 0x07, 0xd1, 0xf8, 0x55,
+	0xc7, 0xe0, 0x1e, 0x38, 0x96, 0xb0, 0xab, 0xa4, 0xab, 0xdf, 0x01, 0x00, 0xe4, 0x68, 0x8b, 0x64,
+	0x4a, 0x02, 0x00, 0x00,
 }
 
 func (m *Vote) Marshal() (dAtA []byte, err error) {
@@ -239,6 +368,85 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoteDigest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteDigest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteDigest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EventHash) > 0 {
+		i -= len(m.EventHash)
+		copy(dAtA[i:], m.EventHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EventHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Digests) > 0 {
+		for iNdEx := len(m.Digests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Digests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Message) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -292,6 +500,27 @@ func (m *Message_Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Message_VoteSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_VoteSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.VoteSummary != nil {
+		{
+			size, err := m.VoteSummary.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -327,6 +556,41 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *VoteDigest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventType != 0 {
+		n += 1 + sovTypes(uint64(m.EventType))
+	}
+	l = len(m.EventHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *VoteSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Digests) > 0 {
+		for _, e := range m.Digests {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Message) Size() (n int) {
 	if m == nil {
 		return 0
@@ -351,6 +615,18 @@ func (m *Message_Vote) Size() (n int) {
 	}
 	return n
 }
+func (m *Message_VoteSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoteSummary != nil {
+		l = m.VoteSummary.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -529,6 +805,227 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VoteDigest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteDigest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteDigest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			m.EventType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventHash = append(m.EventHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EventHash == nil {
+				m.EventHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = append(m.Digest[:0], dAtA[iNdEx:postIndex]...)
+			if m.Digest == nil {
+				m.Digest = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digests = append(m.Digests, &VoteDigest{})
+			if err := m.Digests[len(m.Digests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Message) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -593,6 +1090,41 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Message_Vote{v}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteSummary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &VoteSummary{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_VoteSummary{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
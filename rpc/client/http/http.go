@@ -322,6 +322,15 @@ func (c *baseRPCClient) CheckTx(ctx context.Context, tx types.Tx) (*ctypes.Resul
 	return result, nil
 }
 
+func (c *baseRPCClient) TxStatus(ctx context.Context, hash []byte) (*ctypes.ResultTxStatus, error) {
+	result := new(ctypes.ResultTxStatus)
+	_, err := c.caller.Call(ctx, "tx_status", map[string]interface{}{"hash": hash}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	result := new(ctypes.ResultNetInfo)
 	_, err := c.caller.Call(ctx, "net_info", map[string]interface{}{}, result)
@@ -619,6 +628,19 @@ func (c *baseRPCClient) QueryVote(
 	return result, nil
 }
 
+func (c *baseRPCClient) QueryVoteQuorum(
+	ctx context.Context,
+	eventType int,
+	eventHash []byte,
+) (*ctypes.ResultQueryVoteQuorum, error) {
+	result := new(ctypes.ResultQueryVoteQuorum)
+	_, err := c.caller.Call(ctx, "query_vote_quorum", map[string]interface{}{"event_type": eventType, "event_hash": eventHash}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 //-----------------------------------------------------------------------------
 // WSEvents
 
@@ -408,6 +408,18 @@ func (w *WSEvents) CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultChec
 	return result, nil
 }
 
+func (w *WSEvents) TxStatus(ctx context.Context, hash []byte) (*ctypes.ResultTxStatus, error) {
+	result := new(ctypes.ResultTxStatus)
+	wsClient := w.GetClient()
+	err := w.SimpleCall(ctx, result, func(ctx context.Context, id rpctypes.JSONRPCIntID) error {
+		return wsClient.TxStatus(ctx, id, hash)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (w *WSEvents) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	result := new(ctypes.ResultNetInfo)
 	wsClient := w.GetClient()
@@ -696,3 +708,19 @@ func (w *WSEvents) QueryVote(
 	}
 	return result, nil
 }
+
+func (w *WSEvents) QueryVoteQuorum(
+	ctx context.Context,
+	eventType int,
+	eventHash []byte,
+) (*ctypes.ResultQueryVoteQuorum, error) {
+	result := new(ctypes.ResultQueryVoteQuorum)
+	wsClient := w.GetClient()
+	err := w.SimpleCall(ctx, result, func(ctx context.Context, id rpctypes.JSONRPCIntID) error {
+		return wsClient.QueryVoteQuorum(ctx, eventType, eventHash, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
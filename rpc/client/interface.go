@@ -140,6 +140,7 @@ type MempoolClient interface {
 	UnconfirmedTxs(ctx context.Context, limit *int) (*ctypes.ResultUnconfirmedTxs, error)
 	NumUnconfirmedTxs(context.Context) (*ctypes.ResultUnconfirmedTxs, error)
 	CheckTx(context.Context, types.Tx) (*ctypes.ResultCheckTx, error)
+	TxStatus(ctx context.Context, hash []byte) (*ctypes.ResultTxStatus, error)
 }
 
 // EvidenceClient is used for submitting an evidence of the malicious
@@ -159,4 +160,5 @@ type RemoteClient interface {
 type VotepoolClient interface {
 	BroadcastVote(ctx context.Context, vote votepool.Vote) (*ctypes.ResultBroadcastVote, error)
 	QueryVote(ctx context.Context, eventType int, eventHash []byte) (*ctypes.ResultQueryVote, error)
+	QueryVoteQuorum(ctx context.Context, eventType int, eventHash []byte) (*ctypes.ResultQueryVoteQuorum, error)
 }
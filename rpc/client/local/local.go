@@ -113,6 +113,10 @@ func (c *Local) CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultCheckTx
 	return core.CheckTx(c.ctx, tx)
 }
 
+func (c *Local) TxStatus(ctx context.Context, hash []byte) (*ctypes.ResultTxStatus, error) {
+	return core.TxStatus(c.ctx, hash)
+}
+
 func (c *Local) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	return core.NetInfo(c.ctx)
 }
@@ -223,6 +227,10 @@ func (c *Local) QueryVote(ctx context.Context, eventType int, eventHash []byte)
 	return core.QueryVote(c.ctx, eventType, eventHash)
 }
 
+func (c *Local) QueryVoteQuorum(ctx context.Context, eventType int, eventHash []byte) (*ctypes.ResultQueryVoteQuorum, error) {
+	return core.QueryVoteQuorum(c.ctx, eventType, eventHash)
+}
+
 func (c *Local) Subscribe(
 	ctx context.Context,
 	subscriber,
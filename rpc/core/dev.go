@@ -1,6 +1,12 @@
 package core
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	mempl "github.com/cometbft/cometbft/mempool"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 )
@@ -10,3 +16,26 @@ func UnsafeFlushMempool(ctx *rpctypes.Context) (*ctypes.ResultUnsafeFlushMempool
 	env.Mempool.Flush()
 	return &ctypes.ResultUnsafeFlushMempool{}, nil
 }
+
+// UnsafeExportMempool writes all transactions in the mempool, with their
+// metadata, to a new file at the given absolute path on the node's host. The
+// file can be imported into another node with `cometbft mempool import`.
+func UnsafeExportMempool(ctx *rpctypes.Context, path string) (*ctypes.ResultUnsafeExportMempool, error) {
+	if !filepath.IsAbs(path) {
+		return nil, errors.New("path must be absolute")
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create mempool snapshot: %w", err)
+	}
+
+	numTxs, err := mempl.WriteSnapshot(f, env.Mempool.SnapshotTxs())
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(path)
+		return nil, fmt.Errorf("failed to write mempool snapshot: %w", err)
+	}
+	return &ctypes.ResultUnsafeExportMempool{Path: path, NumTxs: numTxs}, nil
+}
@@ -0,0 +1,46 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	mempl "github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/mempool/mocks"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestUnsafeExportMempool(t *testing.T) {
+	txs := []mempl.SnapshotTx{
+		{Tx: types.Tx("tx1"), Height: 1, GasWanted: 10, Sender: "a"},
+		{Tx: types.Tx("tx2"), Height: 2, Priority: 3},
+	}
+	mempool := new(mocks.Mempool)
+	mempool.On("SnapshotTxs").Return(txs)
+	env = &Environment{Mempool: mempool}
+
+	path := filepath.Join(t.TempDir(), "mempool.snapshot")
+	_, err := UnsafeExportMempool(&rpctypes.Context{}, "mempool.snapshot")
+	require.Error(t, err, "relative path")
+
+	res, err := UnsafeExportMempool(&rpctypes.Context{}, path)
+	require.NoError(t, err)
+	require.Equal(t, 2, res.NumTxs)
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	read := make([]mempl.SnapshotTx, 0)
+	require.NoError(t, mempl.ReadSnapshot(f, func(stx mempl.SnapshotTx) error {
+		read = append(read, stx)
+		return nil
+	}))
+	require.Equal(t, txs, read)
+
+	// an existing file is not overwritten
+	_, err = UnsafeExportMempool(&rpctypes.Context{}, path)
+	require.Error(t, err)
+}
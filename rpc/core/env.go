@@ -12,6 +12,7 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	mempl "github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/pex"
 	"github.com/cometbft/cometbft/proxy"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer"
@@ -68,6 +69,16 @@ type peers interface {
 	AddPrivatePeerIDs([]string) error
 	DialPeersAsync([]string) error
 	Peers() p2p.IPeerSet
+	PeerTrustScore(p2p.ID) (int, bool)
+	BanPeer(target string, duration time.Duration, reason string) (p2p.Ban, error)
+	UnbanPeer(target string) (bool, error)
+	Bans() []p2p.Ban
+	Topology() string
+	TopologyViolations() []string
+}
+
+type addrBook interface {
+	Stats() []pex.AddrStats
 }
 
 // ----------------------------------------------
@@ -88,6 +99,7 @@ type Environment struct {
 	ConsensusState Consensus
 	P2PPeers       peers
 	P2PTransport   transport
+	P2PAddrBook    addrBook
 
 	// objects
 	PubKey           crypto.PubKey
@@ -10,6 +10,7 @@ import (
 	mempl "github.com/cometbft/cometbft/mempool"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/state/txindex/null"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -43,6 +44,10 @@ func BroadcastTxAsync(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadca
 
 // BroadcastTxSync returns with the response from CheckTx. Does not wait for
 // DeliverTx result.
+//
+// NOTE: a valid tx the mempool rejects (e.g. because a quota is exceeded, the
+// mempool is full or its nonce is taken) is reported with code 0 and the
+// reason in MempoolError, so a tx is only accepted if both are unset.
 // More: https://docs.cometbft.com/v0.37/rpc/#/Tx/broadcast_tx_sync
 func BroadcastTxSync(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadcastTx, error) {
 	resCh := make(chan *abci.Response, 1)
@@ -78,11 +83,12 @@ func BroadcastTxSync(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadcas
 	case res := <-resCh:
 		r := res.GetCheckTx()
 		return &ctypes.ResultBroadcastTx{
-			Code:      r.Code,
-			Data:      r.Data,
-			Log:       r.Log,
-			Codespace: r.Codespace,
-			Hash:      tx.Hash(),
+			Code:         r.Code,
+			Data:         r.Data,
+			Log:          r.Log,
+			Codespace:    r.Codespace,
+			MempoolError: r.MempoolError,
+			Hash:         tx.Hash(),
 		}, nil
 	}
 }
@@ -150,7 +156,8 @@ func BroadcastTxCommit(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadc
 		return nil, fmt.Errorf("broadcast confirmation not received: %w", ctx.Context().Err())
 	case checkTxResMsg := <-checkTxResCh:
 		checkTxRes := checkTxResMsg.GetCheckTx()
-		if checkTxRes.Code != abci.CodeTypeOK {
+		// a valid tx rejected by the mempool is not included in a block either
+		if checkTxRes.Code != abci.CodeTypeOK || checkTxRes.MempoolError != "" {
 			return &ctypes.ResultBroadcastTxCommit{
 				CheckTx:   *checkTxRes,
 				DeliverTx: abci.ResponseDeliverTx{},
@@ -220,6 +227,40 @@ func NumUnconfirmedTxs(ctx *rpctypes.Context) (*ctypes.ResultUnconfirmedTxs, err
 	}, nil
 }
 
+// TxStatus returns the status of a transaction: committed if the tx indexer
+// has it, pending or held if it's in the mempool, in_cache if it was seen
+// recently but left the mempool (e.g. it was invalidated, evicted or is being
+// indexed), and unknown otherwise.
+// More: https://docs.cometbft.com/v0.37/rpc/#/Info/tx_status
+func TxStatus(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultTxStatus, error) {
+	var txKey types.TxKey
+	if len(hash) != len(txKey) {
+		return nil, fmt.Errorf("invalid tx hash length %d, expected %d", len(hash), len(txKey))
+	}
+
+	if _, ok := env.TxIndexer.(*null.TxIndex); !ok {
+		r, err := env.TxIndexer.Get(hash)
+		if err != nil {
+			return nil, err
+		}
+		if r != nil {
+			return &ctypes.ResultTxStatus{
+				Hash:   hash,
+				Status: ctypes.TxStatusCommitted,
+				Height: r.Height,
+				Index:  r.Index,
+				Code:   r.Result.Code,
+			}, nil
+		}
+	}
+
+	copy(txKey[:], hash)
+	return &ctypes.ResultTxStatus{
+		Hash:   hash,
+		Status: string(env.Mempool.TxStatus(txKey)),
+	}, nil
+}
+
 // CheckTx checks the transaction without executing it. The transaction won't
 // be added to the mempool either.
 // More: https://docs.cometbft.com/v0.37/rpc/#/Tx/check_tx
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cometbft/cometbft/p2p"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -20,21 +21,27 @@ func NetInfo(ctx *rpctypes.Context) (*ctypes.ResultNetInfo, error) {
 		if !ok {
 			return nil, fmt.Errorf("peer.NodeInfo() is not DefaultNodeInfo")
 		}
-		peers = append(peers, ctypes.Peer{
+		p := ctypes.Peer{
 			NodeInfo:         nodeInfo,
 			IsOutbound:       peer.IsOutbound(),
 			ConnectionStatus: peer.Status(),
 			RemoteIP:         peer.RemoteIP().String(),
-		})
+		}
+		if score, ok := env.P2PPeers.PeerTrustScore(peer.ID()); ok {
+			p.TrustScore = &score
+		}
+		peers = append(peers, p)
 	}
 	// TODO: Should we include PersistentPeers and Seeds in here?
 	// PRO: useful info
 	// CON: privacy
 	return &ctypes.ResultNetInfo{
-		Listening: env.P2PTransport.IsListening(),
-		Listeners: env.P2PTransport.Listeners(),
-		NPeers:    len(peers),
-		Peers:     peers,
+		Listening:          env.P2PTransport.IsListening(),
+		Listeners:          env.P2PTransport.Listeners(),
+		NPeers:             len(peers),
+		Peers:              peers,
+		Topology:           env.P2PPeers.Topology(),
+		TopologyViolations: env.P2PPeers.TopologyViolations(),
 	}, nil
 }
 
@@ -92,6 +99,55 @@ func UnsafeDialPeers(ctx *rpctypes.Context, peers []string, persistent, uncondit
 	return &ctypes.ResultDialPeers{Log: "Dialing peers in progress. See /net_info for details"}, nil
 }
 
+// UnsafeBanPeer bans the target, a node ID, an IP or an IP range in CIDR
+// notation, for the given duration (e.g. "1h"), or forever if empty. The
+// connected peers matching the ban are dropped right away.
+func UnsafeBanPeer(ctx *rpctypes.Context, target, duration, reason string) (*ctypes.ResultBanPeer, error) {
+	var d time.Duration
+	if duration != "" {
+		var err error
+		if d, err = time.ParseDuration(duration); err != nil {
+			return nil, fmt.Errorf("invalid duration: %w", err)
+		}
+	}
+
+	env.Logger.Info("BanPeer", "target", target, "duration", d, "reason", reason)
+
+	ban, err := env.P2PPeers.BanPeer(target, d, reason)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultBanPeer{Ban: ban}, nil
+}
+
+// UnsafeUnbanPeer lifts the ban of the target, a node ID, an IP or an IP range
+// in CIDR notation.
+func UnsafeUnbanPeer(ctx *rpctypes.Context, target string) (*ctypes.ResultUnbanPeer, error) {
+	env.Logger.Info("UnbanPeer", "target", target)
+
+	unbanned, err := env.P2PPeers.UnbanPeer(target)
+	if err != nil {
+		return nil, err
+	}
+	if !unbanned {
+		return nil, fmt.Errorf("%v is not banned", target)
+	}
+	return &ctypes.ResultUnbanPeer{}, nil
+}
+
+// UnsafeListBans returns the bans in effect, including the temporary bans of
+// the peers with a low trust score.
+func UnsafeListBans(ctx *rpctypes.Context) (*ctypes.ResultListBans, error) {
+	return &ctypes.ResultListBans{Bans: env.P2PPeers.Bans()}, nil
+}
+
+// UnsafeDumpAddrBook returns the stats of the addresses in the address book,
+// including their health, the healthiest first.
+func UnsafeDumpAddrBook(ctx *rpctypes.Context) (*ctypes.ResultDumpAddrBook, error) {
+	addrs := env.P2PAddrBook.Stats()
+	return &ctypes.ResultDumpAddrBook{NAddrs: len(addrs), Addrs: addrs}, nil
+}
+
 // Genesis returns genesis file.
 // More: https://docs.cometbft.com/v0.37/rpc/#/Info/genesis
 func Genesis(ctx *rpctypes.Context) (*ctypes.ResultGenesis, error) {
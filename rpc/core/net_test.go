@@ -1,6 +1,8 @@
 package core
 
 import (
+	"errors"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,6 +11,7 @@ import (
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/pex"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 )
 
@@ -85,3 +88,74 @@ func TestUnsafeDialPeers(t *testing.T) {
 		}
 	}
 }
+
+func TestUnsafeBanPeer(t *testing.T) {
+	sw := p2p.MakeSwitch(cfg.DefaultP2PConfig(), 1, "testing", "123.123.123",
+		func(n int, sw *p2p.Switch) *p2p.Switch { return sw })
+	err := sw.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := sw.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	env.Logger = log.TestingLogger()
+	env.P2PPeers = sw
+
+	testCases := []struct {
+		target, duration string
+		isErr            bool
+	}{
+		{"", "", true},
+		{"127.0.0.1:41198", "", true},
+		{"127.0.0.1", "1x", true},
+		{"127.0.0.1", "-1h", true},
+		{"127.0.0.1", "1h", false},
+		{"10.0.0.0/8", "", false},
+		{"d51fb70907db1c6c2d5237e78379b25cf1a37ab4", "10m", false},
+	}
+
+	for _, tc := range testCases {
+		res, err := UnsafeBanPeer(&rpctypes.Context{}, tc.target, tc.duration, "test")
+		if tc.isErr {
+			assert.Error(t, err, tc.target)
+		} else {
+			require.NoError(t, err, tc.target)
+			assert.Equal(t, tc.target, res.Ban.Target)
+			assert.Equal(t, tc.duration == "", res.Ban.Until.IsZero())
+		}
+	}
+
+	res, err := UnsafeListBans(&rpctypes.Context{})
+	require.NoError(t, err)
+	assert.Len(t, res.Bans, 3)
+
+	_, err = UnsafeUnbanPeer(&rpctypes.Context{}, "10.0.0.0/8")
+	require.NoError(t, err)
+	_, err = UnsafeUnbanPeer(&rpctypes.Context{}, "10.0.0.0/8")
+	require.Error(t, err)
+
+	res, err = UnsafeListBans(&rpctypes.Context{})
+	require.NoError(t, err)
+	assert.Len(t, res.Bans, 2)
+}
+
+func TestUnsafeDumpAddrBook(t *testing.T) {
+	book := pex.NewAddrBook(filepath.Join(t.TempDir(), "addrbook.json"), false)
+	book.SetLogger(log.TestingLogger())
+	env.P2PAddrBook = book
+
+	addr, err := p2p.NewNetAddressString("d51fb70907db1c6c2d5237e78379b25cf1a37ab4@127.0.0.1:41198")
+	require.NoError(t, err)
+	require.NoError(t, book.AddAddress(addr, addr))
+	book.RecordDial(addr, errors.New("i/o timeout"))
+
+	res, err := UnsafeDumpAddrBook(&rpctypes.Context{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, res.NAddrs)
+	require.Len(t, res.Addrs, 1)
+	assert.Equal(t, addr, res.Addrs[0].Addr)
+	require.Len(t, res.Addrs[0].Dials, 1)
+	assert.Equal(t, "i/o timeout", res.Addrs[0].Dials[0].Error)
+}
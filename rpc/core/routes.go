@@ -36,6 +36,7 @@ var Routes = map[string]*rpc.RPCFunc{
 	"consensus_params":     rpc.NewRPCFunc(ConsensusParams, "height", rpc.Cacheable("height")),
 	"unconfirmed_txs":      rpc.NewRPCFunc(UnconfirmedTxs, "limit"),
 	"num_unconfirmed_txs":  rpc.NewRPCFunc(NumUnconfirmedTxs, ""),
+	"tx_status":            rpc.NewRPCFunc(TxStatus, "hash"),
 
 	// tx broadcast API
 	"broadcast_tx_commit": rpc.NewRPCFunc(BroadcastTxCommit, "tx"),
@@ -50,8 +51,9 @@ var Routes = map[string]*rpc.RPCFunc{
 	"broadcast_evidence": rpc.NewRPCFunc(BroadcastEvidence, "evidence"),
 
 	// vote pool API
-	"broadcast_vote": rpc.NewRPCFunc(BroadcastVote, "vote"),
-	"query_vote":     rpc.NewRPCFunc(QueryVote, "event_type,event_hash"),
+	"broadcast_vote":    rpc.NewRPCFunc(BroadcastVote, "vote"),
+	"query_vote":        rpc.NewRPCFunc(QueryVote, "event_type,event_hash"),
+	"query_vote_quorum": rpc.NewRPCFunc(QueryVoteQuorum, "event_type,event_hash"),
 
 	// EVM json-rpc API
 	"eth_query": rpc.NewRPCFunc(EthQuery, "request"),
@@ -62,6 +64,11 @@ func AddUnsafeRoutes() {
 	// control API
 	Routes["dial_seeds"] = rpc.NewRPCFunc(UnsafeDialSeeds, "seeds")
 	Routes["dial_peers"] = rpc.NewRPCFunc(UnsafeDialPeers, "peers,persistent,unconditional,private")
+	Routes["ban_peer"] = rpc.NewRPCFunc(UnsafeBanPeer, "target,duration,reason")
+	Routes["unban_peer"] = rpc.NewRPCFunc(UnsafeUnbanPeer, "target")
+	Routes["list_bans"] = rpc.NewRPCFunc(UnsafeListBans, "")
+	Routes["dump_addrbook"] = rpc.NewRPCFunc(UnsafeDumpAddrBook, "")
 	Routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(UnsafeFlushMempool, "")
+	Routes["unsafe_export_mempool"] = rpc.NewRPCFunc(UnsafeExportMempool, "path")
 	Routes["unsafe_flush_vote_pool"] = rpc.NewRPCFunc(UnsafeFlushVotePool, "")
 }
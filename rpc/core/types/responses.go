@@ -8,6 +8,7 @@ import (
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/libs/bytes"
 	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/pex"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cometbft/cometbft/types"
 	"github.com/cometbft/cometbft/votepool"
@@ -120,6 +121,11 @@ type ResultNetInfo struct {
 	Listeners []string `json:"listeners"`
 	NPeers    int      `json:"n_peers"`
 	Peers     []Peer   `json:"peers"`
+
+	// Role of the node in the network topology, and the deviations of the
+	// peers from it.
+	Topology           string   `json:"topology"`
+	TopologyViolations []string `json:"topology_violations"`
 }
 
 // Log from dialing seeds
@@ -132,12 +138,31 @@ type ResultDialPeers struct {
 	Log string `json:"log"`
 }
 
+// Ban added by ban_peer
+type ResultBanPeer struct {
+	Ban p2p.Ban `json:"ban"`
+}
+
+// Bans in effect, listed by list_bans
+type ResultListBans struct {
+	Bans []p2p.Ban `json:"bans"`
+}
+
+// Addresses of the address book, dumped by dump_addrbook
+type ResultDumpAddrBook struct {
+	NAddrs int             `json:"n_addrs"`
+	Addrs  []pex.AddrStats `json:"addrs"`
+}
+
 // A peer
 type Peer struct {
 	NodeInfo         p2p.DefaultNodeInfo  `json:"node_info"`
 	IsOutbound       bool                 `json:"is_outbound"`
 	ConnectionStatus p2p.ConnectionStatus `json:"connection_status"`
 	RemoteIP         string               `json:"remote_ip"`
+	// Trust score of the peer between 0 and 100, if the trust metrics are
+	// enabled
+	TrustScore *int `json:"trust_score,omitempty"`
 }
 
 // Validators for a height.
@@ -180,6 +205,9 @@ type ResultBroadcastTx struct {
 	Data      bytes.HexBytes `json:"data"`
 	Log       string         `json:"log"`
 	Codespace string         `json:"codespace"`
+	// Set if the tx is valid, but the mempool rejected it (e.g. a quota is
+	// exceeded)
+	MempoolError string `json:"mempool_error,omitempty"`
 
 	Hash bytes.HexBytes `json:"hash"`
 }
@@ -219,6 +247,20 @@ type ResultBlockSearch struct {
 	TotalCount int            `json:"total_count"`
 }
 
+// TxStatusCommitted is the status of a tx found by the tx indexer.
+const TxStatusCommitted = "committed"
+
+// Status of a tx in the tx indexer, the mempool and its cache
+type ResultTxStatus struct {
+	Hash   bytes.HexBytes `json:"hash"`
+	Status string         `json:"status"`
+
+	// Set if the tx was committed
+	Height int64  `json:"height,omitempty"`
+	Index  uint32 `json:"index,omitempty"`
+	Code   uint32 `json:"code,omitempty"`
+}
+
 // List of mempool txs
 type ResultUnconfirmedTxs struct {
 	Count      int        `json:"n_txs"`
@@ -252,9 +294,21 @@ type ResultQueryVote struct {
 	Votes []*votepool.Vote `json:"votes"`
 }
 
+// Result of query the aggregated votes of an event which has reached quorum
+type ResultQueryVoteQuorum struct {
+	Quorum *types.EventDataVoteQuorum `json:"quorum"`
+}
+
+// Snapshot of the mempool written by unsafe_export_mempool
+type ResultUnsafeExportMempool struct {
+	Path   string `json:"path"`
+	NumTxs int    `json:"n_txs"`
+}
+
 // empty results
 type (
 	ResultUnsafeFlushMempool struct{}
+	ResultUnbanPeer          struct{}
 	ResultUnsafeProfile      struct{}
 	ResultSubscribe          struct{}
 	ResultUnsubscribe        struct{}
@@ -1,6 +1,8 @@
 package core
 
 import (
+	"fmt"
+
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	"github.com/cometbft/cometbft/votepool"
@@ -12,6 +14,10 @@ func BroadcastVote(ctx *rpctypes.Context, vote votepool.Vote) (*ctypes.ResultBro
 }
 
 func QueryVote(ctx *rpctypes.Context, eventType int, eventHash []byte) (*ctypes.ResultQueryVote, error) {
+	if eventType < 0 || eventType > int(^uint8(0)) || !env.VotePool.EventTypes().Has(votepool.EventType(eventType)) {
+		return nil, fmt.Errorf("unsupported event type %d", eventType)
+	}
+
 	var votes []*votepool.Vote
 	var err error
 	if len(eventHash) == 0 {
@@ -23,6 +29,18 @@ func QueryVote(ctx *rpctypes.Context, eventType int, eventHash []byte) (*ctypes.
 	return &ctypes.ResultQueryVote{Votes: votes}, err
 }
 
+func QueryVoteQuorum(ctx *rpctypes.Context, eventType int, eventHash []byte) (*ctypes.ResultQueryVoteQuorum, error) {
+	if eventType < 0 || eventType > int(^uint8(0)) || !env.VotePool.EventTypes().Has(votepool.EventType(eventType)) {
+		return nil, fmt.Errorf("unsupported event type %d", eventType)
+	}
+
+	quorum, err := env.VotePool.GetVoteQuorum(votepool.EventType(eventType), eventHash)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultQueryVoteQuorum{Quorum: quorum}, nil
+}
+
 func UnsafeFlushVotePool(ctx *rpctypes.Context) (*ctypes.ResultFlushVote, error) {
 	env.VotePool.FlushVotes()
 	return &ctypes.ResultFlushVote{}, nil
@@ -0,0 +1,103 @@
+package coreeth
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/cometbft/cometbft/libs/log"
+	core "github.com/cometbft/cometbft/rpc/core"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+// NewHandler returns an http.Handler which serves the Ethereum JSON-RPC 2.0 API, so that
+// Ethereum wallets can connect to the node directly, e.g. for signing EIP-712 typed messages.
+//
+// Only the methods in rpctypes.SupportedEthQueryRequests are served. Each call is forwarded
+// to the application through the eth_query ABCI connection, and the application's response
+// is returned in the Ethereum wire format. Both single and batch requests are supported.
+func NewHandler(logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeResponse(w, logger, rpctypes.RPCInvalidRequestError(nil, fmt.Errorf("error reading request body: %w", err)))
+			return
+		}
+
+		// a batch request is an array of requests, and must be replied with an array of responses,
+		// even if it contains a single request
+		var (
+			requests []rpctypes.RPCRequest
+			isBatch  = len(bytes.TrimSpace(b)) > 0 && bytes.TrimSpace(b)[0] == '['
+		)
+		if isBatch {
+			if err := json.Unmarshal(b, &requests); err != nil {
+				writeResponse(w, logger, rpctypes.RPCParseError(fmt.Errorf("error unmarshaling request: %w", err)))
+				return
+			}
+			if len(requests) == 0 {
+				writeResponse(w, logger, rpctypes.RPCInvalidRequestError(nil, errors.New("empty batch")))
+				return
+			}
+		} else {
+			var request rpctypes.RPCRequest
+			if err := json.Unmarshal(b, &request); err != nil {
+				writeResponse(w, logger, rpctypes.RPCParseError(fmt.Errorf("error unmarshaling request: %w", err)))
+				return
+			}
+			requests = []rpctypes.RPCRequest{request}
+		}
+
+		responses := make([]rpctypes.RPCResponse, 0, len(requests))
+		for _, request := range requests {
+			request := request
+
+			// A Notification is a Request object without an "id" member.
+			// The Server MUST NOT reply to a Notification, including those that are within a batch request.
+			if request.ID == nil {
+				logger.Debug("Ethereum JSON-RPC received a notification, skipping...", "req", request)
+				continue
+			}
+			responses = append(responses, handleRequest(&rpctypes.Context{JSONReq: &request, HTTPReq: r}))
+		}
+
+		switch {
+		case len(responses) == 0:
+			w.WriteHeader(http.StatusOK)
+		case isBatch:
+			writeResponse(w, logger, responses)
+		default:
+			writeResponse(w, logger, responses[0])
+		}
+	})
+}
+
+// handleRequest forwards a request to the application, and converts the application's response.
+func handleRequest(ctx *rpctypes.Context) rpctypes.RPCResponse {
+	return rpctypes.NewEthQueryResponse(ctx.JSONReq, func(bz []byte) (interface{}, error) {
+		return core.EthQuery(ctx, bz)
+	})
+}
+
+func writeResponse(w http.ResponseWriter, logger log.Logger, v interface{}) {
+	jsonBytes, err := json.Marshal(v)
+	if err != nil {
+		logger.Error("failed to marshal response", "err", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(jsonBytes); err != nil {
+		logger.Error("failed to write response", "err", err)
+	}
+}
@@ -0,0 +1,128 @@
+package coreeth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proxy/mocks"
+	core "github.com/cometbft/cometbft/rpc/core"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+func setupApp(t *testing.T) *mocks.AppConnEthQuery {
+	app := mocks.NewAppConnEthQuery(t)
+	core.SetEnvironment(&core.Environment{ProxyAppEthQuery: app})
+	return app
+}
+
+func doRequest(t *testing.T, method, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	NewHandler(log.TestingLogger()).ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandler_SingleRequest(t *testing.T) {
+	app := setupApp(t)
+	app.On("EthQuerySync", mock.MatchedBy(func(req abci.RequestEthQuery) bool {
+		return strings.Contains(string(req.Request), rpctypes.EthChainID)
+	})).Return(&abci.ResponseEthQuery{Response: []byte{0x03, 0xf5}}, nil)
+
+	rec := doRequest(t, http.MethodPost, `{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var res rpctypes.RPCResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Nil(t, res.Error)
+	require.Equal(t, rpctypes.JSONRPCIntID(1), res.ID)
+	require.JSONEq(t, `"0x3f5"`, string(res.Result))
+}
+
+func TestHandler_SignTypedData(t *testing.T) {
+	app := setupApp(t)
+	app.On("EthQuerySync", mock.MatchedBy(func(req abci.RequestEthQuery) bool {
+		return strings.Contains(string(req.Request), rpctypes.EthSignTypedDataV4)
+	})).Return(&abci.ResponseEthQuery{Response: []byte{0x00, 0xab, 0x1b}}, nil)
+
+	rec := doRequest(t, http.MethodPost, `{"jsonrpc":"2.0","id":1,"method":"eth_signTypedData_v4","params":["0x00","{}"]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// the signature is returned in full
+	var res rpctypes.RPCResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Nil(t, res.Error)
+	require.JSONEq(t, `"0x00ab1b"`, string(res.Result))
+}
+
+func TestHandler_BatchRequest(t *testing.T) {
+	app := setupApp(t)
+	app.On("EthQuerySync", mock.MatchedBy(func(req abci.RequestEthQuery) bool {
+		return strings.Contains(string(req.Request), rpctypes.NetVersion)
+	})).Return(&abci.ResponseEthQuery{Response: []byte{0x03, 0xf5}}, nil)
+	app.On("EthQuerySync", mock.MatchedBy(func(req abci.RequestEthQuery) bool {
+		return strings.Contains(string(req.Request), rpctypes.EthGetBalance)
+	})).Return(&abci.ResponseEthQuery{Code: 1, Log: "invalid address"}, nil)
+
+	body := `[
+		{"jsonrpc":"2.0","id":"a","method":"net_version","params":[]},
+		{"jsonrpc":"2.0","id":"b","method":"eth_getBalance","params":["0x00","latest"]},
+		{"jsonrpc":"2.0","id":"c","method":"eth_sign","params":[]},
+		{"jsonrpc":"2.0","method":"net_version","params":[]}
+	]`
+	rec := doRequest(t, http.MethodPost, body)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// the notification is not replied
+	var res []rpctypes.RPCResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, 3, len(res))
+
+	require.Equal(t, rpctypes.JSONRPCStringID("a"), res[0].ID)
+	require.Nil(t, res[0].Error)
+	require.JSONEq(t, `"1013"`, string(res[0].Result))
+
+	require.Equal(t, rpctypes.JSONRPCStringID("b"), res[1].ID)
+	require.NotNil(t, res[1].Error)
+	require.Equal(t, -32000, res[1].Error.Code)
+	require.Equal(t, "invalid address", res[1].Error.Data)
+
+	require.Equal(t, rpctypes.JSONRPCStringID("c"), res[2].ID)
+	require.NotNil(t, res[2].Error)
+	require.Equal(t, -32601, res[2].Error.Code)
+
+	// a batch with a single request is replied with an array
+	rec = doRequest(t, http.MethodPost, `[{"jsonrpc":"2.0","id":"a","method":"net_version","params":[]}]`)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, 1, len(res))
+}
+
+func TestHandler_InvalidRequest(t *testing.T) {
+	setupApp(t)
+
+	rec := doRequest(t, http.MethodGet, "")
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	testCases := []struct {
+		body    string
+		expCode int
+	}{
+		{`{"jsonrpc":"2.0","id":1,`, -32700},
+		{`[{"jsonrpc":"2.0","id":1,]`, -32700},
+		{`[]`, -32600},
+	}
+	for _, tc := range testCases {
+		rec := doRequest(t, http.MethodPost, tc.body)
+		var res rpctypes.RPCResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res), tc.body)
+		require.NotNil(t, res.Error, tc.body)
+		require.Equal(t, tc.expCode, res.Error.Code, tc.body)
+	}
+}
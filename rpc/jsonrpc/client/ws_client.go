@@ -608,6 +608,10 @@ func (c *WSClient) CheckTx(ctx context.Context, id types.JSONRPCIntID, tx bfttyp
 	return c.CallWithID(ctx, id, "check_tx", map[string]interface{}{"tx": tx})
 }
 
+func (c *WSClient) TxStatus(ctx context.Context, id types.JSONRPCIntID, hash []byte) error {
+	return c.CallWithID(ctx, id, "tx_status", map[string]interface{}{"hash": hash})
+}
+
 func (c *WSClient) NetInfo(ctx context.Context, id types.JSONRPCIntID) error {
 	return c.CallWithID(ctx, id, "net_info", map[string]interface{}{})
 }
@@ -797,3 +801,12 @@ func (c *WSClient) QueryVote(
 ) error {
 	return c.CallWithID(ctx, id, "query_vote", map[string]interface{}{"event_type": eventType, "event_hash": eventHash})
 }
+
+func (c *WSClient) QueryVoteQuorum(
+	ctx context.Context,
+	eventType int,
+	eventHash []byte,
+	id types.JSONRPCIntID,
+) error {
+	return c.CallWithID(ctx, id, "query_vote_quorum", map[string]interface{}{"event_type": eventType, "event_hash": eventHash})
+}
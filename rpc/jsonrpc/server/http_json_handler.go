@@ -86,38 +86,23 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.Han
 
 			if !ok {
 				// try eth_query
-				var isEthQuery bool
-				for _, method := range types.SupportedEthQueryRequests {
-					if method == request.Method {
-						isEthQuery = true
-						break
-					}
-				}
-				if !isEthQuery {
+				rpcFunc, ok = funcMap["eth_query"]
+				if !ok {
 					responses = append(responses, types.RPCMethodNotFoundError(request.ID))
 					cache = false
 					continue
 				}
-
-				rpcFunc = funcMap["eth_query"]
-				bz, err := json.Marshal(request)
-				if err != nil {
-					responses = append(responses, types.RPCInvalidRequestError(request.ID, err))
-					continue
-				}
-				args = append(args, reflect.ValueOf(bz))
-
-				if cache && !rpcFunc.cacheableWithArgs(args) {
+				res := types.NewEthQueryResponse(&request, func(bz []byte) (interface{}, error) {
+					args = append(args, reflect.ValueOf(bz))
+					if cache && !rpcFunc.cacheableWithArgs(args) {
+						cache = false
+					}
+					return unreflectResult(rpcFunc.f.Call(args))
+				})
+				if res.Error != nil {
 					cache = false
 				}
-
-				returns := rpcFunc.f.Call(args)
-				result, err := unreflectResult(returns)
-				if err != nil {
-					responses = append(responses, types.RPCInternalError(request.ID, err))
-					continue
-				}
-				responses = append(responses, types.NewEthRPCSuccessResponse(request.ID, result, request.Method))
+				responses = append(responses, res)
 			} else {
 				// normal tendermint request
 				if rpcFunc.ws {
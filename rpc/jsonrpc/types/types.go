@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"net/http"
@@ -32,6 +33,9 @@ const (
 	EthCall                = "eth_call"
 	EthGetTransactionCount = "eth_getTransactionCount"
 	EthSendRawTransaction  = "eth_sendRawTransaction"
+	EthSignTypedData       = "eth_signTypedData"
+	EthSignTypedDataV3     = "eth_signTypedData_v3"
+	EthSignTypedDataV4     = "eth_signTypedData_v4"
 )
 
 var SupportedEthQueryRequests = []string{
@@ -47,6 +51,9 @@ var SupportedEthQueryRequests = []string{
 	EthCall,
 	EthGetTransactionCount,
 	EthSendRawTransaction,
+	EthSignTypedData,
+	EthSignTypedDataV3,
+	EthSignTypedDataV4,
 }
 
 // a wrapper to emulate a sum type: jsonrpcid = string | int
@@ -237,6 +244,34 @@ func NewRPCSuccessResponse(id jsonrpcid, res interface{}) RPCResponse {
 	return RPCResponse{JSONRPC: "2.0", ID: id, Result: rawMsg}
 }
 
+// IsEthQueryRequest returns true if the method is one of the SupportedEthQueryRequests.
+func IsEthQueryRequest(method string) bool {
+	for _, m := range SupportedEthQueryRequests {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// NewEthQueryResponse forwards the Ethereum JSON-RPC request to the application with ethQuery,
+// which calls eth_query with the marshaled request, and converts the application's response.
+func NewEthQueryResponse(request *RPCRequest, ethQuery func(bz []byte) (interface{}, error)) RPCResponse {
+	if !IsEthQueryRequest(request.Method) {
+		return RPCMethodNotFoundError(request.ID)
+	}
+
+	bz, err := json.Marshal(request)
+	if err != nil {
+		return RPCInvalidRequestError(request.ID, err)
+	}
+	result, err := ethQuery(bz)
+	if err != nil {
+		return RPCInternalError(request.ID, err)
+	}
+	return NewEthRPCSuccessResponse(request.ID, result, request.Method)
+}
+
 func NewEthRPCSuccessResponse(id jsonrpcid, res interface{}, method string) RPCResponse {
 	var rawMsg json.RawMessage
 
@@ -256,9 +291,14 @@ func NewEthRPCSuccessResponse(id jsonrpcid, res interface{}, method string) RPCR
 		}
 
 		// remove the nested structs and convert from base64 to hex string
-		ethResponse := v.(map[string]interface{})["response"]
-		response := ethResponse.(map[string]interface{})["response"]
-		bz, err := base64.StdEncoding.DecodeString(response.(string))
+		ethResponse := v.(map[string]interface{})["response"].(map[string]interface{})
+		// the application failed to serve the request
+		if code, ok := ethResponse["code"]; ok && fmt.Sprint(code) != "0" {
+			log, _ := ethResponse["log"].(string)
+			return RPCServerError(id, errors.New(log))
+		}
+		response, _ := ethResponse["response"].(string)
+		bz, err := base64.StdEncoding.DecodeString(response)
 		if err != nil {
 			return RPCInternalError(id, fmt.Errorf("error decode response: %w", err))
 		}
@@ -289,6 +329,12 @@ func NewEthRPCSuccessResponse(id jsonrpcid, res interface{}, method string) RPCR
 			if err != nil {
 				return RPCInternalError(id, fmt.Errorf("error decode response: %w", err))
 			}
+		// return the signature of the typed data as a hex string
+		case EthSignTypedData, EthSignTypedDataV3, EthSignTypedDataV4:
+			result, err = json.Marshal("0x" + hex.EncodeToString(bz))
+			if err != nil {
+				return RPCInternalError(id, fmt.Errorf("error decode response: %w", err))
+			}
 		case EthSendRawTransaction:
 			return RPCInvalidRequestError(id, fmt.Errorf("transfer BNB through EVM wallet on Greenfield is not available yet, please go to decellar.io or refer to latest docs"))
 		//return int string for net_version
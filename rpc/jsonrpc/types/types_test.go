@@ -81,3 +81,47 @@ func TestRPCError(t *testing.T) {
 			Message: "Badness",
 		}))
 }
+
+type sampleEthQueryResult struct {
+	Response struct {
+		Code     uint32 `json:"code,omitempty"`
+		Log      string `json:"log,omitempty"`
+		Response []byte `json:"response,omitempty"`
+	} `json:"response"`
+}
+
+func TestNewEthQueryResponse(t *testing.T) {
+	id := JSONRPCIntID(1)
+	ethQuery := func(code uint32, log string, response []byte) func(bz []byte) (interface{}, error) {
+		return func(bz []byte) (interface{}, error) {
+			var req RPCRequest
+			if err := json.Unmarshal(bz, &req); err != nil {
+				return nil, err
+			}
+			res := &sampleEthQueryResult{}
+			res.Response.Code, res.Response.Log, res.Response.Response = code, log, response
+			return res, nil
+		}
+	}
+
+	// the methods which are not supported are not forwarded to the application
+	res := NewEthQueryResponse(&RPCRequest{ID: id, Method: "eth_accounts"}, func([]byte) (interface{}, error) {
+		t.Fatal("unsupported method forwarded to the application")
+		return nil, nil
+	})
+	assert.Equal(t, -32601, res.Error.Code)
+
+	res = NewEthQueryResponse(&RPCRequest{ID: id, Method: EthChainID}, ethQuery(0, "", []byte{0x03, 0x83}))
+	assert.Nil(t, res.Error)
+	assert.Equal(t, `"0x383"`, string(res.Result))
+
+	// an error of the application is a server error
+	res = NewEthQueryResponse(&RPCRequest{ID: id, Method: EthGetBalance}, ethQuery(1, "invalid address", nil))
+	assert.Equal(t, -32000, res.Error.Code)
+	assert.Equal(t, "invalid address", res.Error.Data)
+
+	res = NewEthQueryResponse(&RPCRequest{ID: id, Method: EthChainID}, func([]byte) (interface{}, error) {
+		return nil, errors.New("connection lost")
+	})
+	assert.Equal(t, -32603, res.Error.Code)
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
 	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
 	"github.com/cometbft/cometbft/libs/fail"
 	"github.com/cometbft/cometbft/libs/log"
@@ -17,8 +19,6 @@ import (
 	"github.com/cometbft/cometbft/types"
 )
 
-const maxPreExecuteThread = 64
-
 //-----------------------------------------------------------------------------
 // BlockExecutor handles block execution and state updates.
 // It exposes ApplyBlock(), which validates & executes the block, updates state w/ ABCI responses,
@@ -33,6 +33,13 @@ type BlockExecutor struct {
 	proxyApp         proxy.AppConnConsensus
 	proxyPrefetchApp proxy.AppConnPrefetch
 
+	// decide how many workers are used to prefetch a block
+	prefetcher *prefetchScheduler
+
+	// closed once the last prefetch has exited
+	prefetchMtx    sync.Mutex
+	prefetchDoneCh chan struct{}
+
 	// events
 	eventBus types.BlockEventPublisher
 
@@ -54,6 +61,13 @@ func BlockExecutorWithMetrics(metrics *Metrics) BlockExecutorOption {
 	}
 }
 
+// BlockExecutorWithPrefetchConfig sets the configuration for prefetching blocks.
+func BlockExecutorWithPrefetchConfig(cfg *config.PrefetchConfig) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.prefetcher = newPrefetchScheduler(cfg)
+	}
+}
+
 // NewBlockExecutor returns a new BlockExecutor with a NopEventBus.
 // Call SetEventBus to provide one.
 func NewBlockExecutor(
@@ -69,6 +83,7 @@ func NewBlockExecutor(
 		store:            stateStore,
 		proxyApp:         proxyApp,
 		proxyPrefetchApp: proxyPrefetchApp,
+		prefetcher:       newPrefetchScheduler(config.DefaultPrefetchConfig()),
 		eventBus:         types.NopEventBus{},
 		mempool:          mempool,
 		evpool:           evpool,
@@ -196,28 +211,44 @@ func (blockExec *BlockExecutor) ValidateBlock(state State, block *types.Block, s
 func (blockExec *BlockExecutor) ApplyBlock(
 	state State, blockID types.BlockID, block *types.Block,
 	skipAppHashVerify bool,
+) (State, int64, error) {
+	return blockExec.applyBlock(state, blockID, block, block, skipAppHashVerify)
+}
+
+// ApplyBlockWithNext is like ApplyBlock, but prefetches the next block instead of the applied one,
+// so that the txs of the next block are pre-executed while the current block is being executed.
+// It's used when committed blocks are applied back to back, e.g. during blocksync.
+// The next block can be nil, and nothing is prefetched then.
+func (blockExec *BlockExecutor) ApplyBlockWithNext(
+	state State, blockID types.BlockID, block *types.Block, next *types.Block,
+	skipAppHashVerify bool,
+) (State, int64, error) {
+	return blockExec.applyBlock(state, blockID, block, next, skipAppHashVerify)
+}
+
+func (blockExec *BlockExecutor) applyBlock(
+	state State, blockID types.BlockID, block *types.Block, prefetchBlock *types.Block,
+	skipAppHashVerify bool,
 ) (State, int64, error) {
 	if err := validateBlock(state, block, skipAppHashVerify); err != nil {
 		return state, 0, ErrInvalidBlock(err)
 	}
 
-	interruptCh := make(chan struct{})
-	// do pre execute for cache
-	if blockExec.proxyPrefetchApp != nil {
-		go func() {
-			blockExec.prefetch(block, interruptCh)
-		}()
-	}
+	// do pre execute for cache, and make sure it's stopped if the block fails to be applied
+	stopPrefetch := blockExec.Prefetch(prefetchBlock)
+	defer stopPrefetch()
 
 	startTime := time.Now()
 	abciResponses, err := execBlockOnProxyApp(
 		blockExec.logger, blockExec.proxyApp, block, blockExec.store, state.InitialHeight,
 	)
-	elapseTime := time.Since(startTime).Milliseconds()
+	elapse := time.Since(startTime)
+	elapseTime := elapse.Milliseconds()
 	blockExec.metrics.BlockProcessingTime.Set(float64(elapseTime))
 	if err != nil {
 		return state, 0, ErrProxyAppConn(err)
 	}
+	blockExec.prefetcher.recordExecution(len(block.Txs), elapse)
 
 	fail.Fail() // XXX
 
@@ -268,7 +299,7 @@ func (blockExec *BlockExecutor) ApplyBlock(
 	elapseTime = time.Since(startTime).Milliseconds()
 	blockExec.metrics.CommitState.Set(float64(elapseTime))
 
-	close(interruptCh) // stop pre-execution
+	stopPrefetch() // stop pre-execution
 
 	// Update evpool with the latest state.
 	blockExec.evpool.Update(state, block.Evidence.Evidence)
@@ -334,6 +365,7 @@ func (blockExec *BlockExecutor) Commit(
 		block.Height,
 		block.Txs,
 		deliverTxResponses,
+		res.RecheckFilter,
 		TxPreCheck(state),
 		TxPostCheck(state),
 	)
@@ -658,18 +690,46 @@ func ExecCommitBlock(
 
 //---------------------------------------------------------
 
+// Prefetch starts pre-executing the txs of the block in the background, to warm up the application's
+// cache for executing the block. It's a no-op if there is no prefetch connection or the block is nil.
+// The returned function stops prefetching without waiting for it to exit. Since the application keeps
+// a single set of prefetch states, a block is only prefetched once the previous prefetch has exited.
+func (blockExec *BlockExecutor) Prefetch(block *types.Block) (stop func()) {
+	if blockExec.proxyPrefetchApp == nil || block == nil {
+		return func() {}
+	}
+
+	interruptCh := make(chan struct{})
+	doneCh := make(chan struct{})
+	blockExec.prefetchMtx.Lock()
+	prevDoneCh := blockExec.prefetchDoneCh
+	blockExec.prefetchDoneCh = doneCh
+	blockExec.prefetchMtx.Unlock()
+	go func() {
+		defer close(doneCh)
+		if prevDoneCh != nil {
+			<-prevDoneCh
+		}
+		blockExec.prefetch(block, interruptCh)
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(interruptCh) })
+	}
+}
+
+// prefetch pre-executes the txs of the block in parallel, to warm up the application's cache.
+// It stops once interruptCh is closed, e.g. the block has been committed.
 func (blockExec *BlockExecutor) prefetch(
 	block *types.Block,
 	interruptCh <-chan struct{},
 ) {
 	// 1. calculate preExecuteThread number
 	txs := block.Txs
-	if len(txs) < 100 {
-		return // if txs less than 100, no need to prefetch
-	}
-	preExecuteThread := len(txs)/100 + 3
-	if preExecuteThread > maxPreExecuteThread {
-		preExecuteThread = maxPreExecuteThread
+	preExecuteThread := blockExec.prefetcher.workers(len(txs))
+	if preExecuteThread == 0 {
+		return // no need to prefetch, e.g. there are too few txs
 	}
 
 	// 2. prepare pre-deliver state and execute txs
@@ -678,6 +738,7 @@ func (blockExec *BlockExecutor) prefetch(
 		return
 	}
 
+	startTime := time.Now()
 	err := blockExec.proxyPrefetchApp.PreBeginBlockSync(abci.RequestPreBeginBlock{
 		StateNumber: int64(preExecuteThread),
 		Hash:        block.Hash(),
@@ -687,8 +748,22 @@ func (blockExec *BlockExecutor) prefetch(
 		blockExec.logger.Error("error in proxyAppConn.PreBeginBlock", "err", err)
 		return
 	}
+	blockExec.metrics.PrefetchWorkers.Set(float64(preExecuteThread))
+
+	var (
+		wg         sync.WaitGroup
+		prefetched atomic.Int64
+	)
+	defer func() {
+		elapse := time.Since(startTime)
+		blockExec.metrics.PrefetchDurationSeconds.Observe(elapse.Seconds())
+		if n := int(prefetched.Load()); n < len(txs) {
+			blockExec.metrics.PrefetchInterrupts.Add(1)
+			blockExec.logger.Debug("prefetch interrupted", "height", block.Height, "num_txs", len(txs), "prefetched", n)
+		}
+		blockExec.prefetcher.recordPrefetch(int(prefetched.Load()), preExecuteThread, elapse)
+	}()
 
-	var wg sync.WaitGroup
 	txChan := make(chan int, preExecuteThread)
 	for i := 0; i < preExecuteThread; i++ {
 		idx := int64(i)
@@ -709,6 +784,7 @@ func (blockExec *BlockExecutor) prefetch(
 			for txIdx := range txChan {
 				tx := txs[txIdx]
 				blockExec.proxyPrefetchApp.PreDeliverTxAsync(abci.RequestPreDeliverTx{StateIndex: idx, Tx: tx})
+				prefetched.Add(1)
 
 				select {
 				case <-interruptCh:
@@ -740,6 +816,7 @@ func (blockExec *BlockExecutor) PreCommit(
 ) {
 	if err := blockExec.proxyPrefetchApp.PreCommitSync(abci.RequestPreCommit{StateIndex: stateIndex}); err != nil {
 		blockExec.logger.Error("client error during proxyPrefetchApp.PreCommitSync", "err", err)
+		blockExec.metrics.PrefetchPreCommitFailures.Add(1)
 		return
 	}
 
@@ -1,8 +1,10 @@
 package state_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	abciclientmocks "github.com/cometbft/cometbft/abci/client/mocks"
 	abci "github.com/cometbft/cometbft/abci/types"
 	abcimocks "github.com/cometbft/cometbft/abci/types/mocks"
+	"github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
@@ -74,6 +77,108 @@ func TestApplyBlock(t *testing.T) {
 	assert.EqualValues(t, 1, state.Version.Consensus.App, "App version wasn't updated")
 }
 
+func TestApplyBlockWithNext(t *testing.T) {
+	app := &testApp{}
+	cc := proxy.NewLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc, proxy.NopMetrics())
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, _ := makeState(1, 1)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	mp := &mpmocks.Mempool{}
+	mp.On("Lock").Return()
+	mp.On("Unlock").Return()
+	mp.On("FlushAppConn", mock.Anything).Return(nil)
+	mp.On("Update",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything).Return(nil)
+
+	block := makeBlock(state, 1, new(types.Commit))
+	bps, err := block.MakePartSet(testPartSize)
+	require.NoError(t, err)
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: bps.Header()}
+	next := makeBlock(state, 2, new(types.Commit))
+
+	// the next block is prefetched instead of the applied one
+	prefetchApp := pmocks.NewAppConnPrefetch(t)
+	var prefetched atomic.Int32
+	prefetchApp.On("PreBeginBlockSync", mock.MatchedBy(func(req abci.RequestPreBeginBlock) bool {
+		return bytes.Equal(req.Hash, next.Hash())
+	})).Run(func(mock.Arguments) { prefetched.Add(1) }).Return(nil).Once()
+	// prefetching can be interrupted by the commit of the applied block
+	prefetchApp.On("PreDeliverTxAsync", mock.Anything).Return().Maybe()
+	prefetchApp.On("PreCommitSync", mock.Anything).Return(nil).Maybe()
+
+	prefetchConfig := config.DefaultPrefetchConfig()
+	prefetchConfig.MinTxs = 0
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(), prefetchApp,
+		mp, sm.EmptyEvidencePool{}, sm.BlockExecutorWithPrefetchConfig(prefetchConfig))
+
+	_, _, err = blockExec.ApplyBlockWithNext(state, blockID, block, next, false)
+	require.Nil(t, err)
+	require.Eventually(t, func() bool { return prefetched.Load() == 1 }, time.Second, 10*time.Millisecond)
+
+	// nothing is prefetched without the next block
+	_, _, err = blockExec.ApplyBlockWithNext(state, blockID, block, nil, false)
+	require.Nil(t, err)
+	assert.EqualValues(t, 1, prefetched.Load())
+}
+
+func TestPrefetchOneBlockAtATime(t *testing.T) {
+	state, _, _ := makeState(1, 1)
+	first := makeBlock(state, 1, new(types.Commit))
+	second := makeBlock(state, 2, new(types.Commit))
+
+	// prefetching the first block blocks until it's released
+	releaseCh := make(chan struct{})
+	prefetchedCh := make(chan int64, 2)
+	prefetchApp := pmocks.NewAppConnPrefetch(t)
+	prefetchApp.On("PreBeginBlockSync", mock.Anything).Run(func(args mock.Arguments) {
+		req := args.Get(0).(abci.RequestPreBeginBlock)
+		if req.Header.Height == first.Height {
+			<-releaseCh
+		}
+		prefetchedCh <- req.Header.Height
+	}).Return(nil)
+	prefetchApp.On("PreDeliverTxAsync", mock.Anything).Return().Maybe()
+	prefetchApp.On("PreCommitSync", mock.Anything).Return(nil).Maybe()
+
+	prefetchConfig := config.DefaultPrefetchConfig()
+	prefetchConfig.MinTxs = 0
+	blockExec := sm.NewBlockExecutor(nil, log.TestingLogger(), nil, prefetchApp,
+		nil, sm.EmptyEvidencePool{}, sm.BlockExecutorWithPrefetchConfig(prefetchConfig))
+
+	// stopping doesn't wait for the prefetch to exit
+	stop := blockExec.Prefetch(first)
+	stop()
+	stop = blockExec.Prefetch(second)
+	defer stop()
+
+	// the second block isn't prefetched until the first prefetch has exited
+	select {
+	case h := <-prefetchedCh:
+		t.Fatalf("block %d was prefetched before the first prefetch exited", h)
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(releaseCh)
+	for _, h := range []int64{first.Height, second.Height} {
+		select {
+		case prefetched := <-prefetchedCh:
+			assert.Equal(t, h, prefetched)
+		case <-time.After(time.Second):
+			t.Fatal("the blocks weren't prefetched")
+		}
+	}
+}
+
 // TestBeginBlockValidators ensures we send absent validators list.
 func TestBeginBlockValidators(t *testing.T) {
 	app := &testApp{}
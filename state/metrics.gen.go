@@ -56,17 +56,47 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "validator_set_updates",
 			Help:      "ValidatorSetUpdates is the total number of times the application has udated the validator set since process start.",
 		}, labels).With(labelsAndValues...),
+		PrefetchWorkers: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "prefetch_workers",
+			Help:      "Number of workers prefetching the latest prefetched block.",
+		}, labels).With(labelsAndValues...),
+		PrefetchDurationSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "prefetch_duration_seconds",
+			Help:      "Time spent on prefetching a block, in seconds.",
+
+			Buckets: stdprometheus.ExponentialBucketsRange(0.001, 10, 10),
+		}, labels).With(labelsAndValues...),
+		PrefetchInterrupts: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "prefetch_interrupts",
+			Help:      "Number of prefetched blocks which were committed before all their txs were prefetched.",
+		}, labels).With(labelsAndValues...),
+		PrefetchPreCommitFailures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "prefetch_pre_commit_failures",
+			Help:      "Number of failed PreCommit calls to the application after prefetching.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
 func NopMetrics() *Metrics {
 	return &Metrics{
-		BlockProcessingTime:   discard.NewGauge(),
-		SaveABCIResponse:      discard.NewGauge(),
-		UpdateState:           discard.NewGauge(),
-		CommitState:           discard.NewGauge(),
-		SaveState:             discard.NewGauge(),
-		ConsensusParamUpdates: discard.NewCounter(),
-		ValidatorSetUpdates:   discard.NewCounter(),
+		BlockProcessingTime:       discard.NewGauge(),
+		SaveABCIResponse:          discard.NewGauge(),
+		UpdateState:               discard.NewGauge(),
+		CommitState:               discard.NewGauge(),
+		SaveState:                 discard.NewGauge(),
+		ConsensusParamUpdates:     discard.NewCounter(),
+		ValidatorSetUpdates:       discard.NewCounter(),
+		PrefetchWorkers:           discard.NewGauge(),
+		PrefetchDurationSeconds:   discard.NewHistogram(),
+		PrefetchInterrupts:        discard.NewCounter(),
+		PrefetchPreCommitFailures: discard.NewCounter(),
 	}
 }
@@ -31,4 +31,17 @@ type Metrics struct {
 	// ValidatorSetUpdates is the total number of times the application has
 	// udated the validator set since process start.
 	ValidatorSetUpdates metrics.Counter
+
+	// Number of workers prefetching the latest prefetched block.
+	PrefetchWorkers metrics.Gauge
+
+	// Time spent on prefetching a block, in seconds.
+	PrefetchDurationSeconds metrics.Histogram `metrics_buckettype:"exprange" metrics_bucketsizes:"0.001, 10, 10"`
+
+	// Number of prefetched blocks which were committed before all their txs
+	// were prefetched.
+	PrefetchInterrupts metrics.Counter
+
+	// Number of failed PreCommit calls to the application after prefetching.
+	PrefetchPreCommitFailures metrics.Counter
 }
@@ -0,0 +1,90 @@
+package state
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/config"
+)
+
+const (
+	// Weight of the latest sample in the moving averages of the prefetch scheduler.
+	prefetchCostSmoothing = 0.2
+
+	// In adaptive mode, prefetching is sized to be this times as fast as the block execution,
+	// so that the prefetched txs are ahead of the executed ones.
+	prefetchTargetSpeedup = 2.0
+)
+
+// prefetchScheduler decides how many workers are used to prefetch a block.
+//
+// In adaptive mode, it keeps moving averages of the time spent on executing a tx, and the worker time
+// spent on prefetching a tx, and sizes the workers so that prefetching is prefetchTargetSpeedup times as
+// fast as the block execution. Until both averages are known, the workers are sized from the number of txs.
+type prefetchScheduler struct {
+	config *config.PrefetchConfig
+
+	mtx               sync.Mutex
+	execCostPerTx     float64 // seconds spent on executing a tx
+	prefetchCostPerTx float64 // worker seconds spent on prefetching a tx
+}
+
+func newPrefetchScheduler(cfg *config.PrefetchConfig) *prefetchScheduler {
+	return &prefetchScheduler{config: cfg}
+}
+
+// workers returns the number of workers to prefetch a block with numTxs txs,
+// 0 means the block should not be prefetched.
+func (s *prefetchScheduler) workers(numTxs int) int {
+	if !s.config.Enabled || numTxs == 0 || numTxs < s.config.MinTxs {
+		return 0
+	}
+
+	workers := numTxs/s.config.TxsPerWorker + s.config.BaseWorkers
+	if s.config.Adaptive {
+		s.mtx.Lock()
+		if s.execCostPerTx > 0 && s.prefetchCostPerTx > 0 {
+			workers = int(math.Ceil(prefetchTargetSpeedup * s.prefetchCostPerTx / s.execCostPerTx))
+		}
+		s.mtx.Unlock()
+	}
+
+	if workers > numTxs {
+		workers = numTxs
+	}
+	if workers > s.config.MaxWorkers {
+		workers = s.config.MaxWorkers
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	return workers
+}
+
+// recordExecution records the time spent on executing a block with numTxs txs.
+func (s *prefetchScheduler) recordExecution(numTxs int, duration time.Duration) {
+	if !s.config.Adaptive || numTxs == 0 || numTxs < s.config.MinTxs {
+		return
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.execCostPerTx = movingAverage(s.execCostPerTx, duration.Seconds()/float64(numTxs))
+}
+
+// recordPrefetch records the time spent by the workers on prefetching numTxs txs.
+func (s *prefetchScheduler) recordPrefetch(numTxs, workers int, duration time.Duration) {
+	if !s.config.Adaptive || numTxs == 0 {
+		return
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.prefetchCostPerTx = movingAverage(s.prefetchCostPerTx, duration.Seconds()*float64(workers)/float64(numTxs))
+}
+
+func movingAverage(avg, sample float64) float64 {
+	if avg == 0 {
+		return sample
+	}
+	return avg*(1-prefetchCostSmoothing) + sample*prefetchCostSmoothing
+}
@@ -0,0 +1,60 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/config"
+)
+
+func TestPrefetchScheduler_Workers(t *testing.T) {
+	cfg := config.DefaultPrefetchConfig()
+	s := newPrefetchScheduler(cfg)
+
+	require.Equal(t, 0, s.workers(0))
+	require.Equal(t, 0, s.workers(99))
+	require.Equal(t, 4, s.workers(100))
+	require.Equal(t, 13, s.workers(1000))
+	require.Equal(t, 64, s.workers(100000))
+
+	cfg.MinTxs = 0
+	require.Equal(t, 0, s.workers(0))
+	require.Equal(t, 2, s.workers(2))
+
+	cfg.Enabled = false
+	require.Equal(t, 0, s.workers(1000))
+}
+
+func TestPrefetchScheduler_Adaptive(t *testing.T) {
+	cfg := config.DefaultPrefetchConfig()
+	cfg.Adaptive = true
+	s := newPrefetchScheduler(cfg)
+
+	// sized from the number of txs until execution and prefetch times are known
+	require.Equal(t, 13, s.workers(1000))
+	s.recordExecution(1000, time.Second)
+	require.Equal(t, 13, s.workers(1000))
+
+	// prefetching a tx costs 5 times as much as executing it
+	s.recordPrefetch(1000, 10, 500*time.Millisecond)
+	require.Equal(t, 10, s.workers(1000))
+
+	// blocks with too few txs are not recorded
+	s.recordExecution(10, time.Hour)
+	require.Equal(t, 10, s.workers(1000))
+
+	// prefetching gets slower, more workers are used
+	for i := 0; i < 50; i++ {
+		s.recordPrefetch(1000, 10, 2*time.Second)
+	}
+	require.Equal(t, 40, s.workers(1000))
+	require.Equal(t, 40, s.workers(100))
+
+	// the workers are capped
+	for i := 0; i < 50; i++ {
+		s.recordPrefetch(1000, 10, 10*time.Second)
+	}
+	require.Equal(t, cfg.MaxWorkers, s.workers(1000))
+}
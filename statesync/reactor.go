@@ -73,6 +73,7 @@ func (r *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			SendQueueCapacity:   10,
 			RecvMessageCapacity: chunkMsgSize,
 			MessageType:         &ssproto.Message{},
+			Compress:            true,
 		},
 	}
 }
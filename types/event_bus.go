@@ -227,6 +227,49 @@ func (b *EventBus) PublishEventValidatorSetUpdates(data EventDataValidatorSetUpd
 	return b.Publish(EventValidatorSetUpdates, data)
 }
 
+// PublishEventVotePoolUpdates publishes a vote added to the vote pool, which
+// can be filtered by its event type, event hash and signer (both hex encoded).
+func (b *EventBus) PublishEventVotePoolUpdates(data EventDataVotePoolUpdates) error {
+	// no explicit deadline for publishing events
+	ctx := context.Background()
+
+	events := map[string][]string{
+		EventTypeKey:     {EventVotePoolUpdates},
+		VoteEventTypeKey: {fmt.Sprintf("%d", data.EventType)},
+		VoteEventHashKey: {fmt.Sprintf("%X", data.EventHash)},
+		VotePubKeyKey:    {fmt.Sprintf("%X", data.PubKey)},
+	}
+	return b.pubsub.PublishWithEvents(ctx, data, events)
+}
+
+// PublishEventVoteQuorum publishes a vote quorum event, which can be filtered
+// by its event type and event hash (hex encoded).
+func (b *EventBus) PublishEventVoteQuorum(data EventDataVoteQuorum) error {
+	// no explicit deadline for publishing events
+	ctx := context.Background()
+
+	events := map[string][]string{
+		EventTypeKey:           {EventVoteQuorum},
+		VoteQuorumEventTypeKey: {fmt.Sprintf("%d", data.EventType)},
+		VoteQuorumEventHashKey: {fmt.Sprintf("%X", data.EventHash)},
+	}
+	return b.pubsub.PublishWithEvents(ctx, data, events)
+}
+
+// PublishEventMempoolTx publishes a mempool event of a tx, which can be
+// filtered by the tx hash (hex encoded) and its status.
+func (b *EventBus) PublishEventMempoolTx(data EventDataMempoolTx) error {
+	// no explicit deadline for publishing events
+	ctx := context.Background()
+
+	events := map[string][]string{
+		EventTypeKey:       {EventMempoolTx},
+		MempoolTxHashKey:   {data.Hash.String()},
+		MempoolTxStatusKey: {data.Status},
+	}
+	return b.pubsub.PublishWithEvents(ctx, data, events)
+}
+
 // -----------------------------------------------------------------------------
 type NopEventBus struct{}
 
@@ -306,3 +349,15 @@ func (NopEventBus) PublishEventLock(data EventDataRoundState) error {
 func (NopEventBus) PublishEventValidatorSetUpdates(data EventDataValidatorSetUpdates) error {
 	return nil
 }
+
+func (NopEventBus) PublishEventVotePoolUpdates(data EventDataVotePoolUpdates) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventVoteQuorum(data EventDataVoteQuorum) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventMempoolTx(data EventDataMempoolTx) error {
+	return nil
+}
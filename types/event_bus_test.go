@@ -313,6 +313,93 @@ func TestEventBusPublishEventNewEvidence(t *testing.T) {
 	}
 }
 
+func TestEventBusPublishEventVotePoolUpdates(t *testing.T) {
+	eventBus := NewEventBus()
+	err := eventBus.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	vote1 := EventDataVotePoolUpdates{
+		PubKey:    []byte{0x01, 0x02},
+		Signature: []byte{0x03},
+		EventType: 1,
+		EventHash: []byte{0xab, 0xcd},
+	}
+	vote2 := EventDataVotePoolUpdates{
+		PubKey:    []byte{0x01, 0x02},
+		Signature: []byte{0x04},
+		EventType: 2,
+		EventHash: []byte{0xab, 0xcd},
+	}
+
+	query := "tm.event='VotePoolUpdates' AND vote.event_type=2 AND vote.event_hash='ABCD' AND vote.pub_key='0102'"
+	voteSub, err := eventBus.Subscribe(context.Background(), "test", cmtquery.MustParse(query))
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		msg := <-voteSub.Out()
+		edt := msg.Data().(EventDataVotePoolUpdates)
+		assert.Equal(t, vote2, edt)
+		close(done)
+	}()
+
+	err = eventBus.PublishEventVotePoolUpdates(vote1)
+	assert.NoError(t, err)
+	err = eventBus.PublishEventVotePoolUpdates(vote2)
+	assert.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(1 * time.Second):
+		t.Fatal("did not receive a vote after 1 sec.")
+	}
+}
+
+func TestEventBusPublishEventMempoolTx(t *testing.T) {
+	eventBus := NewEventBus()
+	err := eventBus.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	tx := Tx("foo")
+	added := EventDataMempoolTx{Hash: tx.Hash(), Status: MempoolTxAdded, Height: 1}
+	evicted := EventDataMempoolTx{Hash: tx.Hash(), Status: MempoolTxEvicted, Height: 1, Reason: "mempool is full"}
+
+	query := fmt.Sprintf("%s AND mempool_tx.status='evicted'", EventQueryMempoolTxFor(tx))
+	txSub, err := eventBus.Subscribe(context.Background(), "test", cmtquery.MustParse(query))
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		msg := <-txSub.Out()
+		edt := msg.Data().(EventDataMempoolTx)
+		assert.Equal(t, evicted, edt)
+		close(done)
+	}()
+
+	err = eventBus.PublishEventMempoolTx(EventDataMempoolTx{Hash: Tx("bar").Hash(), Status: MempoolTxEvicted})
+	assert.NoError(t, err)
+	err = eventBus.PublishEventMempoolTx(added)
+	assert.NoError(t, err)
+	err = eventBus.PublishEventMempoolTx(evicted)
+	assert.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(1 * time.Second):
+		t.Fatal("did not receive a mempool tx event after 1 sec.")
+	}
+}
+
 func TestEventBusPublish(t *testing.T) {
 	eventBus := NewEventBus()
 	err := eventBus.Start()
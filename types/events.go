@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/bits"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
@@ -36,6 +38,36 @@ const (
 	EventUnlock           = "Unlock"
 	EventValidBlock       = "ValidBlock"
 	EventVote             = "Vote"
+
+	// Vote pool events.
+	// These are triggered from the votepool package, when a vote is added to
+	// the vote pool, and when more than 2/3 of the voting power has voted for
+	// the same event.
+	EventVotePoolUpdates = "VotePoolUpdates"
+	EventVoteQuorum      = "VoteQuorum"
+
+	// Mempool events.
+	// These are triggered from the mempool package, along the lifecycle of a
+	// tx in the mempool.
+	EventMempoolTx = "MempoolTx"
+)
+
+// Statuses of the txs in the mempool events.
+const (
+	// The tx passed CheckTx and was added to the mempool.
+	MempoolTxAdded = "added"
+	// The tx became invalid when it was rechecked after a block was committed,
+	// and was removed from the mempool.
+	MempoolTxRecheckInvalid = "recheck_invalid"
+	// The tx passed CheckTx, but was removed from the mempool or not added to
+	// it because the mempool is full.
+	MempoolTxEvicted = "evicted"
+	// The tx stayed in the mempool longer than its TTL, and was removed.
+	MempoolTxExpired = "expired"
+	// The tx was reaped from the mempool to be proposed in a block.
+	MempoolTxReaped = "reaped"
+	// The tx was committed in a block, and removed from the mempool.
+	MempoolTxCommitted = "committed"
 )
 
 // ENCODING / DECODING
@@ -56,6 +88,9 @@ func init() {
 	cmtjson.RegisterType(EventDataVote{}, "tendermint/event/Vote")
 	cmtjson.RegisterType(EventDataValidatorSetUpdates{}, "tendermint/event/ValidatorSetUpdates")
 	cmtjson.RegisterType(EventDataString(""), "tendermint/event/ProposalString")
+	cmtjson.RegisterType(EventDataVotePoolUpdates{}, "tendermint/event/VotePoolUpdates")
+	cmtjson.RegisterType(EventDataVoteQuorum{}, "tendermint/event/VoteQuorum")
+	cmtjson.RegisterType(EventDataMempoolTx{}, "tendermint/event/MempoolTx")
 }
 
 // Most event messages are basic types (a block, a transaction)
@@ -125,6 +160,40 @@ type EventDataValidatorSetUpdates struct {
 	ValidatorUpdates []*Validator `json:"validator_updates"`
 }
 
+// EventDataVotePoolUpdates is fired when a vote is added to the vote pool.
+type EventDataVotePoolUpdates struct {
+	PubKey    []byte `json:"pub_key"`
+	Signature []byte `json:"signature"`
+	EventType uint8  `json:"event_type"`
+	EventHash []byte `json:"event_hash"`
+}
+
+// EventDataVoteQuorum is fired when more than 2/3 of the voting power has
+// voted for the same event in the vote pool.
+type EventDataVoteQuorum struct {
+	EventType uint8  `json:"event_type"`
+	EventHash []byte `json:"event_hash"`
+
+	// AggSignature is the aggregated bls signature of the signers.
+	AggSignature []byte `json:"agg_signature"`
+	// SignerBitmap marks the signers by their indexes in the validator set.
+	SignerBitmap *bits.BitArray `json:"signer_bitmap"`
+
+	VotingPower      int64 `json:"voting_power"`
+	TotalVotingPower int64 `json:"total_voting_power"`
+}
+
+// EventDataMempoolTx is fired when a tx enters or leaves the mempool, and when
+// it is reaped to be proposed in a block.
+type EventDataMempoolTx struct {
+	Hash   cmtbytes.HexBytes `json:"hash"`
+	Status string            `json:"status"`
+	// Height of the last block the mempool was updated with.
+	Height int64 `json:"height"`
+	// Reason why the tx was evicted or became invalid, if any.
+	Reason string `json:"reason,omitempty"`
+}
+
 // PUBSUB
 
 const (
@@ -140,11 +209,37 @@ const (
 	// BlockHeightKey is a reserved key used for indexing BeginBlock and Endblock
 	// events.
 	BlockHeightKey = "block.height"
+
+	// VoteEventTypeKey is a reserved key, used to specify the event type of a
+	// vote added to the vote pool. see EventBus#PublishEventVotePoolUpdates
+	VoteEventTypeKey = "vote.event_type"
+	// VoteEventHashKey is a reserved key, used to specify the event hash of a
+	// vote added to the vote pool. see EventBus#PublishEventVotePoolUpdates
+	VoteEventHashKey = "vote.event_hash"
+	// VotePubKeyKey is a reserved key, used to specify the bls public key of
+	// the signer of a vote added to the vote pool.
+	// see EventBus#PublishEventVotePoolUpdates
+	VotePubKeyKey = "vote.pub_key"
+
+	// VoteQuorumEventTypeKey is a reserved key, used to specify the event type
+	// of a vote quorum. see EventBus#PublishEventVoteQuorum
+	VoteQuorumEventTypeKey = "vote_quorum.event_type"
+	// VoteQuorumEventHashKey is a reserved key, used to specify the event hash
+	// of a vote quorum. see EventBus#PublishEventVoteQuorum
+	VoteQuorumEventHashKey = "vote_quorum.event_hash"
+
+	// MempoolTxHashKey is a reserved key, used to specify the hash of a tx in
+	// a mempool event. see EventBus#PublishEventMempoolTx
+	MempoolTxHashKey = "mempool_tx.hash"
+	// MempoolTxStatusKey is a reserved key, used to specify the status of a tx
+	// in a mempool event. see EventBus#PublishEventMempoolTx
+	MempoolTxStatusKey = "mempool_tx.status"
 )
 
 var (
 	EventQueryCompleteProposal    = QueryForEvent(EventCompleteProposal)
 	EventQueryLock                = QueryForEvent(EventLock)
+	EventQueryMempoolTx           = QueryForEvent(EventMempoolTx)
 	EventQueryNewBlock            = QueryForEvent(EventNewBlock)
 	EventQueryNewBlockHeader      = QueryForEvent(EventNewBlockHeader)
 	EventQueryNewEvidence         = QueryForEvent(EventNewEvidence)
@@ -159,12 +254,19 @@ var (
 	EventQueryValidatorSetUpdates = QueryForEvent(EventValidatorSetUpdates)
 	EventQueryValidBlock          = QueryForEvent(EventValidBlock)
 	EventQueryVote                = QueryForEvent(EventVote)
+	EventQueryVotePoolUpdates     = QueryForEvent(EventVotePoolUpdates)
+	EventQueryVoteQuorum          = QueryForEvent(EventVoteQuorum)
 )
 
 func EventQueryTxFor(tx Tx) cmtpubsub.Query {
 	return cmtquery.MustParse(fmt.Sprintf("%s='%s' AND %s='%X'", EventTypeKey, EventTx, TxHashKey, tx.Hash()))
 }
 
+// EventQueryMempoolTxFor returns a query for the mempool events of a tx.
+func EventQueryMempoolTxFor(tx Tx) cmtpubsub.Query {
+	return cmtquery.MustParse(fmt.Sprintf("%s='%s' AND %s='%X'", EventTypeKey, EventMempoolTx, MempoolTxHashKey, tx.Hash()))
+}
+
 func QueryForEvent(eventType string) cmtpubsub.Query {
 	return cmtquery.MustParse(fmt.Sprintf("%s='%s'", EventTypeKey, eventType))
 }
@@ -181,3 +283,9 @@ type BlockEventPublisher interface {
 type TxEventPublisher interface {
 	PublishEventTx(EventDataTx) error
 }
+
+// MempoolEventPublisher publishes the lifecycle events of the txs in the
+// mempool.
+type MempoolEventPublisher interface {
+	PublishEventMempoolTx(EventDataMempoolTx) error
+}
@@ -0,0 +1,142 @@
+package votepool
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/proto/tendermint/votepool"
+)
+
+const (
+	// Prefix of the keys of persisted votes.
+	baseKeyVote = byte(0x00)
+)
+
+// voteDB persists votes in a database, so that unexpired votes can be reloaded after restarts.
+// The keys are ordered by event type and then by expired at time, which makes it possible to
+// prune expired votes on disk in the same order as the VoteQueue does in memory.
+type voteDB struct {
+	db dbm.DB
+}
+
+// newVoteDB creates a voteDB backed by the given database.
+func newVoteDB(db dbm.DB) *voteDB {
+	return &voteDB{db: db}
+}
+
+// saveVote will persist a vote. The vote's expired at time should be set before saving.
+func (d *voteDB) saveVote(vote *Vote) error {
+	bz, err := vote.toProto().Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal vote: %w", err)
+	}
+	return d.db.SetSync(keyVote(vote), bz)
+}
+
+// loadVotes will return all persisted votes of the event type which expire after the given time.
+// The returned votes are ordered by their expired at time.
+func (d *voteDB) loadVotes(eventType EventType, after time.Time) ([]*Vote, error) {
+	iter, err := d.db.Iterator(keyExpireAt(eventType, after.Add(time.Nanosecond)), keyEventTypeEnd(eventType))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	votes := make([]*Vote, 0)
+	for ; iter.Valid(); iter.Next() {
+		var pbVote votepool.Vote
+		if err := pbVote.Unmarshal(iter.Value()); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal vote: %w", err)
+		}
+		vote := NewVote(pbVote.PubKey, pbVote.Signature, uint8(pbVote.EventType), pbVote.EventHash)
+		vote.expireAt = expireAtFromKey(iter.Key())
+		votes = append(votes, vote)
+	}
+	return votes, iter.Error()
+}
+
+// pruneVotes will delete persisted votes of the event type which expire no later than the given time.
+func (d *voteDB) pruneVotes(eventType EventType, until time.Time) error {
+	return d.deleteRange(keyEventTypeStart(eventType), keyExpireAt(eventType, until.Add(time.Nanosecond)))
+}
+
+// flushVotes will delete all persisted votes.
+func (d *voteDB) flushVotes() error {
+	return d.deleteRange([]byte{baseKeyVote}, []byte{baseKeyVote + 1})
+}
+
+// close will close the underlying database.
+func (d *voteDB) close() error {
+	return d.db.Close()
+}
+
+func (d *voteDB) deleteRange(start, end []byte) error {
+	iter, err := d.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	keys := make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	if err = iter.Error(); err != nil {
+		iter.Close()
+		return err
+	}
+	iter.Close()
+
+	if len(keys) == 0 {
+		return nil
+	}
+	batch := d.db.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		if err = batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
+// toProto converts a Vote to its protobuf representation.
+func (v *Vote) toProto() *votepool.Vote {
+	return &votepool.Vote{
+		PubKey:    v.PubKey,
+		Signature: v.Signature,
+		EventType: uint32(v.EventType),
+		EventHash: v.EventHash,
+	}
+}
+
+// keyVote returns the key of a vote: prefix | event type | expired at | event hash | public key.
+func keyVote(vote *Vote) []byte {
+	key := keyExpireAt(vote.EventType, vote.expireAt)
+	key = append(key, vote.EventHash...)
+	return append(key, vote.PubKey...)
+}
+
+func keyExpireAt(eventType EventType, expireAt time.Time) []byte {
+	key := make([]byte, 10, 10+eventHashLen+pubKeyLen)
+	key[0] = baseKeyVote
+	key[1] = byte(eventType)
+	binary.BigEndian.PutUint64(key[2:], uint64(expireAt.UnixNano()))
+	return key
+}
+
+func keyEventTypeStart(eventType EventType) []byte {
+	return []byte{baseKeyVote, byte(eventType)}
+}
+
+func keyEventTypeEnd(eventType EventType) []byte {
+	if eventType == EventType(0xff) {
+		return []byte{baseKeyVote + 1}
+	}
+	return []byte{baseKeyVote, byte(eventType) + 1}
+}
+
+func expireAtFromKey(key []byte) time.Time {
+	return time.Unix(0, int64(binary.BigEndian.Uint64(key[2:10])))
+}
@@ -0,0 +1,107 @@
+package votepool
+
+import (
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls/blst"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestVoteDB_SaveLoadPrune(t *testing.T) {
+	pk1, val1, _, _, _, _ := makeVotePool()
+	secKey, _ := blst.SecretKeyFromBytes(pk1.Marshal())
+	vote1, vote2, vote3 := makeValidVotes(secKey, val1)
+
+	now := time.Now()
+	vote1.expireAt = now.Add(-time.Second)
+	vote2.expireAt = now.Add(time.Second)
+	vote3.expireAt = now.Add(2 * time.Second)
+
+	vdb := newVoteDB(dbm.NewMemDB())
+	require.NoError(t, vdb.saveVote(&vote3))
+	require.NoError(t, vdb.saveVote(&vote1))
+	require.NoError(t, vdb.saveVote(&vote2))
+
+	// only unexpired votes of the event type are loaded, ordered by expired at time
+	votes, err := vdb.loadVotes(FromBscCrossChainEvent, now)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(votes))
+	require.Equal(t, vote3.Key(), votes[0].Key())
+	require.Equal(t, vote3.Signature, votes[0].Signature)
+	require.True(t, vote3.expireAt.Equal(votes[0].expireAt))
+
+	votes, err = vdb.loadVotes(FromBscCrossChainEvent, now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(votes))
+	require.Equal(t, vote1.Key(), votes[0].Key())
+	require.Equal(t, vote3.Key(), votes[1].Key())
+
+	// prune
+	require.NoError(t, vdb.pruneVotes(FromBscCrossChainEvent, now))
+	votes, err = vdb.loadVotes(FromBscCrossChainEvent, now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(votes))
+	require.Equal(t, vote3.Key(), votes[0].Key())
+
+	votes, err = vdb.loadVotes(ToBscCrossChainEvent, now)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(votes))
+	require.Equal(t, vote2.Key(), votes[0].Key())
+
+	// flush
+	require.NoError(t, vdb.flushVotes())
+	votes, err = vdb.loadVotes(FromBscCrossChainEvent, now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 0, len(votes))
+	votes, err = vdb.loadVotes(ToBscCrossChainEvent, now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 0, len(votes))
+}
+
+func TestPool_ReloadVotes(t *testing.T) {
+	pk1, val1, _, val2, _, _ := makeVotePool()
+	secKey, _ := blst.SecretKeyFromBytes(pk1.Marshal())
+	vote1, vote2, vote3 := makeValidVotes(secKey, val1)
+
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() { _ = eventBus.Stop() })
+
+	db := dbm.NewMemDB()
+	vals := []*types.Validator{val1, val2}
+	votePool := NewVotePool(log.TestingLogger(), vals, eventBus, WithDB(db))
+	require.NoError(t, votePool.Start())
+
+	require.NoError(t, votePool.AddVote(&vote1))
+	require.NoError(t, votePool.AddVote(&vote2))
+	require.NoError(t, votePool.AddVote(&vote3))
+	require.NoError(t, votePool.Stop())
+
+	// votes are reloaded after restarting
+	votePool = NewVotePool(log.TestingLogger(), vals, eventBus, WithDB(db))
+	require.NoError(t, votePool.Start())
+
+	result, err := votePool.GetVotesByEventType(FromBscCrossChainEvent)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(result))
+	result, err = votePool.GetVotesByEventTypeAndHash(ToBscCrossChainEvent, vote2.EventHash)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result))
+	require.Equal(t, 3, votePool.stores[FromBscCrossChainEvent].queue.Len()+votePool.stores[ToBscCrossChainEvent].queue.Len())
+
+	// flushed votes are not reloaded
+	votePool.FlushVotes()
+	require.NoError(t, votePool.Stop())
+
+	votePool = NewVotePool(log.TestingLogger(), vals, eventBus, WithDB(db))
+	require.NoError(t, votePool.Start())
+	result, err = votePool.GetVotesByEventType(FromBscCrossChainEvent)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(result))
+	require.NoError(t, votePool.Stop())
+}
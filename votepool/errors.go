@@ -0,0 +1,17 @@
+package votepool
+
+import "errors"
+
+var (
+	// ErrUnsupportedEventType is returned when the event type of a vote is not registered in the Pool.
+	ErrUnsupportedEventType = errors.New("unsupported event type")
+
+	// ErrTooManyVotes is returned when the Pool has reached the max number of votes of an event type.
+	ErrTooManyVotes = errors.New("too many votes")
+
+	// ErrNotFromValidator is returned when a vote is not signed by a validator.
+	ErrNotFromValidator = errors.New("vote is not from validators")
+
+	// ErrInvalidSignature is returned when the bls signature of a vote is invalid.
+	ErrInvalidSignature = errors.New("invalid signature")
+)
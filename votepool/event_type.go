@@ -1,5 +1,12 @@
 package votepool
 
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
 // EventType defines the types for voting.
 type EventType uint8
 
@@ -19,3 +26,86 @@ const (
 	// FromOpCrossChainEvent defines the type of cross chain events from op chain to the current chain.
 	FromOpCrossChainEvent EventType = 5
 )
+
+const (
+	// DefaultVoteKeepAlive is how long a vote of the built-in event types is kept in the Pool.
+	DefaultVoteKeepAlive = time.Second * 30
+)
+
+// EventTypeParams defines how the votes of an event type are kept in the Pool.
+type EventTypeParams struct {
+	EventType EventType     // the event type
+	KeepAlive time.Duration // the vote will be assigned the expired at time `now + KeepAlive` when adding to the Pool
+	MaxVotes  int           // the max number of votes of the event type in the Pool, 0 means no limit
+}
+
+// ValidateBasic does basic validation of the params.
+func (p EventTypeParams) ValidateBasic() error {
+	if p.EventType == 0 {
+		return errors.New("event type 0 is reserved")
+	}
+	if p.KeepAlive <= 0 {
+		return fmt.Errorf("keep alive of event type %d must be positive", p.EventType)
+	}
+	if p.MaxVotes < 0 {
+		return fmt.Errorf("max votes of event type %d can't be negative", p.EventType)
+	}
+	return nil
+}
+
+// EventTypeRegistry declares which event types are supported by the Pool.
+// It is immutable once created, so it can be shared without locking.
+type EventTypeRegistry struct {
+	params map[EventType]EventTypeParams
+}
+
+// NewEventTypeRegistry creates a registry with the given event types.
+func NewEventTypeRegistry(params ...EventTypeParams) (*EventTypeRegistry, error) {
+	r := &EventTypeRegistry{
+		params: make(map[EventType]EventTypeParams, len(params)),
+	}
+	for _, p := range params {
+		if err := p.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		if _, ok := r.params[p.EventType]; ok {
+			return nil, fmt.Errorf("duplicated event type %d", p.EventType)
+		}
+		r.params[p.EventType] = p
+	}
+	return r, nil
+}
+
+// DefaultEventTypeRegistry returns a registry with the built-in cross chain and challenge event types.
+func DefaultEventTypeRegistry() *EventTypeRegistry {
+	eventTypes := []EventType{ToBscCrossChainEvent, FromBscCrossChainEvent, DataAvailabilityChallengeEvent, FromOpCrossChainEvent, ToOpCrossChainEvent}
+
+	params := make([]EventTypeParams, 0, len(eventTypes))
+	for _, et := range eventTypes {
+		params = append(params, EventTypeParams{EventType: et, KeepAlive: DefaultVoteKeepAlive})
+	}
+	r, _ := NewEventTypeRegistry(params...) // built-in event types are always valid
+	return r
+}
+
+// Get returns the params of the event type, and whether it is registered.
+func (r *EventTypeRegistry) Get(eventType EventType) (EventTypeParams, bool) {
+	p, ok := r.params[eventType]
+	return p, ok
+}
+
+// Has returns whether the event type is registered.
+func (r *EventTypeRegistry) Has(eventType EventType) bool {
+	_, ok := r.params[eventType]
+	return ok
+}
+
+// EventTypes returns all registered event types in ascending order.
+func (r *EventTypeRegistry) EventTypes() []EventType {
+	eventTypes := make([]EventType, 0, len(r.params))
+	for et := range r.params {
+		eventTypes = append(eventTypes, et)
+	}
+	sort.Slice(eventTypes, func(i, j int) bool { return eventTypes[i] < eventTypes[j] })
+	return eventTypes
+}
@@ -0,0 +1,42 @@
+package votepool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventTypeRegistry(t *testing.T) {
+	r := DefaultEventTypeRegistry()
+	require.Equal(t, []EventType{ToBscCrossChainEvent, FromBscCrossChainEvent, DataAvailabilityChallengeEvent,
+		ToOpCrossChainEvent, FromOpCrossChainEvent}, r.EventTypes())
+	params, ok := r.Get(FromBscCrossChainEvent)
+	require.True(t, ok)
+	require.Equal(t, DefaultVoteKeepAlive, params.KeepAlive)
+	require.Equal(t, 0, params.MaxVotes)
+	require.False(t, r.Has(6))
+
+	r, err := NewEventTypeRegistry(
+		EventTypeParams{EventType: 8, KeepAlive: time.Minute, MaxVotes: 10},
+		EventTypeParams{EventType: 6, KeepAlive: time.Second},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []EventType{6, 8}, r.EventTypes())
+	require.True(t, r.Has(8))
+	require.False(t, r.Has(FromBscCrossChainEvent))
+
+	testCases := []struct {
+		params []EventTypeParams
+		msg    string
+	}{
+		{[]EventTypeParams{{EventType: 0, KeepAlive: time.Second}}, "event type 0 is reserved"},
+		{[]EventTypeParams{{EventType: 6}}, "keep alive of event type 6 must be positive"},
+		{[]EventTypeParams{{EventType: 6, KeepAlive: time.Second, MaxVotes: -1}}, "max votes of event type 6 can't be negative"},
+		{[]EventTypeParams{{EventType: 6, KeepAlive: time.Second}, {EventType: 6, KeepAlive: time.Minute}}, "duplicated event type 6"},
+	}
+	for _, tc := range testCases {
+		_, err := NewEventTypeRegistry(tc.params...)
+		require.EqualError(t, err, tc.msg)
+	}
+}
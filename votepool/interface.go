@@ -2,6 +2,7 @@ package votepool
 
 import (
 	"github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/types"
 )
 
 // VotePool is used for pooling cross chain, challenge votes from different validators/relayers.
@@ -12,12 +13,23 @@ type VotePool interface {
 	// AddVote will add a vote to the Pool. Different types of validations can be conducted before adding.
 	AddVote(vote *Vote) error
 
+	// AddVotes will add a batch of votes to the Pool, and return whether each vote was added, or the error it was
+	// rejected with. A vote already in the Pool or earlier in the batch is neither added nor rejected.
+	// The signatures of the votes can be verified in a batch.
+	AddVotes(votes []*Vote) (added []bool, errs []error)
+
 	// GetVotesByEventTypeAndHash will query votes by event hash and event type.
 	GetVotesByEventTypeAndHash(eventType EventType, eventHash []byte) ([]*Vote, error)
 
 	// GetVotesByEventType will query votes by event type.
 	GetVotesByEventType(eventType EventType) ([]*Vote, error)
 
+	// GetVoteQuorum will aggregate the votes of the event, if more than 2/3 of the voting power has voted for it.
+	GetVoteQuorum(eventType EventType, eventHash []byte) (*types.EventDataVoteQuorum, error)
+
+	// EventTypes returns the event types supported by the Pool.
+	EventTypes() *EventTypeRegistry
+
 	// FlushVotes will clear all votes in the Pool, no matter what types of events.
 	FlushVotes()
 }
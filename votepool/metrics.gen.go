@@ -0,0 +1,90 @@
+// Code generated by metricsgen. DO NOT EDIT.
+
+package votepool
+
+import (
+	"github.com/go-kit/kit/metrics/discard"
+	prometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		Size: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "size",
+			Help:      "Number of votes in the vote pool.",
+		}, append(labels, "event_type")).With(labelsAndValues...),
+		AddedVotes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "added_votes",
+			Help:      "Number of votes added to the vote pool.",
+		}, append(labels, "event_type")).With(labelsAndValues...),
+		RejectedVotes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "rejected_votes",
+			Help:      "Number of votes rejected by the vote pool.",
+		}, append(labels, "event_type", "reason")).With(labelsAndValues...),
+		PrunedVotes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pruned_votes",
+			Help:      "Number of expired votes pruned from the vote pool.",
+		}, append(labels, "event_type")).With(labelsAndValues...),
+		SyncedVotes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "synced_votes",
+			Help:      "Number of votes sent to newly connected peers which were missing them.",
+		}, labels).With(labelsAndValues...),
+		MisbehavingPeers: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "misbehaving_peers",
+			Help:      "Number of peers disconnected for sending invalid votes.",
+		}, labels).With(labelsAndValues...),
+		VerifyBatchSize: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "verify_batch_size",
+			Help:      "Histogram of the number of votes verified in a signature batch.",
+
+			Buckets: stdprometheus.ExponentialBuckets(1, 2, 8),
+		}, labels).With(labelsAndValues...),
+		VerifyBatchDurationSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "verify_batch_duration_seconds",
+			Help:      "Histogram of the time spent on verifying a signature batch, in seconds.",
+
+			Buckets: stdprometheus.ExponentialBucketsRange(0.0001, 1, 10),
+		}, labels).With(labelsAndValues...),
+		VerifyBatchFallbacks: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "verify_batch_fallbacks",
+			Help:      "Number of signature batches which failed the batch verification and were verified vote by vote.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+func NopMetrics() *Metrics {
+	return &Metrics{
+		Size:                       discard.NewGauge(),
+		AddedVotes:                 discard.NewCounter(),
+		RejectedVotes:              discard.NewCounter(),
+		PrunedVotes:                discard.NewCounter(),
+		SyncedVotes:                discard.NewCounter(),
+		MisbehavingPeers:           discard.NewCounter(),
+		VerifyBatchSize:            discard.NewHistogram(),
+		VerifyBatchDurationSeconds: discard.NewHistogram(),
+		VerifyBatchFallbacks:       discard.NewCounter(),
+	}
+}
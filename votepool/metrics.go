@@ -0,0 +1,45 @@
+package votepool
+
+import (
+	"github.com/go-kit/kit/metrics"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "votepool"
+)
+
+//go:generate go run ../scripts/metricsgen -struct=Metrics
+
+// Metrics contains metrics exposed by this package.
+// see MetricsProvider for descriptions.
+type Metrics struct {
+	// Number of votes in the vote pool.
+	Size metrics.Gauge `metrics_labels:"event_type"`
+
+	// Number of votes added to the vote pool.
+	AddedVotes metrics.Counter `metrics_labels:"event_type"`
+
+	// Number of votes rejected by the vote pool.
+	RejectedVotes metrics.Counter `metrics_labels:"event_type, reason"`
+
+	// Number of expired votes pruned from the vote pool.
+	PrunedVotes metrics.Counter `metrics_labels:"event_type"`
+
+	// Number of votes sent to newly connected peers which were missing them.
+	SyncedVotes metrics.Counter
+
+	// Number of peers disconnected for sending invalid votes.
+	MisbehavingPeers metrics.Counter
+
+	// Histogram of the number of votes verified in a signature batch.
+	VerifyBatchSize metrics.Histogram `metrics_buckettype:"exp" metrics_bucketsizes:"1,2,8"`
+
+	// Histogram of the time spent on verifying a signature batch, in seconds.
+	VerifyBatchDurationSeconds metrics.Histogram `metrics_buckettype:"exprange" metrics_bucketsizes:"0.0001,1,10"`
+
+	// Number of signature batches which failed the batch verification and
+	// were verified vote by vote.
+	VerifyBatchFallbacks metrics.Counter
+}
@@ -0,0 +1,47 @@
+package votepool
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// Key for the misbehaviour score of a peer.
+	peerScoreKey = "VotePoolReactor.score"
+
+	// A peer will be disconnected once its misbehaviour score reaches the threshold.
+	maxPeerScore = 100.0
+
+	// The misbehaviour score decays over time, so that occasional bad votes are tolerated.
+	peerScoreDecayPerSecond = 1.0
+
+	// Score of sending a malformed vote or an invalid signature, which an honest peer never does.
+	invalidVoteScore = 10.0
+
+	// Score of sending a vote from a non-validator or of an unsupported event type.
+	// An honest peer could do this if its validator set or event types are different from ours for a while.
+	unexpectedVoteScore = 1.0
+)
+
+// peerScore tracks the misbehaviour score of a peer.
+type peerScore struct {
+	mtx     sync.Mutex
+	score   float64
+	updated time.Time
+}
+
+// add will add points to the score after decaying it, and return the new score.
+func (s *peerScore) add(points float64, now time.Time) float64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if !s.updated.IsZero() {
+		s.score -= now.Sub(s.updated).Seconds() * peerScoreDecayPerSecond
+		if s.score < 0 {
+			s.score = 0
+		}
+	}
+	s.score += points
+	s.updated = now
+	return s.score
+}
@@ -0,0 +1,24 @@
+package votepool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerScore(t *testing.T) {
+	score := &peerScore{}
+	now := time.Now()
+
+	require.Equal(t, invalidVoteScore, score.add(invalidVoteScore, now))
+	require.Equal(t, 2*invalidVoteScore, score.add(invalidVoteScore, now))
+
+	// the score decays over time
+	now = now.Add(5 * time.Second)
+	require.Equal(t, 2*invalidVoteScore-5*peerScoreDecayPerSecond+unexpectedVoteScore, score.add(unexpectedVoteScore, now))
+
+	// the score will not be negative
+	now = now.Add(time.Hour)
+	require.Equal(t, unexpectedVoteScore, score.add(unexpectedVoteScore, now))
+}
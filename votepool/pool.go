@@ -3,8 +3,11 @@ package votepool
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
 	"time"
 
+	dbm "github.com/cometbft/cometbft-db"
 	lru "github.com/hashicorp/golang-lru"
 
 	"github.com/cometbft/cometbft/libs/service"
@@ -20,37 +23,66 @@ const (
 	// The number of cached votes (i.e., keys) to quickly filter out when adding votes.
 	cacheVoteSize = 1024
 
-	// Vote will be assigned the expired at time when adding to the Pool.
-	voteKeepAliveAfter = time.Second * 30
-
 	// Votes in the Pool will be pruned periodically to remove useless ones.
 	pruneVoteInterval = 3 * time.Second
 
 	// Defines the channel size for event bus subscription.
 	eventBusSubscribeCap = 1024
+)
 
-	// The event type of adding new votes to the Pool successfully.
-	eventBusVotePoolUpdates = "votePoolUpdates"
+// Reasons of rejecting votes, used as metrics labels.
+const (
+	rejectReasonMalformed            = "malformed"
+	rejectReasonUnsupportedEventType = "unsupported_event_type"
+	rejectReasonTooManyVotes         = "too_many_votes"
+	rejectReasonNotFromValidator     = "not_from_validator"
+	rejectReasonInvalidSignature     = "invalid_signature"
+	rejectReasonPersistFailure       = "persist_failure"
 )
 
 // voteStore stores one type of votes.
 type voteStore struct {
+	params EventTypeParams // params of the event type
+
 	mtx     *sync.RWMutex               // mutex for concurrency access of voteMap and others
 	voteMap map[string]map[string]*Vote // map: eventHash -> pubKey -> Vote
+	size    int                         // number of votes in voteMap
+	quorums map[string]struct{}         // event hashes which have reached quorum
 
 	queue *VoteQueue // priority queue for prune votes
 }
 
 // newVoteStore creates a store to store votes.
-func newVoteStore() *voteStore {
+func newVoteStore(params EventTypeParams) *voteStore {
 	s := &voteStore{
+		params:  params,
 		mtx:     &sync.RWMutex{},
 		voteMap: make(map[string]map[string]*Vote),
+		quorums: make(map[string]struct{}),
 		queue:   NewVoteQueue(),
 	}
 	return s
 }
 
+// len returns the number of votes in the store.
+func (s *voteStore) len() int {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return s.size
+}
+
+// isFull returns whether the store has reached the max number of votes.
+func (s *voteStore) isFull() bool {
+	if s.params.MaxVotes == 0 {
+		return false
+	}
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return s.size >= s.params.MaxVotes
+}
+
 // addVote will add a vote to the store.
 // Be noted: no validation is conducted in this layer.
 func (s *voteStore) addVote(vote *Vote) {
@@ -64,6 +96,9 @@ func (s *voteStore) addVote(vote *Vote) {
 		subM = make(map[string]*Vote)
 		s.voteMap[eventHashStr] = subM
 	}
+	if _, ok = subM[pubKeyStr]; !ok {
+		s.size++
+	}
 	subM[pubKeyStr] = vote
 	s.queue.Insert(vote)
 }
@@ -82,6 +117,32 @@ func (s *voteStore) getVotesByEventHash(eventHash []byte) []*Vote {
 	return votes
 }
 
+// reachedQuorum returns whether the event hash has been marked as having reached quorum.
+func (s *voteStore) reachedQuorum(eventHash []byte) bool {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	_, ok := s.quorums[string(eventHash[:])]
+	return ok
+}
+
+// markQuorum will mark the event hash as having reached quorum.
+// It returns false if the event hash has been marked before.
+func (s *voteStore) markQuorum(eventHash []byte) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	eventHashStr := string(eventHash[:])
+	if _, ok := s.quorums[eventHashStr]; ok {
+		return false
+	}
+	if _, ok := s.voteMap[eventHashStr]; !ok { // the votes have been pruned
+		return false
+	}
+	s.quorums[eventHashStr] = struct{}{}
+	return true
+}
+
 // getAllVotes will return all votes in the store.
 func (s *voteStore) getAllVotes() []*Vote {
 	s.mtx.RLock()
@@ -102,6 +163,8 @@ func (s *voteStore) flushVotes() {
 	defer s.mtx.Unlock()
 
 	s.voteMap = make(map[string]map[string]*Vote)
+	s.size = 0
+	s.quorums = make(map[string]struct{})
 	s.queue = NewVoteQueue()
 }
 
@@ -115,7 +178,18 @@ func (s *voteStore) pruneVotes() []string {
 	if expires, err := s.queue.PopUntil(current); err == nil {
 		for _, expire := range expires {
 			keys = append(keys, expire.Key())
-			delete(s.voteMap[string(expire.EventHash[:])], string(expire.PubKey[:]))
+			eventHashStr := string(expire.EventHash[:])
+			pubKeyStr := string(expire.PubKey[:])
+			subM := s.voteMap[eventHashStr]
+			// the vote could have been re-added with a later expired at time
+			if v, ok := subM[pubKeyStr]; ok && v == expire {
+				delete(subM, pubKeyStr)
+				s.size--
+			}
+			if len(subM) == 0 {
+				delete(s.voteMap, eventHashStr)
+				delete(s.quorums, eventHashStr)
+			}
 		}
 	}
 	return keys
@@ -126,8 +200,9 @@ func (s *voteStore) pruneVotes() []string {
 type Pool struct {
 	service.BaseService
 
-	stores map[EventType]*voteStore // each event type will have a store
-	ticker *time.Ticker             // prune ticker
+	eventTypes *EventTypeRegistry       // supported event types
+	stores     map[EventType]*voteStore // each event type will have a store
+	ticker     *time.Ticker             // prune ticker
 
 	blsVerifier       *BlsSignatureVerifier  // verify a vote's signature
 	validatorVerifier *FromValidatorVerifier // verify a vote is from a validator
@@ -135,32 +210,58 @@ type Pool struct {
 	cache *lru.Cache // to cache recent added votes' keys
 
 	eventBus *types.EventBus // to subscribe validator update events and publish new added vote events
+
+	db *voteDB // to persist votes, nil if votes are only kept in memory
+
+	metrics *Metrics
 }
 
-// NewVotePool creates a Pool. The initial validators should be supplied.
-func NewVotePool(logger log.Logger, validators []*types.Validator, eventBus *types.EventBus) *Pool {
-	eventTypes := []EventType{ToBscCrossChainEvent, FromBscCrossChainEvent, DataAvailabilityChallengeEvent, FromOpCrossChainEvent, ToOpCrossChainEvent}
+// PoolOption sets an optional parameter on the Pool.
+type PoolOption func(*Pool)
 
-	ticker := time.NewTicker(pruneVoteInterval)
-	stores := make(map[EventType]*voteStore, len(eventTypes))
-	for _, et := range eventTypes {
-		store := newVoteStore()
-		stores[et] = store
-	}
+// WithDB makes the Pool persist votes in the given database. Unexpired votes
+// will be reloaded from the database when the Pool starts.
+func WithDB(db dbm.DB) PoolOption {
+	return func(p *Pool) { p.db = newVoteDB(db) }
+}
+
+// WithMetrics sets the metrics of the Pool.
+func WithMetrics(metrics *Metrics) PoolOption {
+	return func(p *Pool) { p.metrics = metrics }
+}
+
+// WithEventTypes sets the event types supported by the Pool. The built-in
+// event types of DefaultEventTypeRegistry are used if the option is not set.
+func WithEventTypes(eventTypes *EventTypeRegistry) PoolOption {
+	return func(p *Pool) { p.eventTypes = eventTypes }
+}
 
+// NewVotePool creates a Pool. The initial validators should be supplied.
+func NewVotePool(logger log.Logger, validators []*types.Validator, eventBus *types.EventBus, options ...PoolOption) *Pool {
 	cache, _ := lru.New(cacheVoteSize) // positive parameter will never return error
 
 	// set the initial validators
 	validatorVerifier := NewFromValidatorVerifier()
 	validatorVerifier.initValidators(validators)
 	votePool := &Pool{
-		stores:            stores,
-		ticker:            ticker,
+		eventTypes:        DefaultEventTypeRegistry(),
 		cache:             cache,
 		eventBus:          eventBus,
 		blsVerifier:       &BlsSignatureVerifier{},
 		validatorVerifier: validatorVerifier,
+		metrics:           NopMetrics(),
+	}
+	for _, option := range options {
+		option(votePool)
 	}
+
+	eventTypes := votePool.eventTypes.EventTypes()
+	votePool.stores = make(map[EventType]*voteStore, len(eventTypes))
+	for _, et := range eventTypes {
+		params, _ := votePool.eventTypes.Get(et)
+		votePool.stores[et] = newVoteStore(params)
+	}
+	votePool.ticker = time.NewTicker(pruneVoteInterval)
 	votePool.BaseService = *service.NewBaseService(logger, "VotePool", votePool)
 
 	return votePool
@@ -171,6 +272,9 @@ func (p *Pool) OnStart() error {
 	if err := p.BaseService.OnStart(); err != nil {
 		return err
 	}
+	if err := p.loadVotes(); err != nil {
+		return err
+	}
 	go p.validatorUpdateRoutine()
 	go p.pruneVoteRoutine()
 	return nil
@@ -180,64 +284,210 @@ func (p *Pool) OnStart() error {
 func (p *Pool) OnStop() {
 	p.BaseService.OnStop()
 	p.ticker.Stop()
+	if p.db != nil {
+		if err := p.db.close(); err != nil {
+			p.Logger.Error("Cannot close vote database", "err", err.Error())
+		}
+	}
 }
 
 // AddVote implements VotePool.
 func (p *Pool) AddVote(vote *Vote) error {
-	err := vote.ValidateBasic()
-	if err != nil {
-		return err
+	_, errs := p.AddVotes([]*Vote{vote})
+	return errs[0]
+}
+
+// AddVotes implements VotePool.
+// The signatures of the votes are verified in a batch, which is much cheaper than verifying them one by one.
+func (p *Pool) AddVotes(votes []*Vote) ([]bool, []error) {
+	added := make([]bool, len(votes))
+	errs := make([]error, len(votes))
+	pending := make([]*Vote, 0, len(votes))
+	indexes := make([]int, 0, len(votes))
+	batchKeys := make(map[string]struct{}, len(votes))
+	for i, vote := range votes {
+		if err := vote.ValidateBasic(); err != nil {
+			errs[i] = err
+			p.rejectVote(vote, rejectReasonMalformed)
+			continue
+		}
+		store, ok := p.stores[vote.EventType]
+		if !ok {
+			errs[i] = ErrUnsupportedEventType
+			p.rejectVote(vote, rejectReasonUnsupportedEventType)
+			continue
+		}
+
+		if ok = p.cache.Contains(vote.Key()); ok {
+			continue
+		}
+		if _, ok = batchKeys[vote.Key()]; ok { // the same vote is in the batch
+			continue
+		}
+
+		if store.isFull() {
+			errs[i] = fmt.Errorf("%w of event type %d", ErrTooManyVotes, vote.EventType)
+			p.rejectVote(vote, rejectReasonTooManyVotes)
+			continue
+		}
+
+		if err := p.validatorVerifier.Validate(vote); err != nil {
+			errs[i] = err
+			p.rejectVote(vote, rejectReasonNotFromValidator)
+			continue
+		}
+		batchKeys[vote.Key()] = struct{}{}
+		pending = append(pending, vote)
+		indexes = append(indexes, i)
 	}
-	store, ok := p.stores[vote.EventType]
-	if !ok {
-		return errors.New("unsupported event type")
+	if len(pending) == 0 {
+		return added, errs
 	}
 
-	if ok = p.cache.Contains(vote.Key()); ok {
-		return nil
+	start := time.Now()
+	sigErrs, batched := p.blsVerifier.ValidateBatch(pending)
+	p.metrics.VerifyBatchDurationSeconds.Observe(time.Since(start).Seconds())
+	p.metrics.VerifyBatchSize.Observe(float64(len(pending)))
+	if !batched {
+		p.metrics.VerifyBatchFallbacks.Add(1)
 	}
 
-	if err = p.validatorVerifier.Validate(vote); err != nil {
-		return err
-	}
-	if err = p.blsVerifier.Validate(vote); err != nil {
-		return err
+	for j, vote := range pending {
+		if sigErrs[j] != nil {
+			errs[indexes[j]] = sigErrs[j]
+			p.rejectVote(vote, rejectReasonInvalidSignature)
+			continue
+		}
+		errs[indexes[j]] = p.addVerifiedVote(p.stores[vote.EventType], vote)
+		added[indexes[j]] = errs[indexes[j]] == nil
 	}
+	return added, errs
+}
 
-	vote.expireAt = time.Now().Add(voteKeepAliveAfter)
+// addVerifiedVote will add a vote which has passed all validations to the store.
+func (p *Pool) addVerifiedVote(store *voteStore, vote *Vote) error {
+	vote.expireAt = time.Now().Add(store.params.KeepAlive)
+	if p.db != nil {
+		if err := p.db.saveVote(vote); err != nil {
+			p.rejectVote(vote, rejectReasonPersistFailure)
+			return err
+		}
+	}
 	store.addVote(vote)
+	eventTypeLabel := eventTypeLabel(vote.EventType)
+	p.metrics.AddedVotes.With("event_type", eventTypeLabel).Add(1)
+	p.metrics.Size.With("event_type", eventTypeLabel).Set(float64(store.len()))
 
-	if err = p.eventBus.Publish(eventBusVotePoolUpdates, *vote); err != nil {
+	if err := p.eventBus.PublishEventVotePoolUpdates(vote.toEventData()); err != nil {
 		p.Logger.Error("Cannot publish vote pool event", "err", err.Error())
 	}
 	p.cache.Add(vote.Key(), struct{}{})
+
+	p.checkQuorum(store, vote)
 	return nil
 }
 
+// rejectVote will record a rejected vote in metrics.
+func (p *Pool) rejectVote(vote *Vote, reason string) {
+	p.metrics.RejectedVotes.With("event_type", eventTypeLabel(vote.EventType), "reason", reason).Add(1)
+}
+
+// eventTypeLabel returns the event type as a metrics label value.
+func eventTypeLabel(eventType EventType) string {
+	return strconv.Itoa(int(eventType))
+}
+
+// checkQuorum will publish a vote quorum event, when the votes of the same event reach quorum for the first time.
+func (p *Pool) checkQuorum(store *voteStore, vote *Vote) {
+	if store.reachedQuorum(vote.EventHash) {
+		return
+	}
+	votes := store.getVotesByEventHash(vote.EventHash)
+	quorum, err := aggregateVotes(p.validatorVerifier.validatorsByPower(), vote.EventType, vote.EventHash, votes)
+	if err != nil {
+		if !errors.Is(err, errQuorumNotReached) {
+			p.Logger.Error("Cannot aggregate votes", "eventType", vote.EventType, "err", err.Error())
+		}
+		return
+	}
+	if !store.markQuorum(vote.EventHash) {
+		return
+	}
+	p.Logger.Debug("Votes reached quorum", "eventType", vote.EventType, "eventHash", fmt.Sprintf("%X", vote.EventHash))
+	if err = p.eventBus.PublishEventVoteQuorum(*quorum); err != nil {
+		p.Logger.Error("Cannot publish vote quorum event", "err", err.Error())
+	}
+}
+
+// EventTypes implements VotePool.
+func (p *Pool) EventTypes() *EventTypeRegistry {
+	return p.eventTypes
+}
+
 // GetVotesByEventTypeAndHash implements VotePool.
 func (p *Pool) GetVotesByEventTypeAndHash(eventType EventType, eventHash []byte) ([]*Vote, error) {
 	store, ok := p.stores[eventType]
 	if !ok {
-		return nil, errors.New("unsupported event type")
+		return nil, ErrUnsupportedEventType
 	}
 	return store.getVotesByEventHash(eventHash), nil
 }
 
+// GetVoteQuorum implements VotePool.
+func (p *Pool) GetVoteQuorum(eventType EventType, eventHash []byte) (*types.EventDataVoteQuorum, error) {
+	store, ok := p.stores[eventType]
+	if !ok {
+		return nil, ErrUnsupportedEventType
+	}
+	votes := store.getVotesByEventHash(eventHash)
+	return aggregateVotes(p.validatorVerifier.validatorsByPower(), eventType, eventHash, votes)
+}
+
 // GetVotesByEventType implements VotePool.
 func (p *Pool) GetVotesByEventType(eventType EventType) ([]*Vote, error) {
 	store, ok := p.stores[eventType]
 	if !ok {
-		return nil, errors.New("unsupported event type")
+		return nil, ErrUnsupportedEventType
 	}
 	return store.getAllVotes(), nil
 }
 
 // FlushVotes implements VotePool.
 func (p *Pool) FlushVotes() {
-	for _, store := range p.stores {
+	for et, store := range p.stores {
 		store.flushVotes()
+		p.metrics.Size.With("event_type", eventTypeLabel(et)).Set(0)
 	}
 	p.cache.Purge()
+	if p.db != nil {
+		if err := p.db.flushVotes(); err != nil {
+			p.Logger.Error("Cannot flush persisted votes", "err", err.Error())
+		}
+	}
+}
+
+// loadVotes will reload unexpired votes from the database, and prune the expired ones.
+func (p *Pool) loadVotes() error {
+	if p.db == nil {
+		return nil
+	}
+	now := time.Now()
+	for et, s := range p.stores {
+		if err := p.db.pruneVotes(et, now); err != nil {
+			return err
+		}
+		votes, err := p.db.loadVotes(et, now)
+		if err != nil {
+			return err
+		}
+		for _, vote := range votes {
+			s.addVote(vote)
+			p.cache.Add(vote.Key(), struct{}{})
+		}
+		p.metrics.Size.With("event_type", eventTypeLabel(et)).Set(float64(s.len()))
+		p.Logger.Info("Loaded persisted votes", "eventType", et, "votes", len(votes))
+	}
+	return nil
 }
 
 // validatorUpdateRoutine will sync validator updates.
@@ -267,11 +517,19 @@ func (p *Pool) validatorUpdateRoutine() {
 // pruneVoteRoutine will prune votes at the given intervals.
 func (p *Pool) pruneVoteRoutine() {
 	for range p.ticker.C {
-		for _, s := range p.stores {
+		for et, s := range p.stores {
 			keys := s.pruneVotes()
 			for _, key := range keys {
 				p.cache.Remove(key)
 			}
+			eventTypeLabel := eventTypeLabel(et)
+			p.metrics.PrunedVotes.With("event_type", eventTypeLabel).Add(float64(len(keys)))
+			p.metrics.Size.With("event_type", eventTypeLabel).Set(float64(s.len()))
+			if p.db != nil && len(keys) > 0 {
+				if err := p.db.pruneVotes(et, time.Now()); err != nil {
+					p.Logger.Error("Cannot prune persisted votes", "eventType", et, "err", err.Error())
+				}
+			}
 		}
 	}
 }
@@ -150,6 +150,19 @@ func TestPool_AddVote(t *testing.T) {
 	}
 }
 
+func TestPool_AddVotesDuplicate(t *testing.T) {
+	pk1, val1, _, _, _, votePool := makeVotePool()
+	vote1, vote2, _ := makeValidVotes(pk1, val1)
+
+	added, errs := votePool.AddVotes([]*Vote{&vote1, &vote1, &vote2})
+	assert.Equal(t, []error{nil, nil, nil}, errs)
+	assert.Equal(t, []bool{true, false, true}, added, "the same vote is only added once in a batch")
+
+	added, errs = votePool.AddVotes([]*Vote{&vote2})
+	assert.Equal(t, []error{nil}, errs)
+	assert.Equal(t, []bool{false}, added, "a vote in the pool is not added again")
+}
+
 func TestPool_QueryFlushVote(t *testing.T) {
 	pk1, val1, _, _, _, votePool := makeVotePool()
 	secKey, _ := blst.SecretKeyFromBytes(pk1.Marshal())
@@ -202,7 +215,7 @@ func TestPool_PruneVote(t *testing.T) {
 	err = votePool.AddVote(&vote2)
 	require.NoError(t, err)
 
-	time.Sleep(voteKeepAliveAfter)
+	time.Sleep(DefaultVoteKeepAlive)
 	time.Sleep(pruneVoteInterval)
 
 	result, err := votePool.GetVotesByEventType(FromBscCrossChainEvent)
@@ -219,7 +232,7 @@ func TestPool_SubscribeNewVoteEvent(t *testing.T) {
 
 	vote1, _, _ := makeValidVotes(secKey, val1)
 
-	sub, err := eventBus.Subscribe(context.Background(), "VotePoolUpdateSubscriber", eventVotePoolAdded, eventBusSubscribeCap)
+	sub, err := eventBus.Subscribe(context.Background(), "VotePoolUpdateSubscriber", types.EventQueryVotePoolUpdates, eventBusSubscribeCap)
 	require.NoError(t, err)
 
 	err = votePool.AddVote(&vote1)
@@ -227,8 +240,8 @@ func TestPool_SubscribeNewVoteEvent(t *testing.T) {
 
 	select {
 	case msg := <-sub.Out():
-		event, ok := msg.Data().(Vote)
-		require.True(t, ok, "Expected event of type Vote, got %T", msg.Data())
+		event, ok := msg.Data().(types.EventDataVotePoolUpdates)
+		require.True(t, ok, "Expected event of type EventDataVotePoolUpdates, got %T", msg.Data())
 		require.Equal(t, vote1.EventHash, event.EventHash)
 	case <-sub.Cancelled():
 		t.Fatalf("sub was canceled (reason: %v)", sub.Err())
@@ -284,3 +297,32 @@ func TestPool_ValidatorSetUpdate(t *testing.T) {
 	err = votePool.AddVote(&vote1)
 	require.NoError(t, err)
 }
+
+func TestPool_EventTypes(t *testing.T) {
+	pk1, val1, _, val2, eventBus, _ := makeVotePool()
+	secKey, _ := blst.SecretKeyFromBytes(pk1.Marshal())
+
+	eventTypes, err := NewEventTypeRegistry(EventTypeParams{EventType: FromBscCrossChainEvent, KeepAlive: time.Second, MaxVotes: 1})
+	require.NoError(t, err)
+	votePool := NewVotePool(log.TestingLogger(), []*types.Validator{val1, val2}, eventBus, WithEventTypes(eventTypes))
+	require.NoError(t, votePool.Start())
+	t.Cleanup(func() { _ = votePool.Stop() })
+
+	vote1, vote2, vote3 := makeValidVotes(secKey, val1)
+
+	require.NoError(t, votePool.AddVote(&vote1))
+	require.EqualError(t, votePool.AddVote(&vote2), "unsupported event type")
+	require.EqualError(t, votePool.AddVote(&vote3), "too many votes of event type 2")
+
+	_, err = votePool.GetVotesByEventType(ToBscCrossChainEvent)
+	require.Error(t, err)
+
+	// the vote expires with the keep alive duration of its event type
+	time.Sleep(time.Second)
+	time.Sleep(pruneVoteInterval)
+
+	result, err := votePool.GetVotesByEventType(FromBscCrossChainEvent)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(result))
+	require.NoError(t, votePool.AddVote(&vote3))
+}
@@ -0,0 +1,65 @@
+package votepool
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls/blst"
+	blsCommon "github.com/prysmaticlabs/prysm/v5/crypto/bls/common"
+
+	"github.com/cometbft/cometbft/libs/bits"
+	"github.com/cometbft/cometbft/types"
+)
+
+// errQuorumNotReached is returned when the votes of an event do not have more than 2/3 of the voting power.
+var errQuorumNotReached = errors.New("quorum not reached")
+
+// aggregateVotes aggregates the votes of the same event from the validators. Votes from non-validators are ignored.
+// The signers are marked in the bitmap by their indexes in the validators, which are sorted by voting power.
+// errQuorumNotReached is returned if the signers do not have more than 2/3 of the total voting power.
+func aggregateVotes(validators []*types.Validator, eventType EventType, eventHash []byte, votes []*Vote) (*types.EventDataVoteQuorum, error) {
+	voteMap := make(map[string]*Vote, len(votes))
+	for _, vote := range votes {
+		voteMap[string(vote.PubKey[:])] = vote
+	}
+
+	// the signatures are only decoded and aggregated once the signers are known to reach quorum
+	bitmap := bits.NewBitArray(len(validators))
+	signed := make([]*Vote, 0, len(votes))
+	var power, totalPower int64
+	for i, val := range validators {
+		totalPower += val.VotingPower
+		vote, ok := voteMap[string(val.BlsKey[:])]
+		if !ok {
+			continue
+		}
+		signed = append(signed, vote)
+		bitmap.SetIndex(i, true)
+		power += val.VotingPower
+	}
+
+	if !hasQuorum(power, totalPower) {
+		return nil, errQuorumNotReached
+	}
+	sigs := make([]blsCommon.Signature, 0, len(signed))
+	for _, vote := range signed {
+		sig, err := blst.SignatureFromBytes(vote.Signature)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature of vote %X: %w", vote.PubKey, err)
+		}
+		sigs = append(sigs, sig)
+	}
+	return &types.EventDataVoteQuorum{
+		EventType:        uint8(eventType),
+		EventHash:        eventHash,
+		AggSignature:     blst.AggregateSignatures(sigs).Marshal(),
+		SignerBitmap:     bitmap,
+		VotingPower:      power,
+		TotalVotingPower: totalPower,
+	}, nil
+}
+
+// hasQuorum returns whether the power is more than 2/3 of the total power.
+func hasQuorum(power, totalPower int64) bool {
+	return totalPower > 0 && power > totalPower*2/3
+}
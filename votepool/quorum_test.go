@@ -0,0 +1,109 @@
+package votepool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls/blst"
+	blsCommon "github.com/prysmaticlabs/prysm/v5/crypto/bls/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestHasQuorum(t *testing.T) {
+	require.False(t, hasQuorum(0, 0))
+	require.False(t, hasQuorum(2, 3))
+	require.True(t, hasQuorum(3, 3))
+	require.False(t, hasQuorum(20, 30))
+	require.True(t, hasQuorum(21, 30))
+}
+
+func TestPool_VoteQuorum(t *testing.T) {
+	pk1, val1, pk2, val2, eventBus, votePool := makeVotePool()
+	secKey1, _ := blst.SecretKeyFromBytes(pk1.Marshal())
+	secKey2, _ := blst.SecretKeyFromBytes(pk2.Marshal())
+
+	vote1, _, _ := makeValidVotes(secKey1, val1)
+	vote2, _, _ := makeValidVotes(secKey2, val2)
+
+	sub, err := eventBus.Subscribe(context.Background(), "VoteQuorumSubscriber", types.EventQueryVoteQuorum, eventBusSubscribeCap)
+	require.NoError(t, err)
+
+	// half of the voting power is not enough
+	require.NoError(t, votePool.AddVote(&vote1))
+	_, err = votePool.GetVoteQuorum(vote1.EventType, vote1.EventHash)
+	require.ErrorIs(t, err, errQuorumNotReached)
+
+	require.NoError(t, votePool.AddVote(&vote2))
+	quorum, err := votePool.GetVoteQuorum(vote1.EventType, vote1.EventHash)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), quorum.VotingPower)
+	require.Equal(t, int64(20), quorum.TotalVotingPower)
+	require.Equal(t, 2, quorum.SignerBitmap.Size())
+	require.True(t, quorum.SignerBitmap.GetIndex(0))
+	require.True(t, quorum.SignerBitmap.GetIndex(1))
+
+	sig, err := blst.SignatureFromBytes(quorum.AggSignature)
+	require.NoError(t, err)
+	pubKey1, _ := blst.PublicKeyFromBytes(val1.BlsKey)
+	pubKey2, _ := blst.PublicKeyFromBytes(val2.BlsKey)
+	var msg [32]byte
+	copy(msg[:], vote1.EventHash)
+	require.True(t, sig.FastAggregateVerify([]blsCommon.PublicKey{pubKey1, pubKey2}, msg))
+	require.True(t, votePool.stores[vote1.EventType].reachedQuorum(vote1.EventHash))
+
+	select {
+	case msg := <-sub.Out():
+		event, ok := msg.Data().(types.EventDataVoteQuorum)
+		require.True(t, ok, "Expected event of type EventDataVoteQuorum, got %T", msg.Data())
+		require.Equal(t, uint8(vote1.EventType), event.EventType)
+		require.Equal(t, vote1.EventHash, event.EventHash)
+		require.Equal(t, quorum.AggSignature, event.AggSignature)
+	case <-sub.Cancelled():
+		t.Fatalf("sub was canceled (reason: %v)", sub.Err())
+	case <-time.After(1 * time.Second):
+		t.Fatal("Did not receive EventVoteQuorum within 1 sec.")
+	}
+}
+
+func TestAggregateVotes_IgnoreNonValidators(t *testing.T) {
+	pk1, val1, pk2, val2, _, _ := makeVotePool()
+	secKey1, _ := blst.SecretKeyFromBytes(pk1.Marshal())
+	secKey2, _ := blst.SecretKeyFromBytes(pk2.Marshal())
+
+	vote1, _, _ := makeValidVotes(secKey1, val1)
+	vote2, _, _ := makeValidVotes(secKey2, val2)
+
+	val3 := val1.Copy()
+	val3.BlsKey = []byte("another bls key")
+	val3.VotingPower = 1
+	validators := []*types.Validator{val1, val3}
+
+	quorum, err := aggregateVotes(validators, vote1.EventType, vote1.EventHash, []*Vote{&vote1, &vote2})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), quorum.VotingPower)
+	require.Equal(t, int64(11), quorum.TotalVotingPower)
+	require.True(t, quorum.SignerBitmap.GetIndex(0))
+	require.False(t, quorum.SignerBitmap.GetIndex(1))
+	require.Equal(t, vote1.Signature, quorum.AggSignature)
+}
+
+func TestAggregateVotes_DecodeOnlyOnQuorum(t *testing.T) {
+	pk1, val1, _, val2, _, _ := makeVotePool()
+	secKey1, _ := blst.SecretKeyFromBytes(pk1.Marshal())
+
+	vote1, _, _ := makeValidVotes(secKey1, val1)
+	vote1.Signature = []byte("not a signature")
+	validators := []*types.Validator{val1, val2}
+
+	// the signature is not decoded without quorum
+	_, err := aggregateVotes(validators, vote1.EventType, vote1.EventHash, []*Vote{&vote1})
+	require.ErrorIs(t, err, errQuorumNotReached)
+
+	val2.VotingPower = 1
+	_, err = aggregateVotes(validators, vote1.EventType, vote1.EventHash, []*Vote{&vote1})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, errQuorumNotReached)
+}
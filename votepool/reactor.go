@@ -2,6 +2,7 @@ package votepool
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -19,6 +20,10 @@ const (
 	// VotePoolChannel is the p2p channel used for sending and receiving votes in vote Pool.
 	VotePoolChannel = byte(0x70)
 
+	// VoteSummaryChannel is the p2p channel used for sending and receiving vote summaries. Peers not
+	// advertising it in their NodeInfo are sent all the votes in the vote Pool instead.
+	VoteSummaryChannel = byte(0x71)
+
 	// Max number of kept vote histories from each peer, to avoiding broadcasting duplicated votes to a peer.
 	maxVoteHistoryOfEachPeer = 256
 
@@ -27,9 +32,19 @@ const (
 
 	// Key for cache of votes from a peer.
 	peerVoteCacheKey = "VotePoolReactor.voteCache"
+
+	// Max number of received votes whose signatures are verified in one batch.
+	maxVerifyBatchSize = 64
+
+	// Size of the channel buffering received votes before verification.
+	receivedVoteChSize = 1024
 )
 
-var eventVotePoolAdded = types.QueryForEvent(eventBusVotePoolUpdates)
+// receivedVote is a vote received from a peer, waiting to be verified and added to the vote Pool.
+type receivedVote struct {
+	vote *Vote
+	src  p2p.Peer
+}
 
 // Reactor will 1) subscribe votes from vote Pool and 2) broadcast votes to peers.
 type Reactor struct {
@@ -37,13 +52,30 @@ type Reactor struct {
 
 	votePool VotePool
 	eventBus *types.EventBus
+
+	receivedVoteCh chan receivedVote // votes received from peers
+
+	metrics *Metrics
+}
+
+// ReactorOption sets an optional parameter on the Reactor.
+type ReactorOption func(*Reactor)
+
+// ReactorMetrics sets the metrics of the Reactor.
+func ReactorMetrics(metrics *Metrics) ReactorOption {
+	return func(voteR *Reactor) { voteR.metrics = metrics }
 }
 
 // NewReactor returns a new Reactor with the given vote Pool.
-func NewReactor(votePool VotePool, eventBus *types.EventBus) *Reactor {
+func NewReactor(votePool VotePool, eventBus *types.EventBus, options ...ReactorOption) *Reactor {
 	voteR := &Reactor{
-		votePool: votePool,
-		eventBus: eventBus,
+		votePool:       votePool,
+		eventBus:       eventBus,
+		receivedVoteCh: make(chan receivedVote, receivedVoteChSize),
+		metrics:        NopMetrics(),
+	}
+	for _, option := range options {
+		option(voteR)
 	}
 	voteR.BaseReactor = *p2p.NewBaseReactor("VotePoolReactor", voteR)
 	return voteR
@@ -55,7 +87,11 @@ func (voteR *Reactor) OnStart() error {
 		return err
 	}
 
-	return voteR.votePool.Start()
+	if err := voteR.votePool.Start(); err != nil {
+		return err
+	}
+	go voteR.addVotesRoutine()
+	return nil
 }
 
 // OnStop implements Service.
@@ -69,11 +105,19 @@ func (voteR *Reactor) SetLogger(l log.Logger) {
 	voteR.Logger = l
 }
 
+// InitPeer implements Reactor.
+// The sync state is set before the peer is started, so that its vote summary will not be missed.
+func (voteR *Reactor) InitPeer(peer p2p.Peer) p2p.Peer {
+	peer.Set(peerSyncStateKey, newPeerSyncState())
+	return peer
+}
+
 // AddPeer implements Reactor.
 // It starts a broadcast routine ensuring all local votes are forwarded to the remote peer.
 func (voteR *Reactor) AddPeer(peer p2p.Peer) {
 	cache, _ := lru.New(maxVoteHistoryOfEachPeer) // positive parameter will never return error
 	peer.Set(peerVoteCacheKey, cache)
+	peer.Set(peerScoreKey, &peerScore{})
 	go voteR.broadcastVotes(peer)
 }
 
@@ -84,9 +128,9 @@ func (voteR *Reactor) RemovePeer(peer p2p.Peer, reason interface{}) {
 	}
 
 	peerID := peer.ID()
-	err := voteR.eventBus.Unsubscribe(context.Background(), string(peerID), eventVotePoolAdded)
+	err := voteR.eventBus.Unsubscribe(context.Background(), string(peerID), types.EventQueryVotePoolUpdates)
 	if err != nil {
-		voteR.Logger.Error("Cannot unsubscribe events", "peer", peerID, "event", eventVotePoolAdded)
+		voteR.Logger.Error("Cannot unsubscribe events", "peer", peerID, "event", types.EventQueryVotePoolUpdates)
 	}
 }
 
@@ -99,6 +143,12 @@ func (voteR *Reactor) GetChannels() []*conn.ChannelDescriptor {
 			RecvMessageCapacity: 256, // size is bigger than Vote message
 			MessageType:         &votepool.Message{},
 		},
+		{
+			ID:                  VoteSummaryChannel,
+			Priority:            7,
+			RecvMessageCapacity: maxMsgSize,
+			MessageType:         &votepool.Message{},
+		},
 	}
 }
 
@@ -107,11 +157,17 @@ func (voteR *Reactor) Receive(chID byte, peer p2p.Peer, msgBytes []byte) {
 	msg := &votepool.Message{}
 	err := proto.Unmarshal(msgBytes, msg)
 	if err != nil {
-		panic(err)
+		voteR.Logger.Error("Error decoding message", "src", peer, "chId", chID, "err", err)
+		voteR.Switch.MarkPeerBadEvent(peer, err)
+		voteR.Switch.StopPeerForError(peer, err)
+		return
 	}
 	uw, err := msg.Unwrap()
 	if err != nil {
-		panic(err)
+		voteR.Logger.Error("Error unwrapping message", "src", peer, "chId", chID, "err", err)
+		voteR.Switch.MarkPeerBadEvent(peer, err)
+		voteR.Switch.StopPeerForError(peer, err)
+		return
 	}
 	voteR.ReceiveEnvelope(p2p.Envelope{
 		ChannelID: chID,
@@ -121,18 +177,49 @@ func (voteR *Reactor) Receive(chID byte, peer p2p.Peer, msgBytes []byte) {
 }
 
 func (voteR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
+	// vote summaries are only sent on the VoteSummaryChannel, and votes on the VotePoolChannel
+	if _, ok := e.Message.(*votepool.VoteSummary); ok != (e.ChannelID == VoteSummaryChannel) {
+		voteR.Logger.Error("Unexpected message on channel", "src", e.Src, "chId", e.ChannelID, "msg", e.Message)
+		voteR.Switch.StopPeerForError(e.Src, fmt.Errorf("votepool cannot handle message of type %T on channel %#x",
+			e.Message, e.ChannelID))
+		return
+	}
+
 	switch msg := e.Message.(type) {
 	case *votepool.Vote:
 		vote := NewVote(msg.PubKey, msg.Signature, uint8(msg.EventType), msg.EventHash)
 		voteR.Logger.Debug("Receive vote", "vote", vote.Key(), "src", e.Src)
-		if err := voteR.votePool.AddVote(vote); err != nil {
-			voteR.Logger.Info("Could not add vote", "vote", vote.Key(), "err", err)
-		} else {
-			if cache, ok := e.Src.Get(peerVoteCacheKey).(*lru.Cache); ok {
-				// keep track of votes from the remote peer, update timestamp
-				cache.Add(vote.Key(), time.Now())
-			}
+		if msg.EventType > uint32(^uint8(0)) || !voteR.votePool.EventTypes().Has(vote.EventType) {
+			voteR.Logger.Info("Unsupported event type", "eventType", msg.EventType, "src", e.Src)
+			voteR.punishPeer(e.Src, unexpectedVoteScore, ErrUnsupportedEventType)
+			return
+		}
+		if err := vote.ValidateBasic(); err != nil {
+			voteR.Logger.Info("Malformed vote", "vote", vote.Key(), "src", e.Src, "err", err)
+			voteR.punishPeer(e.Src, invalidVoteScore, err)
+			return
+		}
+		select {
+		case voteR.receivedVoteCh <- receivedVote{vote: vote, src: e.Src}:
+		case <-voteR.Quit():
+		}
+	case *votepool.VoteSummary:
+		voteR.Logger.Debug("Receive vote summary", "digests", len(msg.Digests), "src", e.Src)
+		if err := validateVoteSummary(msg); err != nil {
+			voteR.Logger.Info("Malformed vote summary", "src", e.Src, "err", err)
+			voteR.punishPeer(e.Src, invalidVoteScore, err)
+			return
+		}
+		state, ok := e.Src.Get(peerSyncStateKey).(*peerSyncState)
+		if !ok {
+			return
+		}
+		if state.received.Swap(true) {
+			voteR.Logger.Info("Duplicated vote summary", "src", e.Src)
+			voteR.punishPeer(e.Src, unexpectedVoteScore, errors.New("duplicated vote summary"))
+			return
 		}
+		state.summaryCh <- msg
 	default:
 		voteR.Logger.Error("Unknown message type", "src", e.Src, "chId", e.ChannelID, "msg", e.Message)
 		voteR.Switch.StopPeerForError(e.Src, fmt.Errorf("votepool cannot handle message of type: %T", e.Message))
@@ -140,12 +227,84 @@ func (voteR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 	}
 }
 
+// addVotesRoutine will collect received votes into batches, so that their signatures can be verified together.
+// A batch is handled as soon as no more votes are waiting, thus a single vote will not be delayed.
+func (voteR *Reactor) addVotesRoutine() {
+	batch := make([]receivedVote, 0, maxVerifyBatchSize)
+	for {
+		select {
+		case rv := <-voteR.receivedVoteCh:
+			batch = append(batch[:0], rv)
+		collect:
+			for len(batch) < maxVerifyBatchSize {
+				select {
+				case rv = <-voteR.receivedVoteCh:
+					batch = append(batch, rv)
+				default:
+					break collect
+				}
+			}
+			voteR.addVotes(batch)
+		case <-voteR.Quit():
+			return
+		}
+	}
+}
+
+// addVotes will add a batch of received votes to the vote Pool.
+func (voteR *Reactor) addVotes(batch []receivedVote) {
+	votes := make([]*Vote, len(batch))
+	for i, rv := range batch {
+		votes[i] = rv.vote
+	}
+	added, errs := voteR.votePool.AddVotes(votes)
+	for i, rv := range batch {
+		if errs[i] != nil {
+			voteR.Logger.Info("Could not add vote", "vote", rv.vote.Key(), "src", rv.src, "err", errs[i])
+			switch {
+			case errors.Is(errs[i], ErrInvalidSignature):
+				voteR.punishPeer(rv.src, invalidVoteScore, errs[i])
+			case errors.Is(errs[i], ErrNotFromValidator):
+				voteR.punishPeer(rv.src, unexpectedVoteScore, errs[i])
+			}
+			continue
+		}
+		// only reward new votes, so that a peer can't build trust by replaying a vote
+		if added[i] {
+			voteR.Switch.MarkPeerGoodEvent(rv.src)
+		}
+		if cache, ok := rv.src.Get(peerVoteCacheKey).(*lru.Cache); ok {
+			// keep track of votes from the remote peer, update timestamp
+			cache.Add(rv.vote.Key(), time.Now())
+		}
+	}
+}
+
+// punishPeer will add points to the misbehaviour score of the peer, and disconnect the peer once
+// the score reaches the threshold.
+func (voteR *Reactor) punishPeer(peer p2p.Peer, points float64, reason error) {
+	score, ok := peer.Get(peerScoreKey).(*peerScore)
+	if !ok {
+		return
+	}
+	if score.add(points, time.Now()) < maxPeerScore {
+		return
+	}
+	voteR.Logger.Error("Stopping misbehaving peer", "peer", peer, "err", reason)
+	voteR.metrics.MisbehavingPeers.Add(1)
+	voteR.Switch.MarkPeerBadEvent(peer, reason)
+	voteR.Switch.StopPeerForError(peer, fmt.Errorf("too many invalid votes, last error: %w", reason))
+}
+
 // broadcastVotes routine will broadcast votes to peers.
+// Once subscribed, it sends a summary of the votes in the vote Pool to the peer, and replies to the peer's
+// summary with the votes it is missing, so that votes added before the peer connected are not lost.
+// A peer not supporting vote summaries is sent all the votes in the vote Pool instead.
 func (voteR *Reactor) broadcastVotes(peer p2p.Peer) {
 	if !voteR.IsRunning() || !peer.IsRunning() {
 		return
 	}
-	sub, err := voteR.eventBus.Subscribe(context.Background(), string(peer.ID()), eventVotePoolAdded, eventBusSubscribeCap)
+	sub, err := voteR.eventBus.Subscribe(context.Background(), string(peer.ID()), types.EventQueryVotePoolUpdates, eventBusSubscribeCap)
 	if err != nil {
 		voteR.Logger.Error("Cannot subscribe to vote update event", "err", err.Error())
 		return
@@ -155,10 +314,26 @@ func (voteR *Reactor) broadcastVotes(peer p2p.Peer) {
 		voteR.Logger.Error(fmt.Sprintf("Peer %v has no cache state", peer))
 		return
 	}
+	state, ok := peer.Get(peerSyncStateKey).(*peerSyncState)
+	if !ok { // this should not happen
+		voteR.Logger.Error(fmt.Sprintf("Peer %v has no sync state", peer))
+		return
+	}
+	if supportsVoteSummary(peer) {
+		summary := makeVoteSummary(groupVotesByEvent(voteR.votePool))
+		if !peer.SendEnvelope(p2p.Envelope{ChannelID: VoteSummaryChannel, Message: summary}) {
+			voteR.Logger.Error("Cannot send vote summary", "peer", peer)
+		}
+	} else {
+		voteR.sendMissingVotes(peer, cache, &votepool.VoteSummary{})
+	}
 	for {
 		select {
+		case summary := <-state.summaryCh:
+			voteR.sendMissingVotes(peer, cache, summary)
 		case voteData := <-sub.Out():
-			vote := voteData.Data().(Vote)
+			data := voteData.Data().(types.EventDataVotePoolUpdates)
+			vote := NewVote(data.PubKey, data.Signature, data.EventType, data.EventHash)
 			// send votes to remote peer, if
 			// 1) it did not receive the vote from the remote peer, or,
 			// 2) the vote is received earlier than `time.Now() - cacheTimeout`
@@ -174,12 +349,7 @@ func (voteR *Reactor) broadcastVotes(peer p2p.Peer) {
 			if needToSend {
 				_ = peer.SendEnvelope(p2p.Envelope{
 					ChannelID: VotePoolChannel,
-					Message: &votepool.Vote{
-						PubKey:    vote.PubKey,
-						Signature: vote.Signature,
-						EventType: uint32(vote.EventType),
-						EventHash: vote.EventHash,
-					},
+					Message:   vote.toProto(),
 				})
 				voteR.Logger.Debug("Sent vote to", "peer", peer, "vote", vote.Key())
 			}
@@ -192,3 +362,19 @@ func (voteR *Reactor) broadcastVotes(peer p2p.Peer) {
 		}
 	}
 }
+
+// sendMissingVotes will send the votes in the vote Pool which are missing from the peer's summary.
+func (voteR *Reactor) sendMissingVotes(peer p2p.Peer, cache *lru.Cache, summary *votepool.VoteSummary) {
+	// the votes received from the peer are known by it, no matter when they were received
+	votes := missingVotes(groupVotesByEvent(voteR.votePool), summary, func(vote *Vote) bool {
+		return cache.Contains(vote.Key())
+	})
+	for _, vote := range votes {
+		if !peer.SendEnvelope(p2p.Envelope{ChannelID: VotePoolChannel, Message: vote.toProto()}) {
+			voteR.Logger.Error("Cannot send missing votes", "peer", peer)
+			return
+		}
+		voteR.metrics.SyncedVotes.Add(1)
+	}
+	voteR.Logger.Debug("Sent missing votes to", "peer", peer, "votes", len(votes))
+}
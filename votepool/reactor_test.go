@@ -2,6 +2,7 @@ package votepool
 
 import (
 	"bytes"
+	"math/big"
 	"sync"
 	"testing"
 	"time"
@@ -16,6 +17,8 @@ import (
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/conn"
+	"github.com/cometbft/cometbft/proto/tendermint/votepool"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -35,6 +38,12 @@ func votepoolLogger() log.Logger {
 }
 
 func makeAndConnectReactors(config *cfg.Config, n int) ([]blsCommon.SecretKey, []*types.Validator, []*types.EventBus, []VotePool, []*Reactor) {
+	pks, vals, eventBuses, votePools, reactors := makeReactors(n)
+	connectReactors(config, reactors)
+	return pks, vals, eventBuses, votePools, reactors
+}
+
+func makeReactors(n int) ([]blsCommon.SecretKey, []*types.Validator, []*types.EventBus, []VotePool, []*Reactor) {
 	pubKey1 := ed25519.GenPrivKey().PubKey()
 	blsPrivKey1, _ := blst.RandKey()
 	blsPubKey1 := blsPrivKey1.PublicKey().Marshal()
@@ -72,13 +81,15 @@ func makeAndConnectReactors(config *cfg.Config, n int) ([]blsCommon.SecretKey, [
 		reactors[i] = NewReactor(votePool, eventBus)
 		reactors[i].SetLogger(logger.With("validator", i))
 	}
+	return pks, vals, eventBuses, votePools, reactors
+}
 
-	p2p.MakeConnectedSwitches(config.P2P, n, func(i int, s *p2p.Switch) *p2p.Switch {
+func connectReactors(config *cfg.Config, reactors []*Reactor) {
+	p2p.MakeConnectedSwitches(config.P2P, len(reactors), func(i int, s *p2p.Switch) *p2p.Switch {
 		s.AddReactor("VOTEPOOL", reactors[i])
 		return s
 
 	}, p2p.Connect2Switches)
-	return pks, vals, eventBuses, votePools, reactors
 }
 
 func TestReactorBroadcastVotes(t *testing.T) {
@@ -113,6 +124,68 @@ func TestReactorBroadcastVotes(t *testing.T) {
 	waitVotesReceived(t, reactors, eventHash2)
 }
 
+func TestReactorSyncVotesOnConnect(t *testing.T) {
+	config := cfg.TestConfig()
+	pks, vals, _, pools, reactors := makeReactors(2)
+
+	secKey1, _ := blst.SecretKeyFromBytes(pks[0].Marshal())
+	secKey2, _ := blst.SecretKeyFromBytes(pks[1].Marshal())
+	eventHash1 := common.HexToHash("0xeefacfed87736ae1d8e8640f6fd7951862997782e5e79842557923e2779d5d5a").Bytes()
+	eventHash2 := common.HexToHash("0x7e19be15d0d524a1ca5e39be503d18584c23426920bdc23b159c37a2341913d0").Bytes()
+	eventHash3 := common.HexToHash("0xb941130c8d3508f642aba83db420f9cef6a6ebb7f869e3cef06f276bdcf205a9").Bytes()
+
+	// both pools hold the first vote, and each pool holds a vote missing from the other one
+	sharedVote := &Vote{PubKey: vals[0].BlsKey, Signature: secKey1.Sign(eventHash1).Marshal(), EventType: testEventType, EventHash: eventHash1}
+	vote1 := &Vote{PubKey: vals[0].BlsKey, Signature: secKey1.Sign(eventHash2).Marshal(), EventType: testEventType, EventHash: eventHash2}
+	vote2 := &Vote{PubKey: vals[1].BlsKey, Signature: secKey2.Sign(eventHash3).Marshal(), EventType: testEventType, EventHash: eventHash3}
+	require.NoError(t, pools[0].AddVote(sharedVote))
+	require.NoError(t, pools[1].AddVote(sharedVote))
+	require.NoError(t, pools[0].AddVote(vote1))
+	require.NoError(t, pools[1].AddVote(vote2))
+
+	// votes added before connecting are exchanged
+	connectReactors(config, reactors)
+	waitVotesReceived(t, reactors, eventHash2)
+	waitVotesReceived(t, reactors, eventHash3)
+	for _, pool := range pools {
+		votes, err := pool.GetVotesByEventType(testEventType)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(votes))
+	}
+}
+
+// legacyReactor doesn't advertise the VoteSummaryChannel, as a peer not supporting vote summaries.
+type legacyReactor struct {
+	*Reactor
+}
+
+func (r legacyReactor) GetChannels() []*conn.ChannelDescriptor {
+	return r.Reactor.GetChannels()[:1]
+}
+
+func TestReactorSyncVotesWithoutSummaries(t *testing.T) {
+	config := cfg.TestConfig()
+	pks, vals, _, pools, reactors := makeReactors(2)
+
+	secKey, _ := blst.SecretKeyFromBytes(pks[0].Marshal())
+	eventHash := common.HexToHash("0xeefacfed87736ae1d8e8640f6fd7951862997782e5e79842557923e2779d5d5a").Bytes()
+	vote := &Vote{PubKey: vals[0].BlsKey, Signature: secKey.Sign(eventHash).Marshal(), EventType: testEventType, EventHash: eventHash}
+	require.NoError(t, pools[0].AddVote(vote))
+
+	// the peer not supporting vote summaries is sent the votes added before connecting
+	p2p.MakeConnectedSwitches(config.P2P, len(reactors), func(i int, s *p2p.Switch) *p2p.Switch {
+		if i == 1 {
+			s.AddReactor("VOTEPOOL", legacyReactor{reactors[i]})
+		} else {
+			s.AddReactor("VOTEPOOL", reactors[i])
+		}
+		return s
+	}, p2p.Connect2Switches)
+	require.False(t, supportsVoteSummary(reactors[0].Switch.Peers().List()[0]))
+	require.True(t, supportsVoteSummary(reactors[1].Switch.Peers().List()[0]))
+	waitForVoteOnReactor(t, eventHash, reactors[1])
+}
+
 func waitVotesReceived(t *testing.T, reactors []*Reactor, eventHash []byte) {
 	wg := new(sync.WaitGroup)
 	for i, reactor := range reactors {
@@ -153,3 +226,30 @@ func waitForVoteOnReactor(t *testing.T, eventHash []byte, r *Reactor) {
 		}
 	}
 }
+
+func TestReactorStopMisbehavingPeer(t *testing.T) {
+	config := cfg.TestConfig()
+	pks, vals, _, _, reactors := makeAndConnectReactors(config, 2)
+
+	peers := reactors[0].Switch.Peers().List()
+	require.Equal(t, 1, len(peers))
+	peer := peers[0]
+
+	// votes signed by a validator, but with signatures of other events
+	secKey, _ := blst.SecretKeyFromBytes(pks[1].Marshal())
+	anotherEventHash := common.HexToHash("0x7e19be15d0d524a1ca5e39be503d18584c23426920bdc23b159c37a2341913d0").Bytes()
+	sign := secKey.Sign(anotherEventHash).Marshal()
+	for i := 0; i <= int(maxPeerScore/invalidVoteScore); i++ {
+		invalidVote := &votepool.Vote{
+			PubKey:    vals[1].BlsKey,
+			Signature: sign,
+			EventType: uint32(testEventType),
+			EventHash: common.BigToHash(big.NewInt(int64(i))).Bytes(),
+		}
+		reactors[0].ReceiveEnvelope(p2p.Envelope{ChannelID: VotePoolChannel, Src: peer, Message: invalidVote})
+	}
+
+	require.Eventually(t, func() bool {
+		return reactors[0].Switch.Peers().Size() == 0
+	}, 10*time.Second, 100*time.Millisecond)
+}
@@ -0,0 +1,150 @@
+package votepool
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"sync/atomic"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/proto/tendermint/votepool"
+)
+
+const (
+	// Key for the catch-up state of a peer.
+	peerSyncStateKey = "VotePoolReactor.syncState"
+
+	// Max number of vote digests in a VoteSummary message. Events beyond the limit are not summarized,
+	// thus their votes will be sent by the remote peer, and deduplicated when adding to the vote Pool.
+	maxVoteDigests = 512
+
+	// Max size of a message on the VoteSummaryChannel, which is big enough for a VoteSummary with maxVoteDigests digests.
+	maxMsgSize = 64 * 1024
+)
+
+// peerSyncState tracks the catch-up of a newly connected peer.
+// Each peer sends a VoteSummary once, and we reply with the votes missing from the summary.
+type peerSyncState struct {
+	summaryCh chan *votepool.VoteSummary // the summary received from the peer, handled by broadcastVotes
+	received  atomic.Bool                // whether a summary has been received from the peer
+}
+
+func newPeerSyncState() *peerSyncState {
+	return &peerSyncState{
+		summaryCh: make(chan *votepool.VoteSummary, 1),
+	}
+}
+
+// supportsVoteSummary returns true if the peer advertises the VoteSummaryChannel, thus can handle vote summaries.
+func supportsVoteSummary(peer p2p.Peer) bool {
+	ni, ok := peer.NodeInfo().(p2p.DefaultNodeInfo)
+	return ok && ni.HasChannel(VoteSummaryChannel)
+}
+
+// eventVotes are the votes of the same event held by the vote Pool.
+type eventVotes struct {
+	eventType EventType
+	eventHash []byte
+	votes     []*Vote
+}
+
+// digest returns the hash of the sorted public keys of the votes, so that two peers holding the same
+// votes of an event have the same digest.
+func (e *eventVotes) digest() []byte {
+	pubKeys := make([][]byte, len(e.votes))
+	for i, vote := range e.votes {
+		pubKeys[i] = vote.PubKey
+	}
+	sort.Slice(pubKeys, func(i, j int) bool { return bytes.Compare(pubKeys[i], pubKeys[j]) < 0 })
+	return tmhash.Sum(bytes.Join(pubKeys, nil))
+}
+
+// eventKey is used as the identifier of an event in a VoteSummary.
+func eventKey(eventType EventType, eventHash []byte) string {
+	return string([]byte{byte(eventType)}) + string(eventHash)
+}
+
+// groupVotesByEvent returns the votes in the vote Pool grouped by event, ordered by event type.
+func groupVotesByEvent(votePool VotePool) []*eventVotes {
+	events := make([]*eventVotes, 0)
+	for _, et := range votePool.EventTypes().EventTypes() {
+		votes, err := votePool.GetVotesByEventType(et)
+		if err != nil {
+			continue
+		}
+		index := make(map[string]*eventVotes)
+		for _, vote := range votes {
+			ev, ok := index[string(vote.EventHash)]
+			if !ok {
+				ev = &eventVotes{eventType: et, eventHash: vote.EventHash}
+				index[string(vote.EventHash)] = ev
+				events = append(events, ev)
+			}
+			ev.votes = append(ev.votes, vote)
+		}
+	}
+	return events
+}
+
+// makeVoteSummary returns a summary of at most maxVoteDigests events.
+func makeVoteSummary(events []*eventVotes) *votepool.VoteSummary {
+	if len(events) > maxVoteDigests {
+		events = events[:maxVoteDigests]
+	}
+	digests := make([]*votepool.VoteDigest, len(events))
+	for i, ev := range events {
+		digests[i] = &votepool.VoteDigest{
+			EventType: uint32(ev.eventType),
+			EventHash: ev.eventHash,
+			Digest:    ev.digest(),
+		}
+	}
+	return &votepool.VoteSummary{Digests: digests}
+}
+
+// validateVoteSummary does basic validation of a summary received from a peer.
+func validateVoteSummary(summary *votepool.VoteSummary) error {
+	if len(summary.Digests) > maxVoteDigests {
+		return fmt.Errorf("too many vote digests: %d", len(summary.Digests))
+	}
+	for _, d := range summary.Digests {
+		if d == nil {
+			return errors.New("nil vote digest")
+		}
+		if d.EventType == 0 || d.EventType > uint32(^uint8(0)) {
+			return fmt.Errorf("invalid event type %d", d.EventType)
+		}
+		if len(d.EventHash) != eventHashLen {
+			return errors.New("invalid event hash")
+		}
+		if len(d.Digest) != tmhash.Size {
+			return errors.New("invalid digest")
+		}
+	}
+	return nil
+}
+
+// missingVotes returns the votes in the given events which are not covered by the summary.
+// The events with the same digest are skipped, and for the others all votes are considered missing,
+// except those filtered out by the given function.
+func missingVotes(events []*eventVotes, summary *votepool.VoteSummary, skip func(*Vote) bool) []*Vote {
+	remote := make(map[string][]byte, len(summary.Digests))
+	for _, d := range summary.Digests {
+		remote[eventKey(EventType(d.EventType), d.EventHash)] = d.Digest
+	}
+
+	votes := make([]*Vote, 0)
+	for _, ev := range events {
+		if digest, ok := remote[eventKey(ev.eventType, ev.eventHash)]; ok && bytes.Equal(digest, ev.digest()) {
+			continue
+		}
+		for _, vote := range ev.votes {
+			if !skip(vote) {
+				votes = append(votes, vote)
+			}
+		}
+	}
+	return votes
+}
@@ -0,0 +1,114 @@
+package votepool
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls/blst"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/proto/tendermint/votepool"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestVoteSummary_MissingVotes(t *testing.T) {
+	pk1, val1, pk2, val2, eventBus, votePool1 := makeVotePool()
+	secKey1, _ := blst.SecretKeyFromBytes(pk1.Marshal())
+	secKey2, _ := blst.SecretKeyFromBytes(pk2.Marshal())
+	vote1, vote2, vote3 := makeValidVotes(secKey1, val1)
+	vote4 := Vote{
+		PubKey:    val2.BlsKey,
+		Signature: secKey2.Sign(vote1.EventHash).Marshal(),
+		EventType: vote1.EventType,
+		EventHash: vote1.EventHash,
+	}
+
+	votePool2 := NewVotePool(log.TestingLogger(), []*types.Validator{val1, val2}, eventBus)
+	require.NoError(t, votePool2.Start())
+	t.Cleanup(func() { _ = votePool2.Stop() })
+
+	// votePool1 holds all votes, while votePool2 misses vote2 and vote4
+	for _, vote := range []*Vote{&vote1, &vote2, &vote3, &vote4} {
+		require.NoError(t, votePool1.AddVote(vote))
+	}
+	for _, vote := range []*Vote{&vote1, &vote3} {
+		require.NoError(t, votePool2.AddVote(vote))
+	}
+
+	events1 := groupVotesByEvent(votePool1)
+	require.Equal(t, 3, len(events1))
+	events2 := groupVotesByEvent(votePool2)
+	require.Equal(t, 2, len(events2))
+
+	summary := makeVoteSummary(events2)
+	require.NoError(t, validateVoteSummary(summary))
+
+	// all votes of the event of vote1 are sent, since their digests are different
+	votes := missingVotes(events1, summary, func(*Vote) bool { return false })
+	require.ElementsMatch(t, []string{vote1.Key(), vote2.Key(), vote4.Key()}, voteKeys(votes))
+
+	// votes known by the peer are skipped
+	votes = missingVotes(events1, summary, func(vote *Vote) bool { return vote.Key() == vote1.Key() })
+	require.ElementsMatch(t, []string{vote2.Key(), vote4.Key()}, voteKeys(votes))
+
+	// nothing is missing from the peer holding the same votes
+	votes = missingVotes(events1, makeVoteSummary(events1), func(*Vote) bool { return false })
+	require.Empty(t, votes)
+}
+
+func TestVoteSummary_ValidateBasic(t *testing.T) {
+	eventHash := common.HexToHash("0xeefacfed87736ae1d8e8640f6fd7951862997782e5e79842557923e2779d5d5a").Bytes()
+	validDigest := func() *votepool.VoteDigest {
+		return &votepool.VoteDigest{EventType: uint32(testEventType), EventHash: eventHash, Digest: tmhash.Sum(nil)}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(d *votepool.VoteDigest)
+		expErr   bool
+	}{
+		{"valid", func(d *votepool.VoteDigest) {}, false},
+		{"reserved event type", func(d *votepool.VoteDigest) { d.EventType = 0 }, true},
+		{"event type overflow", func(d *votepool.VoteDigest) { d.EventType = 256 }, true},
+		{"invalid event hash", func(d *votepool.VoteDigest) { d.EventHash = eventHash[1:] }, true},
+		{"invalid digest", func(d *votepool.VoteDigest) { d.Digest = nil }, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := validDigest()
+			tc.malleate(d)
+			err := validateVoteSummary(&votepool.VoteSummary{Digests: []*votepool.VoteDigest{d}})
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// a summary with max digests fits in a message
+	digests := make([]*votepool.VoteDigest, maxVoteDigests)
+	for i := range digests {
+		digests[i] = validDigest()
+		digests[i].EventType = uint32(^uint8(0))
+	}
+	summary := &votepool.VoteSummary{Digests: digests}
+	require.NoError(t, validateVoteSummary(summary))
+	bz, err := proto.Marshal(summary.Wrap())
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(bz), maxMsgSize)
+
+	summary.Digests = append(summary.Digests, validDigest())
+	require.Error(t, validateVoteSummary(summary))
+}
+
+func voteKeys(votes []*Vote) []string {
+	keys := make([]string, len(votes))
+	for i, vote := range votes {
+		keys[i] = vote.Key()
+	}
+	return keys
+}
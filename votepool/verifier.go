@@ -1,9 +1,10 @@
 package votepool
 
 import (
-	"errors"
+	"sort"
 
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls/blst"
+	blsCommon "github.com/prysmaticlabs/prysm/v5/crypto/bls/common"
 
 	"github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/types"
@@ -18,6 +19,7 @@ type Verifier interface {
 type FromValidatorVerifier struct {
 	mtx        *sync.RWMutex
 	validators map[string]*types.Validator
+	ordered    []*types.Validator // validators sorted by voting power, the same order as types.ValidatorSet
 }
 
 func NewFromValidatorVerifier() *FromValidatorVerifier {
@@ -34,6 +36,7 @@ func (f *FromValidatorVerifier) initValidators(validators []*types.Validator) {
 			f.validators[string(val.BlsKey[:])] = val
 		}
 	}
+	f.sortValidators()
 }
 
 func (f *FromValidatorVerifier) updateValidators(changes []*types.Validator) {
@@ -52,6 +55,26 @@ func (f *FromValidatorVerifier) updateValidators(changes []*types.Validator) {
 			f.validators[string(val.BlsKey[:])] = val
 		}
 	}
+	f.sortValidators()
+}
+
+// sortValidators rebuilds the ordered validators, the caller should hold the lock.
+func (f *FromValidatorVerifier) sortValidators() {
+	f.ordered = make([]*types.Validator, 0, len(f.validators))
+	for _, val := range f.validators {
+		f.ordered = append(f.ordered, val)
+	}
+	sort.Sort(types.ValidatorsByVotingPower(f.ordered))
+}
+
+// validatorsByPower returns the validators sorted by voting power.
+func (f *FromValidatorVerifier) validatorsByPower() []*types.Validator {
+	f.mtx.RLock()
+	defer f.mtx.RUnlock()
+
+	vals := make([]*types.Validator, len(f.ordered))
+	copy(vals, f.ordered)
+	return vals
 }
 
 func (f *FromValidatorVerifier) lenOfValidators() int {
@@ -69,7 +92,7 @@ func (f *FromValidatorVerifier) Validate(vote *Vote) error {
 	if _, ok := f.validators[string(vote.PubKey[:])]; ok {
 		return nil
 	}
-	return errors.New("vote is not from validators")
+	return ErrNotFromValidator
 }
 
 // BlsSignatureVerifier will check whether the Vote is correctly bls signed.
@@ -80,11 +103,52 @@ type BlsSignatureVerifier struct {
 func (b *BlsSignatureVerifier) Validate(vote *Vote) error {
 	valid := verifySignature(vote.EventHash, vote.PubKey, vote.Signature)
 	if !valid {
-		return errors.New("invalid signature")
+		return ErrInvalidSignature
 	}
 	return nil
 }
 
+// ValidateBatch checks the signatures of the votes with a single batch verification, and returns an error for each vote.
+// If the batch verification fails, the votes will be validated one by one to find out the invalid ones.
+// The event hashes of the votes should have been checked by Vote.ValidateBasic.
+func (b *BlsSignatureVerifier) ValidateBatch(votes []*Vote) ([]error, bool) {
+	errs := make([]error, len(votes))
+	if len(votes) == 1 {
+		errs[0] = b.Validate(votes[0])
+		return errs, true
+	}
+
+	sigs := make([][]byte, 0, len(votes))
+	msgs := make([][32]byte, 0, len(votes))
+	pubKeys := make([]blsCommon.PublicKey, 0, len(votes))
+	indexes := make([]int, 0, len(votes))
+	for i, vote := range votes {
+		blsPubKey, err := blst.PublicKeyFromBytes(vote.PubKey)
+		if err != nil {
+			errs[i] = ErrInvalidSignature
+			continue
+		}
+		var msg [32]byte
+		copy(msg[:], vote.EventHash)
+		sigs = append(sigs, vote.Signature)
+		msgs = append(msgs, msg)
+		pubKeys = append(pubKeys, blsPubKey)
+		indexes = append(indexes, i)
+	}
+	if len(indexes) == 0 {
+		return errs, true
+	}
+
+	if valid, err := blst.VerifyMultipleSignatures(sigs, msgs, pubKeys); err == nil && valid {
+		return errs, true
+	}
+	// fall back to validate the votes one by one, malformed signatures will also fail the batch verification
+	for _, i := range indexes {
+		errs[i] = b.Validate(votes[i])
+	}
+	return errs, false
+}
+
 func verifySignature(msg []byte, pubKey, sig []byte) bool {
 	blsPubKey, err := blst.PublicKeyFromBytes(pubKey)
 	if err != nil {
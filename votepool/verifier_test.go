@@ -1,6 +1,7 @@
 package votepool
 
 import (
+	"math/big"
 	"testing"
 	"time"
 
@@ -105,3 +106,75 @@ func TestVoteBlsVerifier(t *testing.T) {
 	err = verifier.Validate(&vote2)
 	require.Error(t, err)
 }
+
+func makeSignedVotes(n int) []*Vote {
+	votes := make([]*Vote, n)
+	for i := 0; i < n; i++ {
+		privKey, _ := blst.RandKey()
+		eventHash := common.BigToHash(big.NewInt(int64(i))).Bytes()
+		votes[i] = &Vote{
+			PubKey:    privKey.PublicKey().Marshal(),
+			Signature: privKey.Sign(eventHash).Marshal(),
+			EventType: FromBscCrossChainEvent,
+			EventHash: eventHash,
+		}
+	}
+	return votes
+}
+
+func TestVoteBlsVerifier_ValidateBatch(t *testing.T) {
+	verifier := &BlsSignatureVerifier{}
+
+	votes := makeSignedVotes(8)
+	errs, batched := verifier.ValidateBatch(votes)
+	require.True(t, batched)
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	// invalid signatures are found out by validating votes one by one
+	votes[2].Signature = votes[3].Signature
+	votes[5].Signature = votes[5].Signature[:10]
+	errs, batched = verifier.ValidateBatch(votes)
+	require.False(t, batched)
+	for i, err := range errs {
+		if i == 2 || i == 5 {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+
+	// a batch with a single vote
+	errs, batched = verifier.ValidateBatch(votes[2:3])
+	require.True(t, batched)
+	require.Error(t, errs[0])
+}
+
+func BenchmarkBlsSignatureVerifier_Validate(b *testing.B) {
+	verifier := &BlsSignatureVerifier{}
+	votes := makeSignedVotes(maxVerifyBatchSize)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, vote := range votes {
+			if err := verifier.Validate(vote); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+	b.ReportMetric(float64(b.N*len(votes))/b.Elapsed().Seconds(), "votes/s")
+}
+
+func BenchmarkBlsSignatureVerifier_ValidateBatch(b *testing.B) {
+	verifier := &BlsSignatureVerifier{}
+	votes := makeSignedVotes(maxVerifyBatchSize)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, batched := verifier.ValidateBatch(votes); !batched {
+			b.Fatal("batch verification failed")
+		}
+	}
+	b.ReportMetric(float64(b.N*len(votes))/b.Elapsed().Seconds(), "votes/s")
+}
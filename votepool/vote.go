@@ -3,6 +3,8 @@ package votepool
 import (
 	"errors"
 	"time"
+
+	"github.com/cometbft/cometbft/types"
 )
 
 const (
@@ -41,23 +43,30 @@ func (v *Vote) Key() string {
 	return string(v.EventHash[:]) + string(v.PubKey[:])
 }
 
+// toEventData converts a Vote to the data of the event published when it is added to the Pool.
+func (v *Vote) toEventData() types.EventDataVotePoolUpdates {
+	return types.EventDataVotePoolUpdates{
+		PubKey:    v.PubKey,
+		Signature: v.Signature,
+		EventType: uint8(v.EventType),
+		EventHash: v.EventHash,
+	}
+}
+
 // ValidateBasic does basic validation of vote.
 func (v *Vote) ValidateBasic() error {
 	if len(v.EventHash) != eventHashLen {
 		return errors.New("invalid event hash")
 	}
-	if v.EventType != ToBscCrossChainEvent &&
-		v.EventType != FromBscCrossChainEvent &&
-		v.EventType != FromOpCrossChainEvent &&
-		v.EventType != ToOpCrossChainEvent &&
-		v.EventType != DataAvailabilityChallengeEvent {
+	// whether the event type is supported is decided by the EventTypeRegistry of the Pool
+	if v.EventType == 0 {
 		return errors.New("invalid event type")
 	}
 	if len(v.PubKey) != pubKeyLen {
 		return errors.New("invalid public key")
 	}
 	if len(v.Signature) != signatureLen {
-		return errors.New("invalid signature")
+		return ErrInvalidSignature
 	}
 	return nil
 }